@@ -0,0 +1,161 @@
+// rancher-login is the kubectl exec credential plugin used by kubeconfigs generated while
+// kubeconfig-generate-token is false. Its token command takes the same flags as the rancher CLI.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/rancher/rancher/pkg/kubeconfig/login"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var VERSION = "dev"
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "rancher-login"
+	app.Version = VERSION
+	app.Usage = "Log in to Rancher and print short-lived kubeconfig tokens for kubectl"
+
+	flags := []cli.Flag{
+		cli.StringFlag{
+			Name:  "server",
+			Usage: "Rancher server URL or host",
+		},
+		cli.StringFlag{
+			Name:  "user",
+			Usage: "kubeconfig user the token is for",
+		},
+		cli.StringFlag{
+			Name:  "cluster",
+			Usage: "cluster ID, required for the authorized cluster endpoint",
+		},
+		cli.StringFlag{
+			Name:  "auth-provider",
+			Usage: "auth provider to log in with",
+			Value: "local",
+		},
+		cli.StringFlag{
+			Name:  "cache-dir",
+			Usage: "directory caching tokens",
+			Value: defaultCacheDir(),
+		},
+		cli.StringFlag{
+			Name:  "cacerts",
+			Usage: "file with additional CA certificates to trust",
+		},
+		cli.BoolFlag{
+			Name:  "skip-verify",
+			Usage: "skip verification of the server certificate",
+		},
+		cli.DurationFlag{
+			Name:  "timeout",
+			Usage: "time to wait for a browser login to complete",
+			Value: 5 * time.Minute,
+		},
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:   "token",
+			Usage:  "Print an ExecCredential, logging in when there is no valid cached token",
+			Flags:  flags,
+			Action: token,
+		},
+		{
+			Name:   "logout",
+			Usage:  "Remove the cached token",
+			Flags:  flags,
+			Action: logout,
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func token(c *cli.Context) error {
+	opts, err := options(c)
+	if err != nil {
+		return err
+	}
+
+	credential, err := login.Token(context.Background(), opts)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(os.Stdout).Encode(credential)
+}
+
+func logout(c *cli.Context) error {
+	opts, err := options(c)
+	if err != nil {
+		return err
+	}
+	return login.Logout(opts)
+}
+
+func options(c *cli.Context) (login.Options, error) {
+	opts := login.Options{
+		Server:       c.String("server"),
+		User:         c.String("user"),
+		Cluster:      c.String("cluster"),
+		AuthProvider: c.String("auth-provider"),
+		CacheDir:     c.String("cache-dir"),
+		SkipVerify:   c.Bool("skip-verify"),
+		Timeout:      c.Duration("timeout"),
+		Stdin:        os.Stdin,
+		Stderr:       os.Stderr,
+		OpenBrowser:  openBrowser,
+	}
+
+	if path := c.String("cacerts"); path != "" {
+		caCerts, err := ioutil.ReadFile(path)
+		if err != nil {
+			return opts, err
+		}
+		opts.CACerts = caCerts
+	}
+
+	if fd := int(os.Stdin.Fd()); terminal.IsTerminal(fd) {
+		opts.ReadPassword = func() (string, error) {
+			password, err := terminal.ReadPassword(fd)
+			return string(password), err
+		}
+	}
+
+	return opts, nil
+}
+
+func defaultCacheDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "rancher-login")
+	}
+	return filepath.Join(home, ".rancher", "kubeconfig-tokens")
+}
+
+func openBrowser(url string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", url).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+	default:
+		if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+			return fmt.Errorf("no display available")
+		}
+		return exec.Command("xdg-open", url).Start()
+	}
+}
@@ -8,6 +8,7 @@
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/history"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/rbac"
 	"github.com/rancher/rancher/pkg/types/config/dialer"
@@ -85,6 +86,7 @@ func (h *Handler) ClusterAlertRuleActionHandler(actionName string, action *types
 		}
 	}
 
+	setStateChangedBy(&alert.ObjectMeta, request, alert.Status.AlertState)
 	alert, err = h.ClusterAlertRule.Update(alert)
 	if err != nil {
 		logrus.Errorf("Error while updating alert:%v", err)
@@ -144,6 +146,7 @@ func (h *Handler) ProjectAlertRuleActionHandler(actionName string, action *types
 		}
 	}
 
+	setStateChangedBy(&alert.ObjectMeta, request, alert.Status.AlertState)
 	alert, err = h.ProjectAlertRule.Update(alert)
 	if err != nil {
 		logrus.Errorf("Error while updating alert:%v", err)
@@ -158,6 +161,15 @@ func (h *Handler) ProjectAlertRuleActionHandler(actionName string, action *types
 	return nil
 }
 
+// setStateChangedBy records who requested the state change so the alert history can attribute it.
+func setStateChangedBy(meta *metav1.ObjectMeta, request *types.APIContext, state string) {
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	meta.Annotations[history.ChangedByAnnotation] = request.Request.Header.Get("Impersonate-User")
+	meta.Annotations[history.ChangedToAnnotation] = state
+}
+
 func canUpdateAlert(apiContext *types.APIContext, resource *types.RawResource) bool {
 	var groupName, resourceName string
 	switch rbac.TypeFromContext(apiContext, resource) {
@@ -2,6 +2,7 @@
 
 import (
 	"fmt"
+	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 
@@ -10,6 +11,7 @@
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
 	v3client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/maintenance"
 	"github.com/rancher/rancher/pkg/ref"
 )
 
@@ -75,3 +77,36 @@ func ProjectAlertRuleValidator(resquest *types.APIContext, schema *types.Schema,
 
 	return nil
 }
+
+func MaintenanceWindowValidator(resquest *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var spec v32.AlertMaintenanceWindowSpec
+	if err := convert.ToObj(data, &spec); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
+	}
+
+	if spec.Recurrence != nil {
+		if spec.StartsAt != "" || spec.EndsAt != "" {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, "recurrence can't be combined with startsAt and endsAt")
+		}
+		if err := maintenance.Validate(spec.Recurrence); err != nil {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("invalid recurrence: %v", err))
+		}
+		return nil
+	}
+
+	if spec.StartsAt == "" || spec.EndsAt == "" {
+		return httperror.NewAPIError(httperror.MissingRequired, "either startsAt and endsAt or recurrence is required")
+	}
+	startsAt, err := time.Parse(time.RFC3339, spec.StartsAt)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidFormat, fmt.Sprintf("invalid startsAt: %v", err))
+	}
+	endsAt, err := time.Parse(time.RFC3339, spec.EndsAt)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidFormat, fmt.Sprintf("invalid endsAt: %v", err))
+	}
+	if !endsAt.After(startsAt) {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "endsAt must be after startsAt")
+	}
+	return nil
+}
@@ -33,6 +33,7 @@ type ActionHandler struct {
 	SubjectAccessReviewClient     v1.SubjectAccessReviewInterface
 	CisBenchmarkVersionClient     v3.CisBenchmarkVersionInterface
 	CisBenchmarkVersionLister     v3.CisBenchmarkVersionLister
+	CisBenchmarkProfileLister     v3.CisBenchmarkProfileLister
 	CisConfigClient               v3.CisConfigInterface
 	CisConfigLister               v3.CisConfigLister
 	TokenClient                   v3.TokenInterface
@@ -70,19 +70,34 @@ func (a ActionHandler) runCisScan(actionName string, action *types.Action, apiCo
 			fmt.Sprintf("CIS scan already running on cluster"))
 	}
 
-	if cisScanConfig.OverrideBenchmarkVersion != "" {
-		_, err := a.CisBenchmarkVersionLister.Get(namespace.GlobalNamespace, cisScanConfig.OverrideBenchmarkVersion)
+	var benchmarkProfile *v3.CisBenchmarkProfile
+	if cisScanConfig.BenchmarkProfile != "" {
+		benchmarkProfile, err = cis.GetBenchmarkProfile(a.CisBenchmarkProfileLister, cisScanConfig.BenchmarkProfile)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return httperror.WrapAPIError(err, httperror.InvalidOption,
+					fmt.Sprintf("invalid benchmark profile specified"))
+			}
+			logrus.Errorf("error fetching cis benchmark profile %v: %v", cisScanConfig.BenchmarkProfile, err)
+			return httperror.WrapAPIError(err, httperror.ServerError,
+				fmt.Sprintf("error fetching cis benchmark profile %v", cisScanConfig.BenchmarkProfile))
+		}
+	}
+	overrideBenchmarkVersion := cis.EffectiveScanConfig(cisScanConfig, benchmarkProfile).OverrideBenchmarkVersion
+
+	if overrideBenchmarkVersion != "" {
+		_, err := a.CisBenchmarkVersionLister.Get(namespace.GlobalNamespace, overrideBenchmarkVersion)
 		if err != nil {
 			if kerrors.IsNotFound(err) {
 				return httperror.WrapAPIError(err, httperror.InvalidOption,
 					fmt.Sprintf("invalid override benchmark version specified"))
 			}
-			logrus.Errorf("error fetching cis benchmark version %v: %v", cisScanConfig.OverrideBenchmarkVersion, err)
+			logrus.Errorf("error fetching cis benchmark version %v: %v", overrideBenchmarkVersion, err)
 			return httperror.WrapAPIError(err, httperror.ServerError,
-				fmt.Sprintf("error fetching cis benchmark version %v", cisScanConfig.OverrideBenchmarkVersion))
+				fmt.Sprintf("error fetching cis benchmark version %v", overrideBenchmarkVersion))
 		}
 	}
-	_, _, err = cis.GetBenchmarkVersionToUse(cisScanConfig.OverrideBenchmarkVersion,
+	_, _, err = cis.GetBenchmarkVersionToUse(overrideBenchmarkVersion,
 		cluster.Spec.RancherKubernetesEngineConfig.Version,
 		a.CisConfigLister, a.CisConfigClient,
 		a.CisBenchmarkVersionLister, a.CisBenchmarkVersionClient,
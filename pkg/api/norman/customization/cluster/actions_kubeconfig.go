@@ -32,8 +32,9 @@ func (a ActionHandler) GenerateKubeconfigActionHandler(actionName string, action
 	}
 
 	var (
-		cfg      string
-		tokenKey string
+		cfg          string
+		tokenKey     string
+		authProvider string
 	)
 
 	endpointEnabled := cluster.LocalClusterAuthEndpoint != nil && cluster.LocalClusterAuthEndpoint.Enabled
@@ -49,6 +50,13 @@ func (a ActionHandler) GenerateKubeconfigActionHandler(actionName string, action
 		if err != nil {
 			return err
 		}
+	} else {
+		// kubeconfig uses the exec login helper, which needs to know which provider to log in with
+		authToken, err := a.Auth.TokenFromRequest(apiContext.Request)
+		if err != nil {
+			return err
+		}
+		authProvider = authToken.AuthProvider
 	}
 
 	host := settings.ServerURL.Get()
@@ -87,12 +95,20 @@ func (a ActionHandler) GenerateKubeconfigActionHandler(actionName string, action
 			}
 		}
 
-		cfg, err = kubeconfig.ForClusterTokenBased(&cluster, nodes, apiContext.ID, host, tokenKey)
+		if generateToken {
+			cfg, err = kubeconfig.ForClusterTokenBased(&cluster, nodes, apiContext.ID, host, tokenKey)
+		} else {
+			cfg, err = kubeconfig.ForClusterExecBased(&cluster, nodes, apiContext.ID, host, authProvider)
+		}
 		if err != nil {
 			return err
 		}
 	} else {
-		cfg, err = kubeconfig.ForTokenBased(cluster.Name, apiContext.ID, host, tokenKey)
+		if generateToken {
+			cfg, err = kubeconfig.ForTokenBased(cluster.Name, apiContext.ID, host, tokenKey)
+		} else {
+			cfg, err = kubeconfig.ForExecBased(cluster.Name, apiContext.ID, host, authProvider)
+		}
 		if err != nil {
 			return err
 		}
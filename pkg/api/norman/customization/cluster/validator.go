@@ -21,6 +21,7 @@
 	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/cis"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/service"
+	"github.com/rancher/rancher/pkg/maintenance"
 	"github.com/rancher/rancher/pkg/namespace"
 	mgmtSchema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/settings"
@@ -40,6 +41,7 @@ type Validator struct {
 	CisConfigLister               v3.CisConfigLister
 	CisBenchmarkVersionClient     v3.CisBenchmarkVersionInterface
 	CisBenchmarkVersionLister     v3.CisBenchmarkVersionLister
+	CisBenchmarkProfileLister     v3.CisBenchmarkProfileLister
 }
 
 func (v *Validator) Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
@@ -69,6 +71,10 @@ func (v *Validator) Validator(request *types.APIContext, schema *types.Schema, d
 		return err
 	}
 
+	if err := validateCertificateRotationPolicy(&clusterSpec); err != nil {
+		return err
+	}
+
 	if err := v.validateGenericEngineConfig(request, &clusterSpec); err != nil {
 		return err
 	}
@@ -98,7 +104,18 @@ func (v *Validator) validateScheduledClusterScan(spec *mgmtclient.Cluster) error
 	currentK8sVersion := spec.RancherKubernetesEngineConfig.Version
 	overrideBenchmarkVersion := ""
 	if spec.ScheduledClusterScan.ScanConfig.CisScanConfig != nil {
-		overrideBenchmarkVersion = spec.ScheduledClusterScan.ScanConfig.CisScanConfig.OverrideBenchmarkVersion
+		cisScanConfig := spec.ScheduledClusterScan.ScanConfig.CisScanConfig
+		overrideBenchmarkVersion = cisScanConfig.OverrideBenchmarkVersion
+		if cisScanConfig.BenchmarkProfile != "" {
+			profile, err := cis.GetBenchmarkProfile(v.CisBenchmarkProfileLister, cisScanConfig.BenchmarkProfile)
+			if err != nil {
+				return httperror.NewAPIError(httperror.InvalidBodyContent,
+					fmt.Sprintf("invalid benchmark profile %s: %v", cisScanConfig.BenchmarkProfile, err))
+			}
+			if overrideBenchmarkVersion == "" {
+				overrideBenchmarkVersion = profile.Spec.BenchmarkVersion
+			}
+		}
 	}
 	_, _, err := cis.GetBenchmarkVersionToUse(overrideBenchmarkVersion, currentK8sVersion,
 		v.CisConfigLister, v.CisConfigClient,
@@ -149,6 +166,29 @@ func validateScheduledClusterScan(spec *mgmtclient.Cluster) error {
 	return nil
 }
 
+func validateCertificateRotationPolicy(spec *v32.ClusterSpec) error {
+	policy := spec.CertificateRotationPolicy
+	if policy == nil || !policy.Enabled {
+		return nil
+	}
+
+	// If this cluster is created using a template, the rke config is not in the provided data, skip
+	if spec.RancherKubernetesEngineConfig == nil && spec.ClusterTemplateRevisionName == "" {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "CertificateRotationPolicy", "automatic certificate rotation is only supported for RKE clusters")
+	}
+
+	if policy.DaysBeforeExpiry < 0 {
+		return httperror.NewFieldAPIError(httperror.MinLimitExceeded, "CertificateRotationPolicy.DaysBeforeExpiry", "days before expiry cannot be negative")
+	}
+
+	if policy.MaintenanceWindow != nil {
+		if err := maintenance.Validate(policy.MaintenanceWindow); err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, "CertificateRotationPolicy.MaintenanceWindow", err.Error())
+		}
+	}
+	return nil
+}
+
 func (v *Validator) validateLocalClusterAuthEndpoint(request *types.APIContext, spec *v32.ClusterSpec) error {
 	if !spec.LocalClusterAuthEndpoint.Enabled {
 		return nil
@@ -1,6 +1,7 @@
 package clusterscan
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
 
@@ -10,15 +11,24 @@
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
 	"github.com/rancher/rancher/pkg/clustermanager"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/cis"
 	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/ref"
 	"github.com/rancher/security-scan/pkg/kb-summarizer/report"
 	"github.com/sirupsen/logrus"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
+	linkDiff  = "diff"
+	linkTrend = "trend"
+
+	queryBase   = "base"
+	queryLimit  = "limit"
+	queryFormat = "format"
+
+	defaultTrendLimit = 10
+
 	errorState  = "error"
 	failedState = "fail"
 	passedState = "pass"
@@ -55,6 +65,8 @@ func Formatter(apiContext *types.APIContext, resource *types.RawResource) {
 		}
 		if completed {
 			resource.Links["report"] = apiContext.URLBuilder.Link("report", resource)
+			resource.Links["diff"] = apiContext.URLBuilder.Link("diff", resource)
+			resource.Links["trend"] = apiContext.URLBuilder.Link("trend", resource)
 			cisScanStatusInterface, ok := status["cisScanStatus"]
 			if !ok {
 				resource.Values["state"] = errorState
@@ -78,8 +90,9 @@ func Formatter(apiContext *types.APIContext, resource *types.RawResource) {
 }
 
 type Handler struct {
-	CoreClient     corev1.Interface
-	ClusterManager *clustermanager.Manager
+	CoreClient        corev1.Interface
+	ClusterManager    *clustermanager.Manager
+	ClusterScanLister mgmtv3.ClusterScanLister
 }
 
 func (h Handler) LinkHandler(apiContext *types.APIContext, next types.RequestHandler) error {
@@ -90,17 +103,31 @@ func (h Handler) LinkHandler(apiContext *types.APIContext, next types.RequestHan
 
 	clusterID, clusterScanID := ref.Parse(cs["id"].(string))
 
+	switch apiContext.Link {
+	case linkDiff:
+		return h.diff(apiContext, clusterID, clusterScanID)
+	case linkTrend:
+		return h.trend(apiContext, clusterID)
+	}
+
 	clusterContext, err := h.ClusterManager.UserContextNoControllers(clusterID)
 	if err != nil {
 		return err
 	}
 
-	cm, err := clusterContext.Core.ConfigMaps(v32.DefaultNamespaceForCis).Get(clusterScanID, metav1.GetOptions{})
+	clusterScan, err := h.ClusterScanLister.Get(clusterID, clusterScanID)
+	if err != nil {
+		return err
+	}
+	r, err := cis.GetReport(clusterContext.Core.ConfigMaps(v32.DefaultNamespaceForCis), clusterScan)
 	if err != nil {
 		return err
 	}
 
-	reportJSON, err := report.GetJSONBytes([]byte(cm.Data[v32.DefaultScanOutputFileName]))
+	reportJSON, err := json.Marshal(struct {
+		*report.Report
+		AcceptedRisks []v32.CisCheckException `json:"acceptedRisks,omitempty"`
+	}{r, clusterScan.Status.AcceptedRisks})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,124 @@
+package clusterscan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/cis"
+	"github.com/rancher/rancher/pkg/ref"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// diff compares the report of the scan with the one of the scan given by the base query parameter, or
+// with the previous scan of the same profile.
+func (h Handler) diff(apiContext *types.APIContext, clusterID, clusterScanID string) error {
+	scans, err := h.ClusterScanLister.List(clusterID, labels.Everything())
+	if err != nil {
+		return err
+	}
+	target, err := h.ClusterScanLister.Get(clusterID, clusterScanID)
+	if err != nil {
+		return err
+	}
+
+	base := cis.PreviousScan(scans, target)
+	if baseID := apiContext.Request.URL.Query().Get(queryBase); baseID != "" {
+		namespace, name := ref.Parse(baseID)
+		if namespace != "" && namespace != clusterID {
+			return httperror.NewAPIError(httperror.InvalidOption, "scans of different clusters can't be compared")
+		}
+		if base = cis.FindCompletedScan(scans, name); base == nil {
+			return httperror.NewAPIError(httperror.NotFound, fmt.Sprintf("scan %s is not a completed scan of cluster %s", name, clusterID))
+		}
+		if cis.Profile(base) != cis.Profile(target) {
+			return httperror.NewAPIError(httperror.InvalidOption,
+				fmt.Sprintf("scan %s ran with profile %s and can't be compared with scan %s of profile %s", name, cis.Profile(base), clusterScanID, cis.Profile(target)))
+		}
+	} else if base == nil {
+		return httperror.NewAPIError(httperror.NotFound, "no previous scan to compare with")
+	}
+
+	clusterContext, err := h.ClusterManager.UserContextNoControllers(clusterID)
+	if err != nil {
+		return err
+	}
+	configMaps := clusterContext.Core.ConfigMaps(v32.DefaultNamespaceForCis)
+	baseReport, err := cis.GetReport(configMaps, base)
+	if err != nil {
+		return err
+	}
+	targetReport, err := cis.GetReport(configMaps, target)
+	if err != nil {
+		return err
+	}
+
+	diff := cis.DiffReports(baseReport, targetReport)
+	diff.Base = base.Name
+	diff.Target = clusterScanID
+
+	if apiContext.Request.URL.Query().Get(queryFormat) == "csv" {
+		buf := &bytes.Buffer{}
+		if err := cis.WriteDiffCSV(buf, diff); err != nil {
+			return err
+		}
+		return writeCSV(apiContext, buf.Bytes(), clusterScanID+"-diff.csv")
+	}
+	return writeJSON(apiContext, diff)
+}
+
+// trend summarizes the last scans of the cluster, the number of scans is given by the limit query parameter.
+func (h Handler) trend(apiContext *types.APIContext, clusterID string) error {
+	limit := defaultTrendLimit
+	if v := apiContext.Request.URL.Query().Get(queryLimit); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return httperror.NewAPIError(httperror.InvalidOption, "limit must be a positive number")
+		}
+		limit = n
+	}
+
+	scans, err := h.ClusterScanLister.List(clusterID, labels.Everything())
+	if err != nil {
+		return err
+	}
+	points := cis.Trend(scans, limit)
+
+	if apiContext.Request.URL.Query().Get(queryFormat) == "csv" {
+		buf := &bytes.Buffer{}
+		if err := cis.WriteTrendCSV(buf, points); err != nil {
+			return err
+		}
+		return writeCSV(apiContext, buf.Bytes(), clusterID+"-cis-trend.csv")
+	}
+	return writeJSON(apiContext, map[string]interface{}{
+		"clusterId": clusterID,
+		"scans":     points,
+	})
+}
+
+func writeJSON(apiContext *types.APIContext, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	apiContext.Response.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	apiContext.Response.Header().Set("Content-Type", "application/json")
+	apiContext.Response.WriteHeader(http.StatusOK)
+	_, err = apiContext.Response.Write(data)
+	return err
+}
+
+func writeCSV(apiContext *types.APIContext, data []byte, filename string) error {
+	apiContext.Response.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	apiContext.Response.Header().Set("Content-Type", "text/csv")
+	apiContext.Response.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	apiContext.Response.WriteHeader(http.StatusOK)
+	_, err := apiContext.Response.Write(data)
+	return err
+}
@@ -0,0 +1,44 @@
+package clusterscan
+
+import (
+	"fmt"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type ProfileValidator struct {
+	CisBenchmarkVersionLister mgmtv3.CisBenchmarkVersionLister
+}
+
+// Validator checks that the benchmark version of a profile exists and that every check is either skipped or
+// accepted as risk, once.
+func (v *ProfileValidator) Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	if benchmarkVersion := convert.ToString(data[client.CisBenchmarkProfileFieldBenchmarkVersion]); benchmarkVersion != "" {
+		if _, err := v.CisBenchmarkVersionLister.Get(namespace.GlobalNamespace, benchmarkVersion); err != nil {
+			if kerrors.IsNotFound(err) {
+				return httperror.NewFieldAPIError(httperror.InvalidOption, client.CisBenchmarkProfileFieldBenchmarkVersion,
+					fmt.Sprintf("benchmark version %s not found", benchmarkVersion))
+			}
+			return err
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, field := range []string{client.CisBenchmarkProfileFieldSkipChecks, client.CisBenchmarkProfileFieldAcceptedRisks} {
+		for _, check := range convert.ToMapSlice(data[field]) {
+			id := convert.ToString(check["id"])
+			if seen[id] {
+				return httperror.NewFieldAPIError(httperror.InvalidOption, field,
+					fmt.Sprintf("check %s is listed more than once", id))
+			}
+			seen[id] = true
+		}
+	}
+	return nil
+}
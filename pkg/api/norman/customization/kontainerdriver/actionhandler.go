@@ -1,6 +1,8 @@
 package kontainerdriver
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -12,6 +14,7 @@
 	"github.com/rancher/norman/types"
 	"github.com/rancher/rancher/pkg/catalog/utils"
 	"github.com/rancher/rancher/pkg/channelserver"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	kd "github.com/rancher/rancher/pkg/controllers/management/kontainerdrivermetadata"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
@@ -21,6 +24,9 @@
 	rketypes "github.com/rancher/rke/types"
 	img "github.com/rancher/rke/types/image"
 	"github.com/rancher/rke/util"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -34,6 +40,7 @@ type ActionHandler struct {
 	KontainerDrivers      v3.KontainerDriverInterface
 	KontainerDriverLister v3.KontainerDriverLister
 	MetadataHandler       kd.MetadataController
+	ConfigMaps            v1.ConfigMapInterface
 }
 
 type ListHandler struct {
@@ -56,6 +63,8 @@ func (a ActionHandler) ActionHandler(actionName string, action *types.Action, ap
 		return a.deactivate(apiContext)
 	case "refresh":
 		return a.refresh(apiContext)
+	case "importMetadataBundle":
+		return a.importMetadataBundle(apiContext)
 	}
 	return httperror.NewAPIError(httperror.NotFound, "not found")
 }
@@ -86,6 +95,55 @@ func (a ActionHandler) refresh(apiContext *types.APIContext) error {
 	return nil
 }
 
+// importMetadataBundle verifies a release metadata bundle and stores it for every replica to install. Bundles
+// older than the last imported one are rejected.
+func (a ActionHandler) importMetadataBundle(apiContext *types.APIContext) error {
+	input := client.MetadataBundleInput{}
+	if err := json.NewDecoder(apiContext.Request.Body).Decode(&input); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("failed to parse input: %v", err))
+	}
+	content, err := base64.StdEncoding.DecodeString(input.Bundle)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("bundle must be base64 encoded: %v", err))
+	}
+	bundle, err := channelserver.ParseBundle(content)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+
+	configMap, err := a.ConfigMaps.GetNamespaced(namespace.System, channelserver.BundleConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      channelserver.BundleConfigMapName,
+				Namespace: namespace.System,
+			},
+			BinaryData: map[string][]byte{channelserver.BundleConfigMapKey: content},
+		}
+		if _, err := a.ConfigMaps.Create(configMap); err != nil {
+			return httperror.WrapAPIError(err, httperror.ServerError, "failed to store bundle")
+		}
+	} else if err != nil {
+		return httperror.WrapAPIError(err, httperror.ServerError, "failed to get current bundle")
+	} else {
+		current, err := channelserver.ReadBundleMetadata(configMap.BinaryData[channelserver.BundleConfigMapKey])
+		if err != nil {
+			return httperror.WrapAPIError(err, httperror.ServerError, "failed to read the version of the current bundle")
+		}
+		if err := channelserver.CheckUpgrade(current, bundle.Metadata); err != nil {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+		}
+		configMap = configMap.DeepCopy()
+		configMap.BinaryData = map[string][]byte{channelserver.BundleConfigMapKey: content}
+		if _, err := a.ConfigMaps.Update(configMap); err != nil {
+			return httperror.WrapAPIError(err, httperror.ServerError, "failed to store bundle")
+		}
+	}
+
+	apiContext.WriteResponse(http.StatusOK, client.MetadataBundleOutput{Version: bundle.Metadata.Version})
+	return nil
+}
+
 func (a ActionHandler) setDriverActiveStatus(apiContext *types.APIContext, status bool) error {
 	driver, err := a.KontainerDriverLister.Get("", apiContext.ID)
 	if err != nil {
@@ -31,6 +31,7 @@ func NewFormatter(manangement *config.ScaledContext) types.Formatter {
 
 func CollectionFormatter(apiContext *types.APIContext, collection *types.GenericCollection) {
 	collection.AddAction(apiContext, "refresh")
+	collection.AddAction(apiContext, "importMetadataBundle")
 	currContext := apiContext.URLBuilder.Current()
 	if !strings.HasSuffix(currContext, "/") {
 		currContext = fmt.Sprintf("%s/", currContext)
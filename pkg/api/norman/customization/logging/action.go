@@ -77,6 +77,7 @@ func CollectionFormatter(apiContext *types.APIContext, resource *types.GenericCo
 	if canPerformLoggingAction(apiContext, nil, "") {
 		resource.AddAction(apiContext, "test")
 		resource.AddAction(apiContext, "dryRun")
+		resource.AddAction(apiContext, "dryRunReport")
 	}
 }
 
@@ -131,6 +132,17 @@ func (h *Handler) ActionHandler(actionName string, action *types.Action, apiCont
 		}
 	}
 
+	if actionName == "dryRunReport" {
+		report := h.dryRunReport(apiContext, level, clusterName, projectID, containerLogSourceTag, target, outputTags)
+		data, err := convert.EncodeToMap(report)
+		if err != nil {
+			return err
+		}
+		data["type"] = "loggingDryRunReport"
+		apiContext.WriteResponse(http.StatusOK, data)
+		return nil
+	}
+
 	if err := validate(level, containerLogSourceTag, target, outputTags); err != nil {
 		return err
 	}
@@ -0,0 +1,113 @@
+package logging
+
+import (
+	v33 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/types"
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuserlegacy/logging/config"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/logging/configsyncer"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/logging/utils"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/project"
+	"github.com/rancher/rancher/pkg/ref"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	checkValidate      = "validate"
+	checkRender        = "render"
+	checkFluentdDryRun = "fluentdDryRun"
+	checkClusterDialer = "clusterDialer"
+)
+
+// dryRunReport validates and renders the fluentd configuration of the logging target, runs it through the fluentd
+// dry run of the tester and checks each endpoint of the target. Unlike the dryRun action, failures are reported
+// instead of failing the request.
+func (h *Handler) dryRunReport(apiContext *types.APIContext, level, clusterName, projectID, containerLogSourceTag string, target v33.LoggingTargets, outputTags map[string]string) v33.LoggingDryRunReport {
+	report := v33.LoggingDryRunReport{Success: true}
+	addCheck := func(name string, err error) bool {
+		check := v33.LoggingTargetCheck{
+			Name:    name,
+			Success: err == nil,
+		}
+		if err != nil {
+			check.Message = err.Error()
+			report.Success = false
+		}
+		report.Checks = append(report.Checks, check)
+		return err == nil
+	}
+
+	if addCheck(checkValidate, validate(level, containerLogSourceTag, target, outputTags)) &&
+		addCheck(checkRender, h.renderLoggingConfig(level, clusterName, projectID, target)) {
+		addCheck(checkFluentdDryRun, h.dryRunLoggingTarget(apiContext, level, clusterName, projectID, target))
+	}
+
+	clusterDialer, err := h.dialerFactory.ClusterDialer(clusterName)
+	if err != nil {
+		addCheck(checkClusterDialer, errors.Wrap(err, "get cluster dialer failed"))
+		return report
+	}
+
+	report.Targets = utils.DiagnoseLoggingTarget(apiContext.Request.Context(), clusterDialer, target)
+	for _, v := range report.Targets {
+		if !v.Success {
+			report.Success = false
+		}
+	}
+	return report
+}
+
+// renderLoggingConfig renders the fluentd configuration the logging target is deployed with, at project level along
+// with the other project loggings of the cluster.
+func (h *Handler) renderLoggingConfig(level, clusterName, projectID string, target v33.LoggingTargets) error {
+	context, err := h.clusterManager.UserContextNoControllers(clusterName)
+	if err != nil {
+		return err
+	}
+
+	systemProject, err := project.GetSystemProject(clusterName, h.projectLister)
+	if err != nil {
+		return err
+	}
+	systemProjectID := ref.Ref(systemProject)
+
+	namespaces := context.Core.Namespaces(metav1.NamespaceAll)
+	configGenerator := configsyncer.NewConfigGenerator(clusterName, h.projectLoggingLister, namespaces.Controller().Lister())
+	if level == loggingconfig.ClusterLevel {
+		clusterLogging := &mgmtv3.ClusterLogging{
+			Spec: v33.ClusterLoggingSpec{
+				LoggingTargets: target,
+				ClusterName:    clusterName,
+			},
+		}
+		_, err = configGenerator.GenerateClusterLoggingConfig(clusterLogging, systemProjectID, loggingconfig.DefaultCertDir)
+		return err
+	}
+
+	current, err := h.projectLoggingLister.List(metav1.NamespaceAll, labels.NewSelector())
+	if err != nil {
+		return errors.Wrap(err, "list project logging failed")
+	}
+
+	projectLoggings := []*mgmtv3.ProjectLogging{
+		{
+			Spec: v33.ProjectLoggingSpec{
+				LoggingTargets: target,
+				ProjectName:    projectID,
+			},
+		},
+	}
+	for _, v := range current {
+		if controller.ObjectInCluster(clusterName, v) && v.Spec.ProjectName != projectID {
+			projectLoggings = append(projectLoggings, v)
+		}
+	}
+
+	_, err = configGenerator.GenerateProjectLoggingConfig(projectLoggings, systemProjectID, loggingconfig.DefaultCertDir)
+	return err
+}
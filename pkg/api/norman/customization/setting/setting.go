@@ -14,7 +14,10 @@
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	v3client "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
 	"github.com/rancher/rancher/pkg/settings"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var ReadOnlySettings = []string{
@@ -31,6 +34,32 @@ func PipelineFormatter(apiContext *types.APIContext, resource *types.RawResource
 	}
 }
 
+// PipelineValidator rejects values of pipeline settings that would make every execution of the project fail.
+func PipelineValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	_, id := ref.Parse(request.ID)
+	if name, ok := data["name"].(string); ok && id == "" {
+		id = name
+	}
+
+	value := convert.ToString(data["value"])
+	if value == "" {
+		return nil
+	}
+
+	switch id {
+	case utils.SettingEngine:
+		if value != utils.EngineJenkins && value != utils.EnginePod {
+			return httperror.NewAPIError(httperror.InvalidOption,
+				fmt.Sprintf("invalid engine %q, must be %s or %s", value, utils.EngineJenkins, utils.EnginePod))
+		}
+	case utils.SettingWorkspaceSize:
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("invalid workspace size %q: %v", value, err))
+		}
+	}
+	return nil
+}
+
 func Formatter(apiContext *types.APIContext, resource *types.RawResource) {
 	if convert.ToString(resource.Values["source"]) == "env" {
 		delete(resource.Links, "update")
@@ -38,6 +38,7 @@
 	"github.com/rancher/rancher/pkg/api/norman/customization/roletemplatebinding"
 	"github.com/rancher/rancher/pkg/api/norman/customization/secret"
 	"github.com/rancher/rancher/pkg/api/norman/customization/setting"
+	"github.com/rancher/rancher/pkg/api/norman/store/alerthistory"
 	appStore "github.com/rancher/rancher/pkg/api/norman/store/app"
 	catalogStore "github.com/rancher/rancher/pkg/api/norman/store/catalog"
 	"github.com/rancher/rancher/pkg/api/norman/store/cert"
@@ -119,6 +120,8 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 	)
 
 	factory.BatchCreateCRDs(ctx, config.ManagementStorageContext, scheme.Scheme, schemas, &managementschema.Version,
+		client.AlertHistoryType,
+		client.AlertMaintenanceWindowType,
 		client.CatalogType,
 		client.CatalogTemplateType,
 		client.CatalogTemplateVersionType,
@@ -142,6 +145,7 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.ProjectMonitorGraphType,
 		client.CisConfigType,
 		client.CisBenchmarkVersionType,
+		client.CisBenchmarkProfileType,
 		client.TemplateType,
 		client.TemplateVersionType,
 		client.TemplateContentType,
@@ -290,11 +294,13 @@ func Clusters(ctx context.Context, schemas *types.Schemas, managementContext *co
 	handler.CisConfigLister = managementContext.Management.CisConfigs("").Controller().Lister()
 	handler.CisBenchmarkVersionClient = managementContext.Management.CisBenchmarkVersions("")
 	handler.CisBenchmarkVersionLister = managementContext.Management.CisBenchmarkVersions("").Controller().Lister()
+	handler.CisBenchmarkProfileLister = managementContext.Management.CisBenchmarkProfiles("").Controller().Lister()
 
 	clusterValidator.CisConfigClient = managementContext.Management.CisConfigs(namespace.GlobalNamespace)
 	clusterValidator.CisConfigLister = managementContext.Management.CisConfigs(namespace.GlobalNamespace).Controller().Lister()
 	clusterValidator.CisBenchmarkVersionClient = managementContext.Management.CisBenchmarkVersions(namespace.GlobalNamespace)
 	clusterValidator.CisBenchmarkVersionLister = managementContext.Management.CisBenchmarkVersions(namespace.GlobalNamespace).Controller().Lister()
+	clusterValidator.CisBenchmarkProfileLister = managementContext.Management.CisBenchmarkProfiles(namespace.GlobalNamespace).Controller().Lister()
 
 	schema.ActionHandler = handler.ClusterActionHandler
 	schema.Validator = clusterValidator.Validator
@@ -597,6 +603,12 @@ func Alert(schemas *types.Schemas, management *config.ScaledContext) {
 	schema.Validator = alert.ProjectAlertRuleValidator
 	schema.ActionHandler = handler.ProjectAlertRuleActionHandler
 
+	schema = schemas.Schema(&managementschema.Version, client.AlertMaintenanceWindowType)
+	schema.Validator = alert.MaintenanceWindowValidator
+
+	schema = schemas.Schema(&managementschema.Version, client.AlertHistoryType)
+	schema.Store = alerthistory.Wrap(schema.Store)
+
 	//old schema just for migrate
 	schema = schemas.Schema(&managementschema.Version, client.ClusterAlertType)
 	schema = schemas.Schema(&managementschema.Version, client.ProjectAlertType)
@@ -647,6 +659,7 @@ func Pipeline(schemas *types.Schemas, management *config.ScaledContext, clusterM
 
 	schema = schemas.Schema(&projectschema.Version, projectclient.PipelineSettingType)
 	schema.Formatter = setting.PipelineFormatter
+	schema.Validator = setting.PipelineValidator
 
 	sourceCodeCredentialHandler := &pipeline.SourceCodeCredentialHandler{
 		SourceCodeCredentials:      management.Project.SourceCodeCredentials(""),
@@ -757,6 +770,7 @@ func KontainerDriver(schemas *types.Schemas, management *config.ScaledContext) {
 		KontainerDrivers:      management.Management.KontainerDrivers(""),
 		KontainerDriverLister: management.Management.KontainerDrivers("").Controller().Lister(),
 		MetadataHandler:       metadataHandler,
+		ConfigMaps:            management.Core.ConfigMaps(""),
 	}
 	lh := kontainerdriver.ListHandler{
 		SysImageLister:  management.Management.RkeK8sSystemImages("").Controller().Lister(),
@@ -867,11 +881,19 @@ func ClusterTemplates(schemas *types.Schemas, management *config.ScaledContext)
 
 func ClusterScans(schemas *types.Schemas, management *config.ScaledContext, clusterManager *clustermanager.Manager) {
 	clusterScanHandler := clusterscan.Handler{
-		ClusterManager: clusterManager,
+		ClusterManager:    clusterManager,
+		ClusterScanLister: management.Management.ClusterScans("").Controller().Lister(),
 	}
 	schema := schemas.Schema(&managementschema.Version, client.ClusterScanType)
 	schema.Formatter = clusterscan.Formatter
 	schema.LinkHandler = clusterScanHandler.LinkHandler
+
+	profileValidator := clusterscan.ProfileValidator{
+		CisBenchmarkVersionLister: management.Management.CisBenchmarkVersions("").Controller().Lister(),
+	}
+	schema = schemas.Schema(&managementschema.Version, client.CisBenchmarkProfileType)
+	schema.Store = namespacedresource.Wrap(schema.Store, management.Core.Namespaces(""), namespace.GlobalNamespace)
+	schema.Validator = profileValidator.Validator
 }
 
 func SystemImages(schemas *types.Schemas, management *config.ScaledContext) {
@@ -0,0 +1,94 @@
+package alerthistory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rancher/norman/api/handler"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+)
+
+// Wrap limits the entries of alert histories to the range given by the since and until query parameters,
+// both in RFC3339. Histories without any entry in the range are left out of lists, which is done before
+// pagination so that pages are full and the totals only count the histories in the range.
+func Wrap(store types.Store) types.Store {
+	return &Store{
+		Store: store,
+	}
+}
+
+type Store struct {
+	types.Store
+}
+
+func (s *Store) ByID(apiContext *types.APIContext, schema *types.Schema, id string) (map[string]interface{}, error) {
+	since, until, ok, err := historyRange(apiContext)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.Store.ByID(apiContext, schema, id)
+	if err != nil || !ok {
+		return data, err
+	}
+	filterEntries(data, since, until)
+	return data, nil
+}
+
+func (s *Store) List(apiContext *types.APIContext, schema *types.Schema, opt *types.QueryOptions) ([]map[string]interface{}, error) {
+	since, until, ok, err := historyRange(apiContext)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || opt == nil {
+		return s.Store.List(apiContext, schema, opt)
+	}
+
+	unpaginated := *opt
+	unpaginated.Pagination = nil
+	data, err := s.Store.List(apiContext, schema, &unpaginated)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []map[string]interface{}
+	for _, item := range data {
+		if filterEntries(item, since, until) {
+			result = append(result, item)
+		}
+	}
+	return handler.ApplyPagination(opt.Pagination, result), nil
+}
+
+// filterEntries drops the entries of a history outside of the range and returns whether any is left.
+func filterEntries(data map[string]interface{}, since, until time.Time) bool {
+	var entries []interface{}
+	for _, entry := range convert.ToInterfaceSlice(data["entries"]) {
+		t, err := time.Parse(time.RFC3339, convert.ToString(convert.ToMapInterface(entry)["time"]))
+		if err != nil || (!since.IsZero() && t.Before(since)) || (!until.IsZero() && t.After(until)) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	data["entries"] = entries
+	return len(entries) > 0
+}
+
+func historyRange(apiContext *types.APIContext) (time.Time, time.Time, bool, error) {
+	var since, until time.Time
+	query := apiContext.Request.URL.Query()
+	for param, t := range map[string]*time.Time{"since": &since, "until": &until} {
+		v := query.Get(param)
+		if v == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return since, until, false, httperror.NewAPIError(httperror.InvalidFormat, fmt.Sprintf("%s must be a RFC3339 time: %v", param, err))
+		}
+		*t = parsed
+	}
+	return since, until, !since.IsZero() || !until.IsZero(), nil
+}
@@ -0,0 +1,82 @@
+package alerthistory
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rancher/norman/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	types.Store
+	data []map[string]interface{}
+	opts []*types.QueryOptions
+}
+
+func (f *fakeStore) List(apiContext *types.APIContext, schema *types.Schema, opt *types.QueryOptions) ([]map[string]interface{}, error) {
+	f.opts = append(f.opts, opt)
+	return f.data, nil
+}
+
+func (f *fakeStore) ByID(apiContext *types.APIContext, schema *types.Schema, id string) (map[string]interface{}, error) {
+	return f.data[0], nil
+}
+
+func history(id string, times ...string) map[string]interface{} {
+	var entries []interface{}
+	for _, t := range times {
+		entries = append(entries, map[string]interface{}{"time": t, "state": "alerting"})
+	}
+	return map[string]interface{}{"id": id, "entries": entries}
+}
+
+func apiContext(query string) *types.APIContext {
+	return &types.APIContext{Request: httptest.NewRequest("GET", "/v3/alerthistories"+query, nil)}
+}
+
+func TestListFiltersBeforePagination(t *testing.T) {
+	fake := &fakeStore{data: []map[string]interface{}{
+		history("a", "2021-06-01T00:00:00Z"),
+		history("b", "2021-06-01T00:00:00Z", "2021-06-10T00:00:00Z"),
+		history("c", "2021-06-20T00:00:00Z"),
+		history("d", "2021-06-12T00:00:00Z"),
+	}}
+	store := Wrap(fake)
+
+	limit := int64(2)
+	opt := &types.QueryOptions{Pagination: &types.Pagination{Limit: &limit}}
+	data, err := store.List(apiContext("?since=2021-06-05T00:00:00Z&until=2021-06-15T00:00:00Z"), nil, opt)
+	require.NoError(t, err)
+
+	assert.Nil(t, fake.opts[0].Pagination)
+	if assert.Len(t, data, 2) {
+		assert.Equal(t, "b", data[0]["id"])
+		assert.Len(t, data[0]["entries"], 1)
+		assert.Equal(t, "d", data[1]["id"])
+	}
+	assert.Equal(t, int64(2), *opt.Pagination.Total)
+	assert.False(t, opt.Pagination.Partial)
+}
+
+func TestListWithoutRange(t *testing.T) {
+	fake := &fakeStore{data: []map[string]interface{}{history("a", "2021-06-01T00:00:00Z")}}
+	opt := &types.QueryOptions{Pagination: &types.Pagination{}}
+
+	data, err := Wrap(fake).List(apiContext(""), nil, opt)
+	require.NoError(t, err)
+	assert.Len(t, data, 1)
+	assert.Same(t, opt, fake.opts[0])
+}
+
+func TestByID(t *testing.T) {
+	fake := &fakeStore{data: []map[string]interface{}{history("a", "2021-06-01T00:00:00Z", "2021-06-10T00:00:00Z")}}
+
+	data, err := Wrap(fake).ByID(apiContext("?until=2021-06-05T00:00:00Z"), nil, "a")
+	require.NoError(t, err)
+	assert.Len(t, data["entries"], 1)
+
+	_, err = Wrap(fake).ByID(apiContext("?since=yesterday"), nil, "a")
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@
 	"github.com/rancher/norman/types/convert"
 	"github.com/rancher/rancher/pkg/cert"
 	client "github.com/rancher/rancher/pkg/client/generated/project/v3"
+	"github.com/rancher/rancher/pkg/settings"
 )
 
 func Wrap(store types.Store) types.Store {
@@ -44,7 +45,7 @@ func AddCertInfo(data map[string]interface{}) error {
 		return nil
 	}
 
-	certInfo, err := cert.Info(certs, key)
+	certInfo, err := cert.InfoWithRoots(certs, key, settings.CACerts.Get())
 	if err != nil {
 		return httperror.NewFieldAPIError(httperror.InvalidBodyContent, "certs", err.Error())
 	}
@@ -25,7 +25,7 @@
 )
 
 var (
-	arrKeys      = map[string]string{"fluentServers": "endpoint"}
+	arrKeys      = map[string]string{"fluentServers": "endpoint", "headers": "name"}
 	ignoreFields = map[string]bool{"appliedSpec": true, "failedSpec": true}
 )
 
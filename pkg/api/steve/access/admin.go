@@ -0,0 +1,10 @@
+package access
+
+import (
+	"github.com/rancher/apiserver/pkg/types"
+)
+
+// IsAdmin returns whether the user of the request is an administrator, that is allowed to do anything on any resource.
+func IsAdmin(apiRequest *types.APIRequest) bool {
+	return apiRequest.AccessControl.CanDo(apiRequest, "*/*", "*", "", "") == nil
+}
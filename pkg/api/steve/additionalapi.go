@@ -53,9 +53,18 @@ func AdditionalAPIs(ctx context.Context, config *wrangler.Context, steve *steve.
 		return nil, err
 	}
 
+	multiClusterList, err := proxy.NewMultiClusterList(config.K8s.AuthorizationV1(),
+		config.TunnelServer.Dialer,
+		config.Mgmt.Cluster().Cache(),
+		config.RESTConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	mux := gmux.NewRouter()
 	mux.UseEncodedPath()
 	mux.Handle("/v1/github{path:.*}", githubHandler)
+	mux.Handle(proxy.MultiClusterListPath, multiClusterList)
 	mux.Handle("/v3/connect", Tunnel(config))
 	health.Register(mux)
 
@@ -39,6 +39,8 @@ func addSchemas(server *steve.Server, ops *operation, index http.Handler) {
 	server.BaseSchemas.MustImportAndCustomize(types2.ChartInstallAction{}, nil)
 	server.BaseSchemas.MustImportAndCustomize(types2.ChartInstall{}, nil)
 	server.BaseSchemas.MustImportAndCustomize(types2.ChartActionOutput{}, nil)
+	server.BaseSchemas.MustImportAndCustomize(types2.ChartPreflightOutput{}, nil)
+	server.BaseSchemas.MustImportAndCustomize(types2.ChartPreflight{}, nil)
 
 	operationTemplate := schema2.Template{
 		Group: catalog.GroupName,
@@ -74,8 +76,9 @@ func addSchemas(server *steve.Server, ops *operation, index http.Handler) {
 		Kind:  "Repo",
 		Customize: func(apiSchema *types.APISchema) {
 			apiSchema.ActionHandlers = map[string]http.Handler{
-				"install": ops,
-				"upgrade": ops,
+				"install":   ops,
+				"upgrade":   ops,
+				"preflight": ops,
 			}
 			apiSchema.ResourceActions = map[string]schemas3.Action{
 				"install": {
@@ -86,6 +89,10 @@ func addSchemas(server *steve.Server, ops *operation, index http.Handler) {
 					Input:  "chartUpgradeAction",
 					Output: "chartActionOutput",
 				},
+				"preflight": {
+					Input:  "chartUpgradeAction",
+					Output: "chartPreflightOutput",
+				},
 			}
 			apiSchema.ByIDHandler = func(request *types.APIRequest) (types.APIObject, error) {
 				if request.Name == "index.yaml" {
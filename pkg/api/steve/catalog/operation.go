@@ -47,6 +47,17 @@ func (o *operation) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	ns, name := nsAndName(apiRequest)
 	switch apiRequest.Action {
+	case "preflight":
+		output, err := o.ops.Preflight(apiRequest, ns, name, req.Body)
+		if err != nil {
+			apiRequest.WriteError(err)
+			return
+		}
+		apiRequest.WriteResponse(http.StatusOK, types.APIObject{
+			Type:   "chartPreflightOutput",
+			Object: output,
+		})
+		return
 	case "install":
 		op, err = o.ops.Install(apiRequest.Context(), user, ns, name, req.Body, o.imageOverride)
 	case "upgrade":
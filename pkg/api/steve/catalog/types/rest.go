@@ -31,6 +31,25 @@ type ChartInfo struct {
 	Values    v3.MapStringInterface `json:"values,omitempty"`
 	Questions v3.MapStringInterface `json:"questions,omitempty"`
 	Chart     v3.MapStringInterface `json:"chart,omitempty"`
+	Signature *ChartSignature       `json:"signature,omitempty"`
+}
+
+const (
+	// SignatureVerified the chart is signed by a key trusted by its repo
+	SignatureVerified = "verified"
+	// SignatureInvalid the chart is signed but the signature doesn't match the chart or a trusted key
+	SignatureInvalid = "invalid"
+	// SignatureUnverified the chart is signed but its repo trusts no key to verify it
+	SignatureUnverified = "unverified"
+	// SignatureUnsigned the chart has no signature
+	SignatureUnsigned = "unsigned"
+)
+
+type ChartSignature struct {
+	State   string `json:"state,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Signer  string `json:"signer,omitempty"`
+	Message string `json:"message,omitempty"`
 }
 
 type ChartUninstallAction struct {
@@ -70,3 +89,22 @@ type ChartActionOutput struct {
 	OperationName      string `json:"operationName,omitempty"`
 	OperationNamespace string `json:"operationNamespace,omitempty"`
 }
+
+type ChartPreflightOutput struct {
+	Charts []ChartPreflight `json:"charts,omitempty"`
+}
+
+type ChartPreflight struct {
+	ChartName       string   `json:"chartName,omitempty"`
+	Version         string   `json:"version,omitempty"`
+	ReleaseName     string   `json:"releaseName,omitempty"`
+	CurrentVersion  string   `json:"currentVersion,omitempty"`
+	CurrentRevision int      `json:"currentRevision,omitempty"`
+	Valid           bool     `json:"valid"`
+	Errors          []string `json:"errors,omitempty"`
+	Manifest        string   `json:"manifest,omitempty"`
+	Diff            string   `json:"diff,omitempty"`
+	Added           []string `json:"added,omitempty"`
+	Removed         []string `json:"removed,omitempty"`
+	Changed         []string `json:"changed,omitempty"`
+}
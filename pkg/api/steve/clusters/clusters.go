@@ -12,6 +12,7 @@
 	"github.com/rancher/rancher/pkg/auth/requests"
 	"github.com/rancher/rancher/pkg/clusterrouter"
 	normanv3 "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/sessionrecording"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/rancher/pkg/wrangler"
@@ -25,14 +26,19 @@
 )
 
 func Register(ctx context.Context, server *steve.Server, wrangler *wrangler.Context) error {
+	recordings := &recordings{
+		manager: sessionrecording.NewManager(wrangler.Core.Secret()),
+	}
 	log := &log{
-		cg: server.ClientFactory,
+		cg:         server.ClientFactory,
+		recordings: recordings.manager,
 	}
 	shell := &shell{
 		cg:              server.ClientFactory,
 		namespace:       "cattle-system",
 		impersonator:    podimpersonation.New("shell", server.ClientFactory, time.Hour, settings.FullShellImage),
 		clusterRegistry: server.ClusterRegistry,
+		recordings:      recordings.manager,
 	}
 	sc, err := config.NewScaledContext(*wrangler.RESTConfig, nil)
 	if err != nil {
@@ -64,6 +70,8 @@ func Register(ctx context.Context, server *steve.Server, wrangler *wrangler.Cont
 			}
 			schema.LinkHandlers["shell"] = shell
 			schema.LinkHandlers["log"] = log
+			schema.LinkHandlers["recordings"] = recordings
+			schema.LinkHandlers["recording"] = recordings
 			if schema.ActionHandlers == nil {
 				schema.ActionHandlers = map[string]http.Handler{}
 			}
@@ -38,7 +38,7 @@ func (k kubeconfigDownload) ServeHTTP(rw http.ResponseWriter, req *http.Request)
 		apiRequest.WriteError(validation.Unauthorized)
 		return
 	}
-	var tokenKey string
+	var tokenKey, authProvider string
 	var err error
 	generateToken := strings.EqualFold(settings.KubeconfigGenerateToken.Get(), "true")
 	if generateToken {
@@ -47,6 +47,13 @@ func (k kubeconfigDownload) ServeHTTP(rw http.ResponseWriter, req *http.Request)
 			apiRequest.WriteError(err)
 			return
 		}
+	} else {
+		authToken, err := k.auth.TokenFromRequest(req)
+		if err != nil {
+			apiRequest.WriteError(err)
+			return
+		}
+		authProvider = authToken.AuthProvider
 	}
 
 	host := settings.ServerURL.Get()
@@ -60,7 +67,12 @@ func (k kubeconfigDownload) ServeHTTP(rw http.ResponseWriter, req *http.Request)
 			host = apiRequest.Request.Host
 		}
 	}
-	cfg, err := kubeconfig.ForTokenBased(apiRequest.Name, apiRequest.Name, host, tokenKey)
+	var cfg string
+	if generateToken {
+		cfg, err = kubeconfig.ForTokenBased(apiRequest.Name, apiRequest.Name, host, tokenKey)
+	} else {
+		cfg, err = kubeconfig.ForExecBased(apiRequest.Name, apiRequest.Name, host, authProvider)
+	}
 	if err != nil {
 		apiRequest.WriteError(err)
 		return
@@ -10,6 +10,7 @@
 
 	"github.com/gorilla/websocket"
 	"github.com/rancher/apiserver/pkg/types"
+	"github.com/rancher/rancher/pkg/sessionrecording"
 	"github.com/rancher/steve/pkg/stores/proxy"
 	"github.com/rancher/wrangler/pkg/schemas/validation"
 	"github.com/sirupsen/logrus"
@@ -37,7 +38,8 @@ func onError(rw http.ResponseWriter, _ *http.Request, code int, err error) {
 }
 
 type log struct {
-	cg proxy.ClientGetter
+	cg         proxy.ClientGetter
+	recordings *sessionrecording.Manager
 }
 
 func (l *log) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
@@ -55,12 +57,12 @@ func (l *log) printLog(resp http.ResponseWriter, req *http.Request) error {
 	defer conn.Close()
 
 	apiRequest := types.GetAPIContext(req.Context())
-	client, err := l.cg.AdminK8sInterface()
+	ctx, user, client, err := l.contextAndClient(req)
 	if err != nil {
 		return err
 	}
 
-	w, err := client.CoreV1().ConfigMaps(apiRequest.Name).Watch(req.Context(), metav1.ListOptions{
+	w, err := client.CoreV1().ConfigMaps(apiRequest.Name).Watch(ctx, metav1.ListOptions{
 		TimeoutSeconds: &timeout,
 		FieldSelector:  "metadata.name=provisioning-log",
 	})
@@ -68,6 +70,16 @@ func (l *log) printLog(resp http.ResponseWriter, req *http.Request) error {
 		return err
 	}
 
+	recorder, err := l.recordings.Start(sessionrecording.KindLog, user.GetName(), clusterID(req))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := recorder.Close(); err != nil {
+			logrus.Errorf("Failed to save the recording of the cluster log of %s: %v", user.GetName(), err)
+		}
+	}()
+
 	var (
 		lastLine  = ""
 		printLine = true
@@ -99,7 +111,7 @@ func (l *log) printLog(resp http.ResponseWriter, req *http.Request) error {
 				printLine = true
 				continue
 			} else if printLine {
-				if err := printMessage(line, conn); err != nil {
+				if err := printMessage(line, conn, recorder); err != nil {
 					return err
 				}
 				printed = true
@@ -113,7 +125,7 @@ func (l *log) printLog(resp http.ResponseWriter, req *http.Request) error {
 				if line == "" {
 					continue
 				}
-				if err := printMessage(line, conn); err != nil {
+				if err := printMessage(line, conn, recorder); err != nil {
 					return err
 				}
 				lastLine = line
@@ -125,7 +137,8 @@ func (l *log) printLog(resp http.ResponseWriter, req *http.Request) error {
 	return nil
 }
 
-func printMessage(msg string, conn *websocket.Conn) error {
+func printMessage(msg string, conn *websocket.Conn, recorder *sessionrecording.Recorder) error {
+	recorder.Output([]byte(msg + "\r\n"))
 	writer, err := conn.NextWriter(websocket.TextMessage)
 	if err != nil {
 		return err
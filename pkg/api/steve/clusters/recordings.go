@@ -0,0 +1,79 @@
+package clusters
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/rancher/apiserver/pkg/apierror"
+	"github.com/rancher/apiserver/pkg/types"
+	"github.com/rancher/rancher/pkg/api/steve/access"
+	"github.com/rancher/rancher/pkg/clusterrouter"
+	"github.com/rancher/rancher/pkg/sessionrecording"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+)
+
+// recordings serves the shell and log sessions recorded on a cluster, only to administrators. The recordings link
+// lists the sessions and the recording link downloads the asciicast transcript of the session set by the session
+// query parameter.
+type recordings struct {
+	manager *sessionrecording.Manager
+}
+
+func (r *recordings) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	apiRequest := types.GetAPIContext(req.Context())
+	if !access.IsAdmin(apiRequest) {
+		apiRequest.WriteError(apierror.NewAPIError(validation.PermissionDenied, "session recordings can only be read by administrators"))
+		return
+	}
+
+	switch apiRequest.Link {
+	case "recordings":
+		if err := r.serveList(apiRequest, rw); err != nil {
+			apiRequest.WriteError(err)
+		}
+	case "recording":
+		if err := r.serveRecording(apiRequest, rw, req); err != nil {
+			apiRequest.WriteError(err)
+		}
+	}
+}
+
+func (r *recordings) serveList(apiRequest *types.APIRequest, rw http.ResponseWriter) error {
+	sessions, err := r.manager.List(apiRequest.Name)
+	if err != nil {
+		return err
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(rw).Encode(map[string]interface{}{
+		"data": sessions,
+	})
+}
+
+func (r *recordings) serveRecording(apiRequest *types.APIRequest, rw http.ResponseWriter, req *http.Request) error {
+	name := req.URL.Query().Get("session")
+	if name == "" {
+		return apierror.NewAPIError(validation.MissingRequired, "session query parameter is required")
+	}
+
+	_, transcript, err := r.manager.Open(req.Context(), apiRequest.Name, name)
+	if err != nil {
+		return err
+	}
+	defer transcript.Close()
+
+	rw.Header().Set("Content-Type", "application/x-asciicast")
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.cast", name))
+	_, err = io.Copy(rw, transcript)
+	return err
+}
+
+// clusterID returns the cluster a shell or log request was sent to.
+func clusterID(req *http.Request) string {
+	if id := clusterrouter.GetClusterID(req); id != "" {
+		return id
+	}
+	return types.GetAPIContext(req.Context()).Name
+}
@@ -7,10 +7,12 @@
 	"strings"
 	"time"
 
+	"github.com/rancher/rancher/pkg/sessionrecording"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/steve/pkg/podimpersonation"
 	"github.com/rancher/steve/pkg/stores/proxy"
 	"github.com/rancher/wrangler/pkg/schemas/validation"
+	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -25,6 +27,7 @@ type shell struct {
 	impersonator    *podimpersonation.PodImpersonation
 	cg              proxy.ClientGetter
 	clusterRegistry string
+	recordings      *sessionrecording.Manager
 }
 
 func (s *shell) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
@@ -53,7 +56,19 @@ func (s *shell) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		defer cancel()
 		_ = client.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
 	}()
-	s.proxyRequest(rw, req, pod, client)
+
+	// the recording starts once the shell is up, a shell that fails to start has nothing to record
+	recorder, err := s.recordings.Start(sessionrecording.KindShell, user.GetName(), clusterID(req))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer func() {
+		if err := recorder.Close(); err != nil {
+			logrus.Errorf("Failed to save the recording of the shell of %s: %v", user.GetName(), err)
+		}
+	}()
+	s.proxyRequest(recorder.ResponseWriter(rw), req, pod, client)
 }
 
 func (s *shell) proxyRequest(rw http.ResponseWriter, req *http.Request, pod *v1.Pod, client kubernetes.Interface) {
@@ -0,0 +1,151 @@
+package machine
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corecontrollers "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	caNamespace  = "cattle-system"
+	caSecretName = "machine-ssh-ca"
+	caComment    = "rancher-machine-ssh-ca"
+	caKey        = "ca.key"
+
+	// certificateBackdate allows for clock skew between rancher and the machines
+	certificateBackdate = time.Minute
+)
+
+// sshCA is the certificate authority signing the short-lived ssh certificates of the users opening shells on
+// machines. The private key of the CA is generated once and kept in a secret of the local cluster, the machines
+// trust it through a cert-authority line in the authorized_keys of their ssh user.
+type sshCA struct {
+	lock    sync.Mutex
+	secrets corecontrollers.SecretClient
+	signer  ssh.Signer
+}
+
+func (c *sshCA) getSigner() (ssh.Signer, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.signer != nil {
+		return c.signer, nil
+	}
+
+	secret, err := c.secrets.Get(caNamespace, caSecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		secret, err = c.create()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(secret.Data[caKey])
+	if block == nil {
+		return nil, fmt.Errorf("secret %s/%s doesn't contain a PEM encoded key", caNamespace, caSecretName)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+	c.signer = signer
+	return signer, nil
+}
+
+func (c *sshCA) create() (*corev1.Secret, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := c.secrets.Create(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      caSecretName,
+			Namespace: caNamespace,
+		},
+		Data: map[string][]byte{
+			caKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+		},
+	})
+	if apierrors.IsAlreadyExists(err) {
+		// another rancher server created it first
+		return c.secrets.Get(caNamespace, caSecretName, metav1.GetOptions{})
+	}
+	return secret, err
+}
+
+// authorizedKey returns the line of authorized_keys trusting the CA to sign certificates of the ssh user.
+func (c *sshCA) authorizedKey() (string, error) {
+	signer, err := c.getSigner()
+	if err != nil {
+		return "", err
+	}
+	return "cert-authority " + strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))) + " " + caComment, nil
+}
+
+// issue generates a key pair for one ssh session and signs a certificate for it, valid for the ssh user of the
+// machine only and until the ttl expires. The key id identifies the rancher user in the logs of the machine.
+func (c *sshCA) issue(keyID, principal string, ttl time.Duration) (ssh.Signer, *ssh.Certificate, error) {
+	caSigner, err := c.getSigner()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial := make([]byte, 8)
+	if _, err := rand.Read(serial); err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	cert := &ssh.Certificate{
+		Key:             signer.PublicKey(),
+		Serial:          binary.BigEndian.Uint64(serial),
+		CertType:        ssh.UserCert,
+		KeyId:           keyID,
+		ValidPrincipals: []string{principal},
+		ValidAfter:      uint64(now.Add(-certificateBackdate).Unix()),
+		ValidBefore:     uint64(now.Add(ttl).Unix()),
+		Permissions: ssh.Permissions{
+			Extensions: map[string]string{
+				"permit-pty": "",
+			},
+		},
+	}
+	if err := cert.SignCert(rand.Reader, caSigner); err != nil {
+		return nil, nil, err
+	}
+
+	certSigner, err := ssh.NewCertSigner(cert, signer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certSigner, cert, nil
+}
@@ -0,0 +1,65 @@
+package machine
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func testCA(t *testing.T) *sshCA {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &sshCA{signer: signer}
+}
+
+func TestIssueCertificate(t *testing.T) {
+	ca := testCA(t)
+	signer, cert, err := ca.issue("u-abc@machine-1", "ubuntu", 5*time.Minute)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, cert, signer.PublicKey())
+	assert.Equal(t, "u-abc@machine-1", cert.KeyId)
+
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return string(auth.Marshal()) == string(ca.signer.PublicKey().Marshal())
+		},
+	}
+	_, err = checker.Authenticate(testConnMetadata("ubuntu"), cert)
+	assert.NoError(t, err)
+	_, err = checker.Authenticate(testConnMetadata("root"), cert)
+	assert.Error(t, err)
+
+	checker.Clock = func() time.Time {
+		return time.Now().Add(10 * time.Minute)
+	}
+	_, err = checker.Authenticate(testConnMetadata("ubuntu"), cert)
+	assert.Error(t, err)
+
+	authorizedKey, err := ca.authorizedKey()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(authorizedKey, "cert-authority ssh-ed25519 "))
+	assert.True(t, strings.HasSuffix(authorizedKey, " "+caComment))
+}
+
+type testConnMetadata string
+
+func (c testConnMetadata) User() string          { return string(c) }
+func (c testConnMetadata) SessionID() []byte     { return nil }
+func (c testConnMetadata) ClientVersion() []byte { return nil }
+func (c testConnMetadata) ServerVersion() []byte { return nil }
+func (c testConnMetadata) RemoteAddr() net.Addr  { return nil }
+func (c testConnMetadata) LocalAddr() net.Addr   { return nil }
@@ -8,10 +8,16 @@
 	"net/http"
 	"strconv"
 
+	"github.com/rancher/apiserver/pkg/apierror"
 	"github.com/rancher/apiserver/pkg/types"
+	"github.com/rancher/rancher/pkg/api/steve/access"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
 )
 
 func (s *sshClient) download(apiContext *types.APIRequest) error {
+	if !access.IsAdmin(apiContext) {
+		return apierror.NewAPIError(validation.PermissionDenied, "the ssh keys of machines can only be downloaded by administrators")
+	}
 	machineInfo, err := s.getSSHKey(apiContext.Namespace, apiContext.Name)
 	if err != nil {
 		return err
@@ -4,6 +4,8 @@
 	"net/http"
 
 	"github.com/rancher/apiserver/pkg/types"
+	"github.com/rancher/rancher/pkg/api/steve/access"
+	"github.com/rancher/rancher/pkg/sessionrecording"
 	"github.com/rancher/rancher/pkg/wrangler"
 	schema2 "github.com/rancher/steve/pkg/schema"
 	steve "github.com/rancher/steve/pkg/server"
@@ -13,6 +15,15 @@ func Register(server *steve.Server, clients *wrangler.Context) {
 	sshClient := &sshClient{
 		machines: clients.CAPI.Machine(),
 		secrets:  clients.Core.Secret(),
+		events:   clients.Core.Event(),
+		ca: &sshCA{
+			secrets: clients.Core.Secret(),
+		},
+		policy: &sshPolicy{
+			clusters: clients.Provisioning.Cluster().Cache(),
+			crtbs:    clients.Mgmt.ClusterRoleTemplateBinding().Cache(),
+		},
+		recordings: sessionrecording.NewManager(clients.Core.Secret()),
 	}
 
 	server.SchemaFactory.AddTemplate(schema2.Template{
@@ -30,6 +41,10 @@ func Register(server *steve.Server, clients *wrangler.Context) {
 					delete(resource.Links, "shell")
 					delete(resource.Links, "sshkeys")
 				}
+				// the keys of the machines are only given to administrators, the other users get short-lived certificates
+				if !access.IsAdmin(request) {
+					delete(resource.Links, "sshkeys")
+				}
 			}
 		},
 	})
@@ -0,0 +1,84 @@
+package machine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rancher/apiserver/pkg/apierror"
+	"github.com/rancher/apiserver/pkg/types"
+	"github.com/rancher/rancher/pkg/api/steve/access"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	provcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// sshPolicy decides which users can open a shell on the machines of a cluster from the roles they are bound to in
+// the cluster, the allowed and denied roles are set by the machine-ssh-allowed-roles and machine-ssh-denied-roles
+// settings. Administrators are always allowed.
+type sshPolicy struct {
+	clusters provcontrollers.ClusterCache
+	crtbs    mgmtcontrollers.ClusterRoleTemplateBindingCache
+}
+
+// check returns the name of the management cluster of the machine, or an error if the user isn't allowed to open
+// a shell on it.
+func (p *sshPolicy) check(apiRequest *types.APIRequest, user user.Info, machine *capi.Machine) (string, error) {
+	cluster, err := p.clusters.Get(machine.Namespace, machine.Spec.ClusterName)
+	if err != nil {
+		return "", err
+	}
+	if access.IsAdmin(apiRequest) {
+		return cluster.Status.ClusterName, nil
+	}
+
+	roles, err := p.clusterRoles(user, cluster.Status.ClusterName)
+	if err != nil {
+		return "", err
+	}
+	if !allowed(roles, settings.MachineSSHAllowedRoles.Get(), settings.MachineSSHDeniedRoles.Get()) {
+		return "", apierror.NewAPIError(validation.PermissionDenied,
+			fmt.Sprintf("the cluster roles of %s don't allow ssh into the machines of cluster %s", user.GetName(), cluster.Name))
+	}
+	return cluster.Status.ClusterName, nil
+}
+
+// clusterRoles returns the role templates the user is bound to in a management cluster, directly or through groups.
+func (p *sshPolicy) clusterRoles(user user.Info, clusterName string) ([]string, error) {
+	crtbs, err := p.crtbs.List(clusterName, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	groups := sets.NewString(user.GetGroups()...)
+	roles := sets.NewString()
+	for _, crtb := range crtbs {
+		if crtb.UserName == user.GetName() || (crtb.GroupPrincipalName != "" && groups.Has(crtb.GroupPrincipalName)) {
+			roles.Insert(crtb.RoleTemplateName)
+		}
+	}
+	return roles.List(), nil
+}
+
+// allowed returns whether none of the roles is denied and one is allowed, no role is needed when all are allowed.
+func allowed(roles []string, allowedRoles, deniedRoles string) bool {
+	allowedSet, deniedSet := splitRoles(allowedRoles), splitRoles(deniedRoles)
+	if deniedSet.HasAny(roles...) {
+		return false
+	}
+	return allowedSet.Has("*") || allowedSet.HasAny(roles...)
+}
+
+func splitRoles(roles string) sets.String {
+	result := sets.NewString()
+	for _, role := range strings.Split(roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			result.Insert(role)
+		}
+	}
+	return result
+}
@@ -0,0 +1,29 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		name          string
+		roles         []string
+		allowedRoles  string
+		deniedRoles   string
+		expectAllowed bool
+	}{
+		{"all allowed", nil, "*", "", true},
+		{"allowed role", []string{"cluster-member", "cluster-owner"}, "cluster-owner, nodes-manage", "", true},
+		{"no allowed role", []string{"cluster-member"}, "cluster-owner", "", false},
+		{"no role", nil, "cluster-owner", "", false},
+		{"denied role", []string{"cluster-owner", "read-only"}, "*", "read-only", false},
+		{"nothing allowed", []string{"cluster-owner"}, "", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectAllowed, allowed(test.roles, test.allowedRoles, test.deniedRoles))
+		})
+	}
+}
@@ -12,22 +12,36 @@
 	"io/ioutil"
 	"net/http"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/rancher/apiserver/pkg/types"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2/machineprovision"
 	capicontrollers "github.com/rancher/rancher/pkg/generated/controllers/cluster.x-k8s.io/v1beta1"
+	"github.com/rancher/rancher/pkg/sessionrecording"
+	"github.com/rancher/rancher/pkg/settings"
 	corecontrollers "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 type sshClient struct {
-	secrets  corecontrollers.SecretClient
-	machines capicontrollers.MachineClient
+	secrets    corecontrollers.SecretClient
+	machines   capicontrollers.MachineClient
+	events     corecontrollers.EventClient
+	ca         *sshCA
+	policy     *sshPolicy
+	recordings *sessionrecording.Manager
 }
 
+const minCertificateTTL = time.Minute
+
 var upgrader = websocket.Upgrader{
 	HandshakeTimeout: 5 * time.Second,
 	CheckOrigin:      func(r *http.Request) bool { return true },
@@ -62,6 +76,19 @@ func (s *sshClient) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 }
 
 func (s *sshClient) shell(apiRequest *types.APIRequest) error {
+	user, ok := request.UserFrom(apiRequest.Context())
+	if !ok {
+		return validation.Unauthorized
+	}
+	machine, err := s.machines.Get(apiRequest.Namespace, apiRequest.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	clusterName, err := s.policy.check(apiRequest, user, machine)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithCancel(apiRequest.Context())
 	defer cancel()
 
@@ -72,29 +99,34 @@ func (s *sshClient) shell(apiRequest *types.APIRequest) error {
 	}
 
 	defer conn.Close()
-	machineInfo, err := s.getSSHKey(apiRequest.Namespace, apiRequest.Name)
+	machineInfo, err := s.getMachineInfo(machine)
 	if err != nil {
 		return err
 	}
 
-	signer, err := ssh.ParsePrivateKey(machineInfo.IDRSA)
+	client, cert, err := s.dial(machineInfo, user.GetName())
 	if err != nil {
 		return err
 	}
+	defer client.Close()
 
-	addr := fmt.Sprintf("%s:%d", machineInfo.Driver.IPAddress, machineInfo.Driver.SSHPort)
-	client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
-		User: machineInfo.Driver.SSHUser,
-		Auth: []ssh.AuthMethod{
-			ssh.PublicKeys(signer),
-		},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-		Timeout:         30 * time.Second,
-	})
+	start := time.Now()
+	s.audit(machine, "SSHSessionStarted", fmt.Sprintf("%s opened an ssh session as %s with certificate %d valid until %s",
+		user.GetName(), machineInfo.Driver.SSHUser, cert.Serial, time.Unix(int64(cert.ValidBefore), 0).UTC().Format(time.RFC3339)))
+	defer func() {
+		s.audit(machine, "SSHSessionEnded", fmt.Sprintf("%s closed the ssh session with certificate %d after %s",
+			user.GetName(), cert.Serial, time.Since(start).Round(time.Second)))
+	}()
+
+	recorder, err := s.recordings.Start(sessionrecording.KindSSH, user.GetName(), clusterName)
 	if err != nil {
 		return err
 	}
-	defer client.Close()
+	defer func() {
+		if err := recorder.Close(); err != nil {
+			logrus.Errorf("Failed to save the recording of the ssh session of %s: %v", user.GetName(), err)
+		}
+	}()
 
 	session, err := client.NewSession()
 	if err != nil {
@@ -121,7 +153,7 @@ func (s *sshClient) shell(apiRequest *types.APIRequest) error {
 	go func() {
 		defer cancel()
 		defer conn.Close()
-		io.Copy(&writer{conn: conn}, stdOut)
+		io.Copy(&writer{conn: conn, recorder: recorder}, stdOut)
 	}()
 
 	for {
@@ -138,6 +170,7 @@ func (s *sshClient) shell(apiRequest *types.APIRequest) error {
 			if err != nil {
 				return err
 			}
+			recorder.Input(data)
 			if _, err := stdIn.Write(data); err != nil {
 				return err
 			}
@@ -150,6 +183,7 @@ func (s *sshClient) shell(apiRequest *types.APIRequest) error {
 			if err := json.Unmarshal(data, resize); err != nil {
 				return err
 			}
+			recorder.Resize(resize.Width, resize.Height)
 			if err := session.WindowChange(resize.Height, resize.Width); err != nil {
 				return err
 			}
@@ -157,6 +191,121 @@ func (s *sshClient) shell(apiRequest *types.APIRequest) error {
 	}
 }
 
+// dial connects to the machine with a short-lived certificate signed by the ssh CA. The key of the machine is only
+// used, from the server, to add the CA to the authorized keys of the ssh user the first time the certificate is
+// refused.
+func (s *sshClient) dial(machineInfo *machineInfo, userName string) (*ssh.Client, *ssh.Certificate, error) {
+	addr := fmt.Sprintf("%s:%d", machineInfo.Driver.IPAddress, machineInfo.Driver.SSHPort)
+	ttl := certificateTTL()
+	keyID := fmt.Sprintf("%s@%s", userName, machineInfo.Driver.MachineName)
+
+	certSigner, cert, err := s.ca.issue(keyID, machineInfo.Driver.SSHUser, ttl)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := sshDial(addr, machineInfo.Driver.SSHUser, certSigner)
+	if err == nil {
+		return client, cert, nil
+	} else if !isAuthError(err) {
+		return nil, nil, err
+	}
+
+	if err := s.trustCA(addr, machineInfo); err != nil {
+		return nil, nil, fmt.Errorf("failed to add the ssh CA to machine %s: %w", machineInfo.Driver.MachineName, err)
+	}
+	client, err = sshDial(addr, machineInfo.Driver.SSHUser, certSigner)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, cert, nil
+}
+
+// certificateTTL returns the lifetime of the certificates of machine shells, the setting is raised to
+// minCertificateTTL so that a certificate doesn't expire before it is used to log in.
+func certificateTTL() time.Duration {
+	ttl := time.Duration(settings.MachineSSHCertificateTTL.GetInt()) * time.Second
+	if ttl < minCertificateTTL {
+		return minCertificateTTL
+	}
+	return ttl
+}
+
+// isAuthError returns whether the machine refused the key an ssh connection was attempted with, as opposed to the
+// machine not being reachable.
+func isAuthError(err error) bool {
+	return strings.Contains(err.Error(), "unable to authenticate")
+}
+
+// trustCA adds the ssh CA to the authorized keys of the ssh user of the machine, using the key of the machine.
+func (s *sshClient) trustCA(addr string, machineInfo *machineInfo) error {
+	authorizedKey, err := s.ca.authorizedKey()
+	if err != nil {
+		return err
+	}
+	signer, err := ssh.ParsePrivateKey(machineInfo.IDRSA)
+	if err != nil {
+		return err
+	}
+	client, err := sshDial(addr, machineInfo.Driver.SSHUser, signer)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	output, err := session.CombinedOutput(fmt.Sprintf("umask 077 && mkdir -p ~/.ssh && touch ~/.ssh/authorized_keys && "+
+		"(grep -qxF '%[1]s' ~/.ssh/authorized_keys || echo '%[1]s' >> ~/.ssh/authorized_keys)", authorizedKey))
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, output)
+	}
+	return nil
+}
+
+func sshDial(addr, user string, signer ssh.Signer) (*ssh.Client, error) {
+	return ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User: user,
+		Auth: []ssh.AuthMethod{
+			ssh.PublicKeys(signer),
+		},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         30 * time.Second,
+	})
+}
+
+// audit records an event of an ssh session on the machine.
+func (s *sshClient) audit(machine *capi.Machine, reason, message string) {
+	logrus.Infof("[machine-ssh] machine %s/%s: %s", machine.Namespace, machine.Name, message)
+	now := metav1.Now()
+	_, err := s.events.Create(&corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: machine.Name + ".",
+			Namespace:    machine.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: capi.GroupVersion.String(),
+			Kind:       "Machine",
+			Namespace:  machine.Namespace,
+			Name:       machine.Name,
+			UID:        machine.UID,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           corev1.EventTypeNormal,
+		Source:         corev1.EventSource{Component: "rancher"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	})
+	if err != nil {
+		logrus.Errorf("Failed to record the ssh session event of machine %s/%s: %v", machine.Namespace, machine.Name, err)
+	}
+}
+
 type resizeRequest struct {
 	Height int
 	Width  int
@@ -176,14 +325,17 @@ type machineConfig struct {
 }
 
 func (s *sshClient) getSSHKey(machineNamespace, machineName string) (*machineInfo, error) {
-	result := &machineInfo{}
 	machine, err := s.machines.Get(machineNamespace, machineName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
+	return s.getMachineInfo(machine)
+}
 
+func (s *sshClient) getMachineInfo(machine *capi.Machine) (*machineInfo, error) {
+	result := &machineInfo{}
 	secretName := machineprovision.MachineStateSecretName(machine.Spec.InfrastructureRef.Name)
-	secret, err := s.secrets.Get(machineNamespace, secretName, metav1.GetOptions{})
+	secret, err := s.secrets.Get(machine.Namespace, secretName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -224,10 +376,12 @@ func (s *sshClient) getSSHKey(machineNamespace, machineName string) (*machineInf
 }
 
 type writer struct {
-	conn *websocket.Conn
+	conn     *websocket.Conn
+	recorder *sessionrecording.Recorder
 }
 
 func (w *writer) Write(buf []byte) (int, error) {
+	w.recorder.Output(buf)
 	data := []byte("1" + base64.StdEncoding.EncodeToString(buf))
 	m, err := w.conn.NextWriter(websocket.TextMessage)
 	if err != nil {
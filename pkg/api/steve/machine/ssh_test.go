@@ -0,0 +1,29 @@
+package machine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificateTTL(t *testing.T) {
+	defer settings.MachineSSHCertificateTTL.Set(settings.MachineSSHCertificateTTL.Default)
+
+	for value, expected := range map[string]time.Duration{
+		"600": 10 * time.Minute,
+		"0":   minCertificateTTL,
+		"-5":  minCertificateTTL,
+		"10":  minCertificateTTL,
+	} {
+		assert.NoError(t, settings.MachineSSHCertificateTTL.Set(value))
+		assert.Equal(t, expected, certificateTTL(), value)
+	}
+}
+
+func TestIsAuthError(t *testing.T) {
+	assert.True(t, isAuthError(errors.New("ssh: handshake failed: ssh: unable to authenticate, attempted methods [none publickey], no supported methods remain")))
+	assert.False(t, isAuthError(errors.New("dial tcp 10.0.0.1:22: i/o timeout")))
+}
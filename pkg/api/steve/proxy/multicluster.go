@@ -0,0 +1,362 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gmux "github.com/gorilla/mux"
+	"github.com/rancher/rancher/pkg/clusterrouter/ratelimit"
+	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	v1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	MultiClusterListPath = "/v1/multicluster/{path:.*}"
+
+	// pageSize is the number of objects requested at once from a cluster, large lists are read in chunks
+	pageSize = 500
+	// maxParallelClusters is the number of clusters listed at the same time
+	maxParallelClusters = 10
+
+	defaultClusterTimeout = 30 * time.Second
+	maxClusterTimeout     = 5 * time.Minute
+)
+
+// MultiClusterList lists a kubernetes resource in all the clusters the user can access, with the permissions of
+// the user in each cluster. GET /v1/multicluster/api/v1/pods?labelSelector=app=web lists the pods of all the
+// clusters, the clusters query parameter restricts the list to some clusters and timeoutSeconds bounds the time
+// spent listing each cluster. The objects are streamed as they are received, each one with the ID of its cluster,
+// and the clusters which couldn't be listed are reported with their error at the end of the response.
+type MultiClusterList struct {
+	handler     *Handler
+	clusters    v3.ClusterCache
+	localConfig *rest.Config
+}
+
+type clusterObject struct {
+	Cluster string          `json:"cluster"`
+	Object  json.RawMessage `json:"object"`
+}
+
+type clusterResult struct {
+	ID    string `json:"id"`
+	Count int    `json:"count"`
+	Error string `json:"error,omitempty"`
+}
+
+type listPage struct {
+	Metadata struct {
+		Continue string `json:"continue"`
+	} `json:"metadata"`
+	Items []json.RawMessage `json:"items"`
+}
+
+func NewMultiClusterList(sar v1.AuthorizationV1Interface,
+	dialerFactory ClusterDialerFactory,
+	clusters v3.ClusterCache,
+	localConfig *rest.Config) (*MultiClusterList, error) {
+	authorizer, err := newAuthorizer(sar)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiClusterList{
+		handler:     NewProxyHandler(authorizer, dialerFactory, clusters),
+		clusters:    clusters,
+		localConfig: localConfig,
+	}, nil
+}
+
+func (m *MultiClusterList) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	user, ok := request.UserFrom(req.Context())
+	if !ok || sets.NewString(user.GetGroups()...).Has("system:unauthenticated") {
+		http.Error(rw, "not authorized", http.StatusUnauthorized)
+		return
+	}
+	if req.Method != http.MethodGet {
+		http.Error(rw, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := "/" + gmux.Vars(req)["path"]
+	if !strings.HasPrefix(path, "/api/") && !strings.HasPrefix(path, "/apis/") {
+		http.Error(rw, "path must be a kubernetes list path such as /api/v1/pods", http.StatusBadRequest)
+		return
+	}
+	query := req.URL.Query()
+	if value := query.Get("watch"); value == "true" || value == "1" {
+		http.Error(rw, "watches are not supported", http.StatusBadRequest)
+		return
+	}
+	timeout, err := clusterTimeout(query.Get("timeoutSeconds"))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	clusterIDs, err := m.clusterIDs(req.Context(), user, query.Get("clusters"))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	listQuery := url.Values{}
+	for _, key := range []string{"labelSelector", "fieldSelector"} {
+		if value := query.Get(key); value != "" {
+			listQuery.Set(key, value)
+		}
+	}
+	listQuery.Set("limit", strconv.Itoa(pageSize))
+
+	m.stream(rw, req, user, clusterIDs, path, listQuery, timeout)
+}
+
+func clusterTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return defaultClusterTimeout, nil
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return 0, fmt.Errorf("invalid timeoutSeconds %s", value)
+	}
+	timeout := time.Duration(seconds) * time.Second
+	if timeout > maxClusterTimeout {
+		timeout = maxClusterTimeout
+	}
+	return timeout, nil
+}
+
+// clusterIDs returns the clusters the user can access, limited to the requested ones if any.
+func (m *MultiClusterList) clusterIDs(ctx context.Context, user user.Info, requested string) ([]string, error) {
+	clusters, err := m.clusters.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := sets.NewString()
+	for _, id := range strings.Split(requested, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			filter.Insert(id)
+		}
+	}
+
+	var result []string
+	for _, cluster := range clusters {
+		if filter.Len() > 0 && !filter.Has(cluster.Name) {
+			continue
+		}
+		if m.handler.canAccess(ctx, user, cluster.Name) {
+			result = append(result, cluster.Name)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// stream lists the clusters in parallel and writes the objects to the response as they are received.
+func (m *MultiClusterList) stream(rw http.ResponseWriter, req *http.Request, user user.Info, clusterIDs []string, path string, query url.Values, timeout time.Duration) {
+	objects := make(chan clusterObject, pageSize)
+	results := make([]clusterResult, len(clusterIDs))
+
+	var wg sync.WaitGroup
+	limit := make(chan struct{}, maxParallelClusters)
+	for i, clusterID := range clusterIDs {
+		wg.Add(1)
+		go func(i int, clusterID string) {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			results[i] = m.listCluster(req.Context(), user, clusterID, path, query, timeout, objects)
+		}(i, clusterID)
+	}
+	go func() {
+		wg.Wait()
+		close(objects)
+	}()
+
+	flusher, _ := rw.(http.Flusher)
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	io.WriteString(rw, `{"type":"collection","data":[`)
+
+	encoder := json.NewEncoder(rw)
+	first := true
+	for object := range objects {
+		if !first {
+			io.WriteString(rw, ",")
+		}
+		first = false
+		if err := encoder.Encode(object); err != nil {
+			// the client is gone, the lists are canceled with the context of the request
+			logrus.Debugf("multicluster list: failed to write object of cluster %s: %v", object.Cluster, err)
+		}
+		if flusher != nil && len(objects) == 0 {
+			flusher.Flush()
+		}
+	}
+
+	io.WriteString(rw, `],"clusters":`)
+	encoder.Encode(results)
+	io.WriteString(rw, "}")
+}
+
+// listCluster lists the resource in a cluster page by page, sending each object to the channel.
+func (m *MultiClusterList) listCluster(ctx context.Context, user user.Info, clusterID, path string, query url.Values, timeout time.Duration, objects chan<- clusterObject) clusterResult {
+	result := clusterResult{ID: clusterID}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, host, err := m.httpClient(user, clusterID)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer client.CloseIdleConnections()
+
+	query = copyValues(query)
+	for {
+		// every page is a request to the cluster, counted against the rate limits of the user and the cluster
+		release, err := acquire(ctx, clusterID, user.GetName())
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		page, err := listPageOf(ctx, client, host+path+"?"+query.Encode())
+		release()
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		for _, item := range page.Items {
+			select {
+			case objects <- clusterObject{Cluster: clusterID, Object: item}:
+				result.Count++
+			case <-ctx.Done():
+				result.Error = ctx.Err().Error()
+				return result
+			}
+		}
+		if page.Metadata.Continue == "" {
+			return result
+		}
+		query.Set("continue", page.Metadata.Continue)
+	}
+}
+
+// acquire takes a token from the rate limits of the user and the cluster for a request, waiting for the limits to
+// allow it until the context is done.
+func acquire(ctx context.Context, clusterID, userName string) (func(), error) {
+	for {
+		release, retryAfter, err := ratelimit.Default.Acquire(clusterID, userName, false)
+		if err == nil {
+			return release, nil
+		}
+		timer := time.NewTimer(retryAfter)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		}
+	}
+}
+
+func listPageOf(ctx context.Context, client *http.Client, url string) (*listPage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+		status := struct {
+			Message string `json:"message"`
+		}{}
+		if json.Unmarshal(body, &status) == nil && status.Message != "" {
+			return nil, fmt.Errorf("%d: %s", resp.StatusCode, status.Message)
+		}
+		return nil, fmt.Errorf("%d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	page := &listPage{}
+	return page, json.NewDecoder(resp.Body).Decode(page)
+}
+
+// httpClient returns a client sending requests to a cluster as the user, through the tunnel to the agent of the
+// cluster for downstream clusters. Closing the idle connections of the client closes its connections to a downstream
+// cluster, the client of the local cluster shares the cached transport of its config.
+func (m *MultiClusterList) httpClient(user user.Info, clusterID string) (*http.Client, string, error) {
+	var (
+		cfg  *rest.Config
+		base *http.Transport
+	)
+	if clusterID == "local" {
+		cfg = rest.CopyConfig(m.localConfig)
+	} else {
+		base = &http.Transport{
+			DialContext: m.handler.dialer,
+		}
+		cfg = &rest.Config{
+			// the dialer connects to the tunnel of the cluster, the host is only used to find the cluster
+			Host:      "http://" + clusterID,
+			UserAgent: rest.DefaultKubernetesUserAgent() + " cluster " + clusterID,
+			Transport: base,
+		}
+	}
+	cfg.Impersonate = rest.ImpersonationConfig{
+		UserName: user.GetName(),
+		Groups:   user.GetGroups(),
+		Extra:    user.GetExtra(),
+	}
+
+	transport, err := rest.TransportFor(cfg)
+	if err != nil {
+		return nil, "", err
+	}
+	if base != nil {
+		transport = &idleClosingTransport{RoundTripper: transport, base: base}
+	}
+	return &http.Client{Transport: transport}, strings.TrimSuffix(cfg.Host, "/"), nil
+}
+
+// idleClosingTransport lets http.Client.CloseIdleConnections reach the transport wrapped by the round trippers of
+// the rest config.
+type idleClosingTransport struct {
+	http.RoundTripper
+	base *http.Transport
+}
+
+func (t *idleClosingTransport) CloseIdleConnections() {
+	t.base.CloseIdleConnections()
+}
+
+func copyValues(values url.Values) url.Values {
+	result := url.Values{}
+	for k, v := range values {
+		result[k] = append([]string{}, v...)
+	}
+	return result
+}
@@ -0,0 +1,136 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rancher/remotedialer"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/client-go/rest"
+)
+
+func TestMultiClusterListStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Impersonate-User") != "u-1" || req.URL.Path != "/api/v1/pods" {
+			rw.WriteHeader(http.StatusForbidden)
+			rw.Write([]byte(`{"kind":"Status","message":"pods is forbidden"}`))
+			return
+		}
+		assert.Equal(t, "app=web", req.URL.Query().Get("labelSelector"))
+		if req.URL.Query().Get("continue") == "" {
+			rw.Write([]byte(`{"metadata":{"continue":"next"},"items":[{"metadata":{"name":"a"}},{"metadata":{"name":"b"}}]}`))
+			return
+		}
+		rw.Write([]byte(`{"metadata":{},"items":[{"metadata":{"name":"c"}}]}`))
+	}))
+	defer server.Close()
+
+	m := &MultiClusterList{
+		handler: &Handler{
+			dialerFactory: func(clusterID string) remotedialer.Dialer {
+				return func(ctx context.Context, network, address string) (net.Conn, error) {
+					if clusterID == "stv-cluster-c-down" {
+						return nil, errors.New("cluster is down")
+					}
+					return (&net.Dialer{}).DialContext(ctx, network, strings.TrimPrefix(server.URL, "http://"))
+				}
+			},
+		},
+		localConfig: &rest.Config{Host: server.URL},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/multicluster/api/v1/pods", nil)
+	rw := httptest.NewRecorder()
+	query := url.Values{"labelSelector": []string{"app=web"}}
+	m.stream(rw, req, &user.DefaultInfo{Name: "u-1"}, []string{"c-1", "c-down", "local"}, "/api/v1/pods", query, time.Minute)
+
+	output := struct {
+		Data     []clusterObject `json:"data"`
+		Clusters []clusterResult `json:"clusters"`
+	}{}
+	if !assert.NoError(t, json.Unmarshal(rw.Body.Bytes(), &output)) {
+		return
+	}
+
+	counts := map[string]int{}
+	for _, object := range output.Data {
+		counts[object.Cluster]++
+	}
+	assert.Equal(t, map[string]int{"c-1": 3, "local": 3}, counts)
+
+	assert.Equal(t, clusterResult{ID: "c-1", Count: 3}, output.Clusters[0])
+	assert.Equal(t, "c-down", output.Clusters[1].ID)
+	assert.Contains(t, output.Clusters[1].Error, "cluster is down")
+	assert.Equal(t, clusterResult{ID: "local", Count: 3}, output.Clusters[2])
+}
+
+func TestMultiClusterListForbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusForbidden)
+		rw.Write([]byte(`{"kind":"Status","message":"pods is forbidden"}`))
+	}))
+	defer server.Close()
+
+	m := &MultiClusterList{
+		handler:     &Handler{},
+		localConfig: &rest.Config{Host: server.URL},
+	}
+	objects := make(chan clusterObject, 1)
+	result := m.listCluster(context.Background(), &user.DefaultInfo{Name: "u-2"}, "local", "/api/v1/pods", url.Values{}, time.Minute, objects)
+	assert.Equal(t, clusterResult{ID: "local", Error: "403: pods is forbidden"}, result)
+}
+
+func TestClusterTimeout(t *testing.T) {
+	timeout, err := clusterTimeout("")
+	assert.NoError(t, err)
+	assert.Equal(t, defaultClusterTimeout, timeout)
+
+	timeout, err = clusterTimeout("3600")
+	assert.NoError(t, err)
+	assert.Equal(t, maxClusterTimeout, timeout)
+
+	_, err = clusterTimeout("-1")
+	assert.Error(t, err)
+}
+
+func TestMultiClusterListClosesConnections(t *testing.T) {
+	closed := make(chan struct{}, 1)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"metadata":{},"items":[{"metadata":{"name":"a"}}]}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateClosed {
+			closed <- struct{}{}
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	m := &MultiClusterList{
+		handler: &Handler{
+			dialerFactory: func(clusterID string) remotedialer.Dialer {
+				return func(ctx context.Context, network, address string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, network, strings.TrimPrefix(server.URL, "http://"))
+				}
+			},
+		},
+	}
+	objects := make(chan clusterObject, 1)
+	result := m.listCluster(context.Background(), &user.DefaultInfo{Name: "u-1"}, "c-1", "/api/v1/pods", url.Values{}, time.Minute, objects)
+	assert.Equal(t, clusterResult{ID: "c-1", Count: 1}, result)
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Error("the connection to the cluster is still open after the list")
+	}
+}
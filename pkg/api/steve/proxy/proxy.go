@@ -9,6 +9,7 @@
 	"time"
 
 	gmux "github.com/gorilla/mux"
+	"github.com/rancher/rancher/pkg/clusterrouter/ratelimit"
 	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	managementv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/settings"
@@ -49,14 +50,7 @@ func NewProxyMiddleware(sar v1.AuthorizationV1Interface,
 	clusters v3.ClusterCache,
 	localSupport bool,
 	localCluster http.Handler) (func(http.Handler) http.Handler, error) {
-	cfg := authorizerfactory.DelegatingAuthorizerConfig{
-		SubjectAccessReviewClient: sar,
-		AllowCacheTTL:             time.Second * time.Duration(settings.AuthorizationCacheTTLSeconds.GetInt()),
-		DenyCacheTTL:              time.Second * time.Duration(settings.AuthorizationDenyCacheTTLSeconds.GetInt()),
-		WebhookRetryBackoff:       &auth.WebhookBackoff,
-	}
-
-	authorizer, err := cfg.New()
+	authorizer, err := newAuthorizer(sar)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +72,16 @@ func NewProxyMiddleware(sar v1.AuthorizationV1Interface,
 	}, nil
 }
 
+func newAuthorizer(sar v1.AuthorizationV1Interface) (authorizer.Authorizer, error) {
+	cfg := authorizerfactory.DelegatingAuthorizerConfig{
+		SubjectAccessReviewClient: sar,
+		AllowCacheTTL:             time.Second * time.Duration(settings.AuthorizationCacheTTLSeconds.GetInt()),
+		DenyCacheTTL:              time.Second * time.Duration(settings.AuthorizationDenyCacheTTLSeconds.GetInt()),
+		WebhookRetryBackoff:       &auth.WebhookBackoff,
+	}
+	return cfg.New()
+}
+
 func routeToShellProxy(key, value string, localSupport bool, localCluster http.Handler, mux *gmux.Router, proxyHandler *Handler) func(rw http.ResponseWriter, r *http.Request) {
 	return func(rw http.ResponseWriter, r *http.Request) {
 		vars := gmux.Vars(r)
@@ -148,7 +152,7 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	handler.ServeHTTP(rw, req)
+	ratelimit.Default.Handler(clusterID, handler).ServeHTTP(rw, req)
 }
 
 func (h *Handler) dialer(ctx context.Context, network, address string) (net.Conn, error) {
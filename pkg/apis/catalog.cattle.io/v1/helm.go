@@ -17,9 +17,45 @@ type App struct {
 	Status ReleaseStatus `json:"status,omitempty"`
 }
 
+const (
+	// UpgradePolicyAnnotation opts an app installed from a ClusterRepo into automatic upgrades when the index of
+	// the repo is refreshed, the value is one of the UpgradePolicy* constants. Upgrades run as the service
+	// account of the repo and apps of repos without one aren't upgraded.
+	UpgradePolicyAnnotation = "catalog.cattle.io/upgrade-policy"
+	// UpgradeWindowScheduleAnnotation is the cron schedule of the maintenance window opening for automatic upgrades,
+	// the app is upgraded as soon as a new version is available when unset.
+	UpgradeWindowScheduleAnnotation = "catalog.cattle.io/upgrade-window-schedule"
+	// UpgradeWindowMinutesAnnotation is how long the maintenance window stays open, 60 minutes when unset.
+	UpgradeWindowMinutesAnnotation = "catalog.cattle.io/upgrade-window-minutes"
+
+	// UpgradePolicyPatch follows the latest patch version of the installed minor version.
+	UpgradePolicyPatch = "patch"
+	// UpgradePolicyMinor follows the latest minor version of the installed major version.
+	UpgradePolicyMinor = "minor"
+	// UpgradePolicyPinned keeps the installed version.
+	UpgradePolicyPinned = "pinned"
+)
+
 type ReleaseStatus struct {
 	Summary            Summary `json:"summary,omitempty"`
 	ObservedGeneration int64   `json:"observedGeneration"`
+	// Upgrade records the last automatic upgrade of the app
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+}
+
+type UpgradeStatus struct {
+	// Policy is the upgrade policy which triggered the upgrade
+	Policy string `json:"policy,omitempty"`
+	// FromVersion is the chart version the app was upgraded from
+	FromVersion string `json:"fromVersion,omitempty"`
+	// ToVersion is the chart version the app was upgraded to
+	ToVersion string `json:"toVersion,omitempty"`
+	// Operation is the namespace and name of the helm operation running the upgrade
+	Operation string `json:"operation,omitempty"`
+	// LastAttempt is the time when the upgrade operation was last created
+	LastAttempt metav1.Time `json:"lastAttempt,omitempty"`
+	// Error is set when the upgrade operation couldn't be created or the upgrade failed
+	Error string `json:"error,omitempty"`
 }
 
 type Summary struct {
@@ -23,7 +23,8 @@ type SecretReference struct {
 }
 
 type RepoSpec struct {
-	// URL A http URL of the repo to connect to
+	// URL A http URL of the repo to connect to, or an oci:// URL of a registry, a namespace of a registry or a
+	// chart repository of a registry
 	URL string `json:"url,omitempty"`
 
 	// GitRepo a git repo to clone and index as the helm repo
@@ -32,6 +33,16 @@ type RepoSpec struct {
 	// GitBranch The git branch to follow
 	GitBranch string `json:"gitBranch,omitempty"`
 
+	// GitTagConstraint follows the latest tag of the git repo which is a semantic version matching the
+	// constraint instead of GitBranch, "*" follows every release
+	GitTagConstraint string `json:"gitTagConstraint,omitempty"`
+
+	// GitSubPath the directory of the git repo holding the charts, only this directory is checked out and indexed
+	GitSubPath string `json:"gitSubPath,omitempty"`
+
+	// GitSubmodules checks out the submodules of the git repo
+	GitSubmodules bool `json:"gitSubmodules,omitempty"`
+
 	// CABundle is a PEM encoded CA bundle which will be used to validate the repo's certificate.
 	// If unspecified, system trust roots will be used.
 	CABundle []byte `json:"caBundle,omitempty"`
@@ -45,6 +56,19 @@ type RepoSpec struct {
 	// For a repo the Namespace file will be ignored
 	ClientSecret *SecretReference `json:"clientSecret,omitempty"`
 
+	// VerificationSecret is the secret holding the keys trusted to sign the charts of the repo.
+	// The key "keyring" holds a PGP keyring verifying Helm provenance files and the key "cosign.pub"
+	// PEM encoded public keys verifying cosign signatures of charts in OCI registries.
+	// For a repo the Namespace file will be ignored
+	VerificationSecret *SecretReference `json:"verificationSecret,omitempty"`
+
+	// RequireSignedCharts only allows installing and upgrading charts of the repo whose signature is verified
+	// with the keys of VerificationSecret. Charts with an invalid signature are rejected either way.
+	// Charts of git repos can't be signed, and the repos Rancher installs its own charts from are always trusted.
+	// Signed charts are required from every repo when the require-signed-charts feature is enabled, whatever the
+	// value of this field.
+	RequireSignedCharts bool `json:"requireSignedCharts,omitempty"`
+
 	// BasicAuthSecretName is the client secret to be used to connect to the repo
 	BasicAuthSecretName string `json:"basicAuthSecretName,omitempty"`
 
@@ -52,6 +76,10 @@ type RepoSpec struct {
 	// If ForceUpdate is greater than time.Now() it will not trigger an update
 	ForceUpdate *metav1.Time `json:"forceUpdate,omitempty"`
 
+	// RefreshInterval is the number of seconds between downloads of the repo index, 5 minutes when unset.
+	// Intervals below 60 seconds are raised to 60 seconds.
+	RefreshInterval int `json:"refreshInterval,omitempty"`
+
 	// ServiceAccount this service account will be used to deploy charts instead of the end users credentials
 	ServiceAccount string `json:"serviceAccount,omitempty"`
 
@@ -93,6 +121,18 @@ type RepoStatus struct {
 	// The git commit used to generate the index
 	Commit string `json:"commit,omitempty"`
 
+	// The git tag used to generate the index when following tags
+	Tag string `json:"tag,omitempty"`
+
+	// The git sub-path and submodules settings used to generate the index
+	SubPath    string `json:"subPath,omitempty"`
+	Submodules bool   `json:"submodules,omitempty"`
+
+	// IndexETag and IndexLastModified are the validators returned with the last downloaded index.yaml,
+	// the index is only downloaded and parsed again once the server reports it changed
+	IndexETag         string `json:"indexETag,omitempty"`
+	IndexLastModified string `json:"indexLastModified,omitempty"`
+
 	Conditions []genericcondition.GenericCondition `json:"conditions,omitempty"`
 }
 
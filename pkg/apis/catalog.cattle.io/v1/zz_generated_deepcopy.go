@@ -31,7 +31,7 @@ func (in *App) DeepCopyInto(out *App) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -389,6 +389,11 @@ func (in *ReleaseSpec) DeepCopy() *ReleaseSpec {
 func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
 	*out = *in
 	out.Summary = in.Summary
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -415,6 +420,11 @@ func (in *RepoSpec) DeepCopyInto(out *RepoSpec) {
 		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.VerificationSecret != nil {
+		in, out := &in.VerificationSecret, &out.VerificationSecret
+		*out = new(SecretReference)
+		**out = **in
+	}
 	if in.ForceUpdate != nil {
 		in, out := &in.ForceUpdate, &out.ForceUpdate
 		*out = (*in).DeepCopy()
@@ -490,3 +500,20 @@ func (in *Summary) DeepCopy() *Summary {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+	in.LastAttempt.DeepCopyInto(&out.LastAttempt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
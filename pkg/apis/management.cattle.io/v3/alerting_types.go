@@ -194,6 +194,55 @@ func (p *ProjectGroupSpec) ObjClusterName() string {
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// AlertMaintenanceWindow silences the alerts of a cluster while planned maintenance is underway. Without
+// a project, node selector or group the window applies to every alert of the cluster.
+type AlertMaintenanceWindow struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AlertMaintenanceWindowSpec `json:"spec"`
+	// Most recent observed status of the window. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Status AlertMaintenanceWindowStatus `json:"status"`
+}
+
+func (a *AlertMaintenanceWindow) ObjClusterName() string {
+	return a.Spec.ObjClusterName()
+}
+
+type AlertMaintenanceWindowSpec struct {
+	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
+	DisplayName string `json:"displayName,omitempty" norman:"required"`
+	Description string `json:"description,omitempty"`
+	// StartsAt and EndsAt define a one-off window, Recurrence a recurring one.
+	StartsAt   string             `json:"startsAt,omitempty" norman:"type=date"`
+	EndsAt     string             `json:"endsAt,omitempty" norman:"type=date"`
+	Recurrence *MaintenanceWindow `json:"recurrence,omitempty"`
+	// ProjectName, NodeSelector and GroupName narrow down the alerts that are silenced.
+	ProjectName  string            `json:"projectName,omitempty" norman:"type=reference[project]"`
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	GroupName    string            `json:"groupName,omitempty"`
+}
+
+func (a *AlertMaintenanceWindowSpec) ObjClusterName() string {
+	return a.ClusterName
+}
+
+type AlertMaintenanceWindowStatus struct {
+	Active bool `json:"active"`
+	// CurrentStartsAt and CurrentEndsAt are the bounds of the ongoing occurrence, or of the next one if the
+	// window isn't active.
+	CurrentStartsAt string `json:"currentStartsAt,omitempty"`
+	CurrentEndsAt   string `json:"currentEndsAt,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type ClusterAlertRule struct {
 	types.Namespaced
 
@@ -221,6 +270,7 @@ type ClusterAlertRuleSpec struct {
 	SystemServiceRule *SystemServiceRule `json:"systemServiceRule,omitempty"`
 	MetricRule        *MetricRule        `json:"metricRule,omitempty"`
 	ClusterScanRule   *ClusterScanRule   `json:"clusterScanRule,omitempty"`
+	CertificateRule   *CertificateRule   `json:"certificateRule,omitempty"`
 }
 
 func (c *ClusterAlertRuleSpec) ObjClusterName() string {
@@ -250,11 +300,12 @@ func (p *ProjectAlertRule) ObjClusterName() string {
 
 type ProjectAlertRuleSpec struct {
 	CommonRuleField
-	ProjectName  string        `json:"projectName" norman:"type=reference[project]"`
-	GroupName    string        `json:"groupName" norman:"type=reference[projectAlertGroup]"`
-	PodRule      *PodRule      `json:"podRule,omitempty"`
-	WorkloadRule *WorkloadRule `json:"workloadRule,omitempty"`
-	MetricRule   *MetricRule   `json:"metricRule,omitempty"`
+	ProjectName               string                     `json:"projectName" norman:"type=reference[project]"`
+	GroupName                 string                     `json:"groupName" norman:"type=reference[projectAlertGroup]"`
+	PodRule                   *PodRule                   `json:"podRule,omitempty"`
+	WorkloadRule              *WorkloadRule              `json:"workloadRule,omitempty"`
+	MetricRule                *MetricRule                `json:"metricRule,omitempty"`
+	PersistentVolumeClaimRule *PersistentVolumeClaimRule `json:"persistentVolumeClaimRule,omitempty"`
 }
 
 func (p *ProjectAlertRuleSpec) ObjClusterName() string {
@@ -278,8 +329,9 @@ type CommonRuleField struct {
 }
 
 type ClusterScanRule struct {
-	ScanRunType  ClusterScanRunType `json:"scanRunType,omitempty" norman:"required,options=manual|scheduled,default=scheduled"`
-	FailuresOnly bool               `json:"failuresOnly,omitempty"`
+	ScanRunType     ClusterScanRunType `json:"scanRunType,omitempty" norman:"required,options=manual|scheduled,default=scheduled"`
+	FailuresOnly    bool               `json:"failuresOnly,omitempty"`
+	RegressionsOnly bool               `json:"regressionsOnly,omitempty"`
 }
 
 type MetricRule struct {
@@ -326,6 +378,68 @@ type SystemServiceRule struct {
 	Condition string `json:"condition,omitempty" norman:"required,options=etcd|controller-manager|scheduler,default=scheduler"`
 }
 
+// PersistentVolumeClaimRule alerts on a claim, or on the claims of the project matching Selector, that is
+// filled above UsageThreshold percent or that stays unbound for longer than PendingSeconds.
+type PersistentVolumeClaimRule struct {
+	PersistentVolumeClaimID string            `json:"persistentVolumeClaimId,omitempty"`
+	Selector                map[string]string `json:"selector,omitempty"`
+	Condition               string            `json:"condition,omitempty" norman:"required,options=usage|pending,default=usage"`
+	UsageThreshold          int               `json:"usageThreshold,omitempty" norman:"min=1,max=100,default=80"`
+	PendingSeconds          int               `json:"pendingSeconds,omitempty" norman:"min=1,default=300"`
+}
+
+// CertificateRule alerts on the kubernetes.io/tls secrets of the cluster whose certificate expires within
+// ExpiryDays. Namespace and Selector narrow down the secrets that are checked.
+type CertificateRule struct {
+	Namespace  string            `json:"namespace,omitempty"`
+	Selector   map[string]string `json:"selector,omitempty"`
+	ExpiryDays int               `json:"expiryDays,omitempty" norman:"required,min=1,default=14"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AlertHistory is the append-only record of the state changes of an alert rule. It lives next to the rule,
+// has the same name and is bounded by the alert-history-max-entries and alert-history-retention-days settings.
+type AlertHistory struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AlertHistorySpec `json:"spec"`
+}
+
+func (a *AlertHistory) ObjClusterName() string {
+	return a.Spec.ObjClusterName()
+}
+
+type AlertHistorySpec struct {
+	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
+	ProjectName string `json:"projectName,omitempty" norman:"type=reference[project]"`
+	// RuleName is the id of the cluster or project alert rule, GroupName the id of its group.
+	RuleName    string              `json:"ruleName,omitempty"`
+	GroupName   string              `json:"groupName,omitempty"`
+	DisplayName string              `json:"displayName,omitempty"`
+	Severity    string              `json:"severity,omitempty"`
+	Entries     []AlertHistoryEntry `json:"entries,omitempty"`
+}
+
+func (a *AlertHistorySpec) ObjClusterName() string {
+	return a.ClusterName
+}
+
+type AlertHistoryEntry struct {
+	Time          string `json:"time,omitempty" norman:"type=date"`
+	State         string `json:"state,omitempty"`
+	PreviousState string `json:"previousState,omitempty"`
+	// ChangedBy is the user that muted, unmuted, activated or deactivated the rule. It is empty for the
+	// state changes reported by alertmanager.
+	ChangedBy string `json:"changedBy,omitempty" norman:"type=reference[user]"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
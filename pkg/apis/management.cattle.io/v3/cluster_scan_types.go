@@ -46,6 +46,8 @@ type CisScanConfig struct {
 	DebugMaster bool `json:"debugMaster"`
 	// Internal flag for debugging worker component of the scan
 	DebugWorker bool `json:"debugWorker"`
+	// Benchmark profile to use, its benchmark version, base profile and skipped checks apply to the scan
+	BenchmarkProfile string `json:"benchmarkProfile,omitempty" norman:"type=reference[cisBenchmarkProfile]"`
 }
 
 type CisScanStatus struct {
@@ -54,6 +56,7 @@ type CisScanStatus struct {
 	Fail          int `json:"fail"`
 	Skip          int `json:"skip"`
 	NotApplicable int `json:"notApplicable"`
+	AcceptedRisk  int `json:"acceptedRisk"`
 }
 
 type ClusterScanConfig struct {
@@ -88,6 +91,8 @@ type ClusterScanSpec struct {
 type ClusterScanStatus struct {
 	Conditions    []ClusterScanCondition `json:"conditions"`
 	CisScanStatus *CisScanStatus         `json:"cisScanStatus"`
+	// Failed checks reported as accepted risk by the benchmark profile of the scan
+	AcceptedRisks []CisCheckException `json:"acceptedRisks,omitempty"`
 }
 
 // +genclient
@@ -127,6 +132,36 @@ type CisBenchmarkVersion struct {
 	Info kdm.CisBenchmarkVersionInfo `json:"info" yaml:"info"`
 }
 
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CisBenchmarkProfile struct {
+	types.Namespaced
+
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CisBenchmarkProfileSpec `json:"spec"`
+}
+
+type CisBenchmarkProfileSpec struct {
+	DisplayName string `json:"displayName,omitempty" norman:"required"`
+	Description string `json:"description,omitempty"`
+	// Benchmark version the profile is based on
+	BenchmarkVersion string `json:"benchmarkVersion,omitempty" norman:"required"`
+	// Built-in profile whose default skips apply on top of the ones of this profile
+	BaseProfile CisScanProfileType `json:"baseProfile,omitempty" norman:"options=permissive|hardened,default=permissive"`
+	// Checks that are not run
+	SkipChecks []CisCheckException `json:"skipChecks,omitempty"`
+	// Checks that run but whose failures are reported as accepted risk
+	AcceptedRisks []CisCheckException `json:"acceptedRisks,omitempty"`
+}
+
+type CisCheckException struct {
+	ID            string `json:"id,omitempty" norman:"required"`
+	Justification string `json:"justification,omitempty" norman:"required"`
+}
+
 type ScheduledClusterScanConfig struct {
 	// Cron Expression for Schedule
 	CronSchedule string `yaml:"cron_schedule" json:"cronSchedule,omitempty"`
@@ -71,6 +71,8 @@ func init() {
 	ClusterConditionPrometheusOperatorDeployed condition.Cond = "PrometheusOperatorDeployed"
 	ClusterConditionMonitoringEnabled          condition.Cond = "MonitoringEnabled"
 	ClusterConditionAlertingEnabled            condition.Cond = "AlertingEnabled"
+	// ClusterConditionCertificatesRotated records the last automatic certificate rotation triggered by the CertificateRotationPolicy
+	ClusterConditionCertificatesRotated condition.Cond = "CertificatesRotated"
 
 	ClusterDriverImported = "imported"
 	ClusterDriverLocal    = "local"
@@ -116,6 +118,7 @@ type ClusterSpecBase struct {
 	WindowsPreferedCluster               bool                                    `json:"windowsPreferedCluster" norman:"noupdate"`
 	LocalClusterAuthEndpoint             LocalClusterAuthEndpoint                `json:"localClusterAuthEndpoint,omitempty"`
 	ScheduledClusterScan                 *ScheduledClusterScan                   `json:"scheduledClusterScan,omitempty"`
+	CertificateRotationPolicy            *CertificateRotationPolicy              `json:"certificateRotationPolicy,omitempty"`
 }
 
 type ClusterSpec struct {
@@ -354,6 +357,23 @@ type CertExpiration struct {
 	ExpirationDate string `json:"expirationDate,omitempty"`
 }
 
+// CertificateRotationPolicy configures automatic rotation of RKE cluster certificates ahead of their expiration
+type CertificateRotationPolicy struct {
+	Enabled bool `json:"enabled" norman:"default=false"`
+	// Rotate once any certificate is within this many days of expiring
+	DaysBeforeExpiry int `json:"daysBeforeExpiry,omitempty" norman:"default=30,min=1"`
+	// Rotate the CA certificates as well as the component certificates
+	CACertificates bool `json:"caCertificates,omitempty"`
+	// Only rotate while the maintenance window is open, rotate as soon as needed when unset
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// MaintenanceWindow is a recurring window that opens on CronSchedule and stays open for DurationMinutes
+type MaintenanceWindow struct {
+	CronSchedule    string `json:"cronSchedule" norman:"required"`
+	DurationMinutes int    `json:"durationMinutes" norman:"required,min=1"`
+}
+
 type SaveAsTemplateInput struct {
 	ClusterTemplateName         string `json:"clusterTemplateName,omitempty"`
 	ClusterTemplateRevisionName string `json:"clusterTemplateRevisionName,omitempty"`
@@ -38,6 +38,15 @@ type KontainerDriverSpec struct {
 	WhitelistDomains []string `json:"whitelistDomains,omitempty"`
 }
 
+type MetadataBundleInput struct {
+	// Bundle is the base64 encoded, signed release metadata tarball
+	Bundle string `json:"bundle,omitempty" norman:"required"`
+}
+
+type MetadataBundleOutput struct {
+	Version string `json:"version,omitempty"`
+}
+
 var (
 	KontainerDriverConditionDownloaded condition.Cond = "Downloaded"
 	KontainerDriverConditionInstalled  condition.Cond = "Installed"
@@ -66,6 +66,8 @@ type LoggingTargets struct {
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty"`
 	SyslogConfig          *SyslogConfig          `json:"syslogConfig,omitempty"`
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty"`
+	OTLPConfig            *OTLPConfig            `json:"otlpConfig,omitempty"`
+	LokiConfig            *LokiConfig            `json:"lokiConfig,omitempty"`
 	CustomTargetConfig    *CustomTargetConfig    `json:"customTargetConfig,omitempty"`
 }
 
@@ -83,7 +85,8 @@ func (c *ClusterLoggingSpec) ObjClusterName() string {
 type ProjectLoggingSpec struct {
 	LoggingTargets
 	LoggingCommonField
-	ProjectName string `json:"projectName" norman:"type=reference[project]"`
+	ProjectName string            `json:"projectName" norman:"type=reference[project]"`
+	RateLimit   *LoggingRateLimit `json:"rateLimit,omitempty"`
 }
 
 func (p *ProjectLoggingSpec) ObjClusterName() string {
@@ -100,8 +103,27 @@ type ClusterLoggingStatus struct {
 }
 
 type ProjectLoggingStatus struct {
-	Conditions  []LoggingCondition `json:"conditions,omitempty"`
-	AppliedSpec ProjectLoggingSpec `json:"appliedSpec,omitempty"`
+	Conditions     []LoggingCondition     `json:"conditions,omitempty"`
+	AppliedSpec    ProjectLoggingSpec     `json:"appliedSpec,omitempty"`
+	DroppedRecords *LoggingDroppedRecords `json:"droppedRecords,omitempty"`
+}
+
+// LoggingRateLimit throttles the container logs forwarded by a project logging. Limits are numbers of records
+// accepted per period, the records above a limit are dropped until the period ends. NamespaceLimits overrides
+// NamespaceLimit for some namespaces of the project, a limit of 0 doesn't throttle.
+type LoggingRateLimit struct {
+	PeriodSeconds   int            `json:"periodSeconds,omitempty" norman:"default=60,min=1"`
+	ProjectLimit    int            `json:"projectLimit,omitempty" norman:"min=0"`
+	NamespaceLimit  int            `json:"namespaceLimit,omitempty" norman:"min=0"`
+	NamespaceLimits map[string]int `json:"namespaceLimits,omitempty"`
+}
+
+// LoggingDroppedRecords counts the records dropped by the rate limits of a project logging, summed over the fluentd
+// pods of the cluster since they started.
+type LoggingDroppedRecords struct {
+	Total       int64            `json:"total"`
+	Namespaces  map[string]int64 `json:"namespaces,omitempty"`
+	LastUpdated string           `json:"lastUpdated,omitempty"`
 }
 
 var (
@@ -197,6 +219,40 @@ type FluentServer struct {
 	SharedKey string `json:"sharedKey,omitempty" norman:"type=password"`
 }
 
+// OTLPConfig forwards logs to an OpenTelemetry collector over OTLP/HTTP. Labels maps log attribute names to
+// the source of their value, one of namespace, project, workload, pod, container or node.
+type OTLPConfig struct {
+	Endpoint string `json:"endpoint,omitempty" norman:"required"`
+	// Headers are sent with every request. They usually carry credentials, so their values are stored in
+	// secrets like the other passwords of the logging targets.
+	Headers     []OTLPHeader      `json:"headers,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Compress    bool              `json:"compress,omitempty"`
+	Certificate string            `json:"certificate,omitempty"`
+	ClientCert  string            `json:"clientCert,omitempty"`
+	ClientKey   string            `json:"clientKey,omitempty"`
+	SSLVerify   bool              `json:"sslVerify,omitempty"`
+}
+
+type OTLPHeader struct {
+	Name  string `json:"name,omitempty" norman:"required"`
+	Value string `json:"value,omitempty" norman:"type=password"`
+}
+
+// LokiConfig forwards logs to Grafana Loki. Labels maps stream label names to the source of their value, one of
+// namespace, project, workload, pod, container or node.
+type LokiConfig struct {
+	Endpoint     string            `json:"endpoint,omitempty" norman:"required"`
+	TenantID     string            `json:"tenantId,omitempty"`
+	AuthUserName string            `json:"authUsername,omitempty"`
+	AuthPassword string            `json:"authPassword,omitempty" norman:"type=password"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Certificate  string            `json:"certificate,omitempty"`
+	ClientCert   string            `json:"clientCert,omitempty"`
+	ClientKey    string            `json:"clientKey,omitempty"`
+	SSLVerify    bool              `json:"sslVerify,omitempty"`
+}
+
 type CustomTargetConfig struct {
 	Content     string `json:"content,omitempty"`
 	Certificate string `json:"certificate,omitempty"`
@@ -226,3 +282,32 @@ func (p *ProjectTestInput) ObjClusterName() string {
 	}
 	return ""
 }
+
+// LoggingDryRunReport is the output of the dryRunReport action: the checks of the rendered fluentd configuration and
+// a report per endpoint of the logging target.
+type LoggingDryRunReport struct {
+	Success bool                  `json:"success"`
+	Checks  []LoggingTargetCheck  `json:"checks,omitempty"`
+	Targets []LoggingTargetReport `json:"targets,omitempty"`
+}
+
+type LoggingTargetReport struct {
+	Target   string               `json:"target"`
+	Endpoint string               `json:"endpoint,omitempty"`
+	Success  bool                 `json:"success"`
+	Checks   []LoggingTargetCheck `json:"checks,omitempty"`
+}
+
+type LoggingTargetCheck struct {
+	Name    string `json:"name"`
+	Success bool   `json:"success"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// LoggingSystemImages are the images the rancher-logging app is deployed with, overriding the ones pinned by the
+// chart. Fluentd must ship fluent-plugin-opentelemetry and fluent-plugin-grafana-loki for the otlp and loki targets,
+// and fluent-plugin-throttle for the project and namespace rate limits.
+type LoggingSystemImages struct {
+	Fluentd string `json:"fluentd,omitempty"`
+}
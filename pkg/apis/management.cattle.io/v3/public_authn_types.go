@@ -190,6 +190,10 @@ type OIDCProvider struct {
 type OIDCLogin struct {
 	GenericLogin `json:",inline"`
 	Code         string `json:"code" norman:"type=string,required"`
+	// RequestID and PublicKey are set when a kubeconfig login helper started the login, the resulting
+	// kubeconfig token is encrypted with PublicKey and handed out through the authTokens API as RequestID
+	RequestID string `json:"requestId,omitempty"`
+	PublicKey string `json:"publicKey,omitempty"`
 }
 
 type KeyCloakOIDCProvider struct {
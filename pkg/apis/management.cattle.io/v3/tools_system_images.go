@@ -8,6 +8,7 @@
 	ToolsSystemImages = struct {
 		PipelineSystemImages projectv3.PipelineSystemImages
 		AuthSystemImages     AuthSystemImages
+		LoggingSystemImages  LoggingSystemImages
 	}{
 		PipelineSystemImages: projectv3.PipelineSystemImages{
 			Jenkins:       "rancher/pipeline-jenkins-server:v0.1.4",
@@ -22,5 +23,8 @@
 		AuthSystemImages: AuthSystemImages{
 			KubeAPIAuth: "rancher/kube-api-auth:v0.1.6",
 		},
+		LoggingSystemImages: LoggingSystemImages{
+			Fluentd: "rancher/fluentd:v0.1.25",
+		},
 	}
 )
@@ -383,6 +383,210 @@ func (in *AlertCommonSpec) DeepCopy() *AlertCommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertHistory) DeepCopyInto(out *AlertHistory) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertHistory.
+func (in *AlertHistory) DeepCopy() *AlertHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertHistory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertHistoryEntry) DeepCopyInto(out *AlertHistoryEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertHistoryEntry.
+func (in *AlertHistoryEntry) DeepCopy() *AlertHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertHistoryList) DeepCopyInto(out *AlertHistoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertHistoryList.
+func (in *AlertHistoryList) DeepCopy() *AlertHistoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertHistoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertHistoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertHistorySpec) DeepCopyInto(out *AlertHistorySpec) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]AlertHistoryEntry, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertHistorySpec.
+func (in *AlertHistorySpec) DeepCopy() *AlertHistorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertHistorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertMaintenanceWindow) DeepCopyInto(out *AlertMaintenanceWindow) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertMaintenanceWindow.
+func (in *AlertMaintenanceWindow) DeepCopy() *AlertMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertMaintenanceWindow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertMaintenanceWindowList) DeepCopyInto(out *AlertMaintenanceWindowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertMaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertMaintenanceWindowList.
+func (in *AlertMaintenanceWindowList) DeepCopy() *AlertMaintenanceWindowList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertMaintenanceWindowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertMaintenanceWindowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertMaintenanceWindowSpec) DeepCopyInto(out *AlertMaintenanceWindowSpec) {
+	*out = *in
+	if in.Recurrence != nil {
+		in, out := &in.Recurrence, &out.Recurrence
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertMaintenanceWindowSpec.
+func (in *AlertMaintenanceWindowSpec) DeepCopy() *AlertMaintenanceWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertMaintenanceWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertMaintenanceWindowStatus) DeepCopyInto(out *AlertMaintenanceWindowStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertMaintenanceWindowStatus.
+func (in *AlertMaintenanceWindowStatus) DeepCopy() *AlertMaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertMaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertStatus) DeepCopyInto(out *AlertStatus) {
 	*out = *in
@@ -1113,6 +1317,50 @@ func (in *CertExpiration) DeepCopy() *CertExpiration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRotationPolicy) DeepCopyInto(out *CertificateRotationPolicy) {
+	*out = *in
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRotationPolicy.
+func (in *CertificateRotationPolicy) DeepCopy() *CertificateRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRule) DeepCopyInto(out *CertificateRule) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRule.
+func (in *CertificateRule) DeepCopy() *CertificateRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChangePasswordInput) DeepCopyInto(out *ChangePasswordInput) {
 	*out = *in
@@ -1129,6 +1377,93 @@ func (in *ChangePasswordInput) DeepCopy() *ChangePasswordInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CisBenchmarkProfile) DeepCopyInto(out *CisBenchmarkProfile) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CisBenchmarkProfile.
+func (in *CisBenchmarkProfile) DeepCopy() *CisBenchmarkProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(CisBenchmarkProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CisBenchmarkProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CisBenchmarkProfileList) DeepCopyInto(out *CisBenchmarkProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CisBenchmarkProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CisBenchmarkProfileList.
+func (in *CisBenchmarkProfileList) DeepCopy() *CisBenchmarkProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(CisBenchmarkProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CisBenchmarkProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CisBenchmarkProfileSpec) DeepCopyInto(out *CisBenchmarkProfileSpec) {
+	*out = *in
+	if in.SkipChecks != nil {
+		in, out := &in.SkipChecks, &out.SkipChecks
+		*out = make([]CisCheckException, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceptedRisks != nil {
+		in, out := &in.AcceptedRisks, &out.AcceptedRisks
+		*out = make([]CisCheckException, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CisBenchmarkProfileSpec.
+func (in *CisBenchmarkProfileSpec) DeepCopy() *CisBenchmarkProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CisBenchmarkProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CisBenchmarkVersion) DeepCopyInto(out *CisBenchmarkVersion) {
 	*out = *in
@@ -1190,6 +1525,22 @@ func (in *CisBenchmarkVersionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CisCheckException) DeepCopyInto(out *CisCheckException) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CisCheckException.
+func (in *CisCheckException) DeepCopy() *CisCheckException {
+	if in == nil {
+		return nil
+	}
+	out := new(CisCheckException)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CisConfig) DeepCopyInto(out *CisConfig) {
 	*out = *in
@@ -1641,6 +1992,11 @@ func (in *ClusterAlertRuleSpec) DeepCopyInto(out *ClusterAlertRuleSpec) {
 		*out = new(ClusterScanRule)
 		**out = **in
 	}
+	if in.CertificateRule != nil {
+		in, out := &in.CertificateRule, &out.CertificateRule
+		*out = new(CertificateRule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2342,6 +2698,11 @@ func (in *ClusterScanStatus) DeepCopyInto(out *ClusterScanStatus) {
 		*out = new(CisScanStatus)
 		**out = **in
 	}
+	if in.AcceptedRisks != nil {
+		in, out := &in.AcceptedRisks, &out.AcceptedRisks
+		*out = make([]CisCheckException, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2452,6 +2813,11 @@ func (in *ClusterSpecBase) DeepCopyInto(out *ClusterSpecBase) {
 		*out = new(ScheduledClusterScan)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CertificateRotationPolicy != nil {
+		in, out := &in.CertificateRotationPolicy, &out.CertificateRotationPolicy
+		*out = new(CertificateRotationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5317,6 +5683,133 @@ func (in *LoggingCondition) DeepCopy() *LoggingCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingDroppedRecords) DeepCopyInto(out *LoggingDroppedRecords) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingDroppedRecords.
+func (in *LoggingDroppedRecords) DeepCopy() *LoggingDroppedRecords {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingDroppedRecords)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingDryRunReport) DeepCopyInto(out *LoggingDryRunReport) {
+	*out = *in
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]LoggingTargetCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]LoggingTargetReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingDryRunReport.
+func (in *LoggingDryRunReport) DeepCopy() *LoggingDryRunReport {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingDryRunReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingRateLimit) DeepCopyInto(out *LoggingRateLimit) {
+	*out = *in
+	if in.NamespaceLimits != nil {
+		in, out := &in.NamespaceLimits, &out.NamespaceLimits
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingRateLimit.
+func (in *LoggingRateLimit) DeepCopy() *LoggingRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSystemImages) DeepCopyInto(out *LoggingSystemImages) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSystemImages.
+func (in *LoggingSystemImages) DeepCopy() *LoggingSystemImages {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSystemImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingTargetCheck) DeepCopyInto(out *LoggingTargetCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTargetCheck.
+func (in *LoggingTargetCheck) DeepCopy() *LoggingTargetCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingTargetCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingTargetReport) DeepCopyInto(out *LoggingTargetReport) {
+	*out = *in
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]LoggingTargetCheck, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTargetReport.
+func (in *LoggingTargetReport) DeepCopy() *LoggingTargetReport {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingTargetReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggingTargets) DeepCopyInto(out *LoggingTargets) {
 	*out = *in
@@ -5345,6 +5838,16 @@ func (in *LoggingTargets) DeepCopyInto(out *LoggingTargets) {
 		*out = new(FluentForwarderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OTLPConfig != nil {
+		in, out := &in.OTLPConfig, &out.OTLPConfig
+		*out = new(OTLPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LokiConfig != nil {
+		in, out := &in.LokiConfig, &out.LokiConfig
+		*out = new(LokiConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CustomTargetConfig != nil {
 		in, out := &in.CustomTargetConfig, &out.CustomTargetConfig
 		*out = new(CustomTargetConfig)
@@ -5363,6 +5866,29 @@ func (in *LoggingTargets) DeepCopy() *LoggingTargets {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiConfig) DeepCopyInto(out *LokiConfig) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiConfig.
+func (in *LokiConfig) DeepCopy() *LokiConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MSTeamsConfig) DeepCopyInto(out *MSTeamsConfig) {
 	*out = *in
@@ -5384,6 +5910,22 @@ func (in *MSTeamsConfig) DeepCopy() *MSTeamsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedChart) DeepCopyInto(out *ManagedChart) {
 	*out = *in
@@ -5555,6 +6097,38 @@ func (in *Member) DeepCopy() *Member {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataBundleInput) DeepCopyInto(out *MetadataBundleInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataBundleInput.
+func (in *MetadataBundleInput) DeepCopy() *MetadataBundleInput {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataBundleInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataBundleOutput) DeepCopyInto(out *MetadataBundleOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataBundleOutput.
+func (in *MetadataBundleOutput) DeepCopy() *MetadataBundleOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataBundleOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetadataUpdate) DeepCopyInto(out *MetadataUpdate) {
 	*out = *in
@@ -7030,6 +7604,50 @@ func (in *OKTAProvider) DeepCopy() *OKTAProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPConfig) DeepCopyInto(out *OTLPConfig) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]OTLPHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPConfig.
+func (in *OTLPConfig) DeepCopy() *OTLPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPHeader) DeepCopyInto(out *OTLPHeader) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPHeader.
+func (in *OTLPHeader) DeepCopy() *OTLPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenLdapConfig) DeepCopyInto(out *OpenLdapConfig) {
 	*out = *in
@@ -7145,6 +7763,29 @@ func (in *PagerdutyConfig) DeepCopy() *PagerdutyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimRule) DeepCopyInto(out *PersistentVolumeClaimRule) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimRule.
+func (in *PersistentVolumeClaimRule) DeepCopy() *PersistentVolumeClaimRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PingConfig) DeepCopyInto(out *PingConfig) {
 	*out = *in
@@ -7698,6 +8339,11 @@ func (in *ProjectAlertRuleSpec) DeepCopyInto(out *ProjectAlertRuleSpec) {
 		*out = new(MetricRule)
 		**out = **in
 	}
+	if in.PersistentVolumeClaimRule != nil {
+		in, out := &in.PersistentVolumeClaimRule, &out.PersistentVolumeClaimRule
+		*out = new(PersistentVolumeClaimRule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -7935,6 +8581,11 @@ func (in *ProjectLoggingSpec) DeepCopyInto(out *ProjectLoggingSpec) {
 	*out = *in
 	in.LoggingTargets.DeepCopyInto(&out.LoggingTargets)
 	in.LoggingCommonField.DeepCopyInto(&out.LoggingCommonField)
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(LoggingRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -7957,6 +8608,11 @@ func (in *ProjectLoggingStatus) DeepCopyInto(out *ProjectLoggingStatus) {
 		copy(*out, *in)
 	}
 	in.AppliedSpec.DeepCopyInto(&out.AppliedSpec)
+	if in.DroppedRecords != nil {
+		in, out := &in.DroppedRecords, &out.DroppedRecords
+		*out = new(LoggingDroppedRecords)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -196,6 +196,23 @@ func NewCisBenchmarkVersion(namespace, name string, obj CisBenchmarkVersion) *Ci
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// CisBenchmarkProfileList is a list of CisBenchmarkProfile resources
+type CisBenchmarkProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CisBenchmarkProfile `json:"items"`
+}
+
+func NewCisBenchmarkProfile(namespace, name string, obj CisBenchmarkProfile) *CisBenchmarkProfile {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("CisBenchmarkProfile").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // CisConfigList is a list of CisConfig resources
 type CisConfigList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -281,6 +298,23 @@ func NewClusterAlertGroup(namespace, name string, obj ClusterAlertGroup) *Cluste
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// AlertMaintenanceWindowList is a list of AlertMaintenanceWindow resources
+type AlertMaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AlertMaintenanceWindow `json:"items"`
+}
+
+func NewAlertMaintenanceWindow(namespace, name string, obj AlertMaintenanceWindow) *AlertMaintenanceWindow {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("AlertMaintenanceWindow").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ClusterAlertRuleList is a list of ClusterAlertRule resources
 type ClusterAlertRuleList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -332,6 +366,23 @@ func NewClusterMonitorGraph(namespace, name string, obj ClusterMonitorGraph) *Cl
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// AlertHistoryList is a list of AlertHistory resources
+type AlertHistoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AlertHistory `json:"items"`
+}
+
+func NewAlertHistory(namespace, name string, obj AlertHistory) *AlertHistory {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("AlertHistory").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ClusterRegistrationTokenList is a list of ClusterRegistrationToken resources
 type ClusterRegistrationTokenList struct {
 	metav1.TypeMeta `json:",inline"`
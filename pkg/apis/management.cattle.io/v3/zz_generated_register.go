@@ -30,6 +30,8 @@
 var (
 	APIServiceResourceName                              = "apiservices"
 	ActiveDirectoryProviderResourceName                 = "activedirectoryproviders"
+	AlertHistoryResourceName                            = "alerthistories"
+	AlertMaintenanceWindowResourceName                  = "alertmaintenancewindows"
 	AuthConfigResourceName                              = "authconfigs"
 	AuthProviderResourceName                            = "authproviders"
 	AuthTokenResourceName                               = "authtokens"
@@ -37,17 +39,18 @@
 	CatalogResourceName                                 = "catalogs"
 	CatalogTemplateResourceName                         = "catalogtemplates"
 	CatalogTemplateVersionResourceName                  = "catalogtemplateversions"
+	CisBenchmarkProfileResourceName                     = "cisbenchmarkprofiles"
 	CisBenchmarkVersionResourceName                     = "cisbenchmarkversions"
 	CisConfigResourceName                               = "cisconfigs"
 	CloudCredentialResourceName                         = "cloudcredentials"
-	ClusterResourceName                                 = "clusters"
-	ClusterAlertResourceName                            = "clusteralerts"
 	ClusterAlertGroupResourceName                       = "clusteralertgroups"
+	ClusterAlertResourceName                            = "clusteralerts"
 	ClusterAlertRuleResourceName                        = "clusteralertrules"
 	ClusterCatalogResourceName                          = "clustercatalogs"
 	ClusterLoggingResourceName                          = "clusterloggings"
 	ClusterMonitorGraphResourceName                     = "clustermonitorgraphs"
 	ClusterRegistrationTokenResourceName                = "clusterregistrationtokens"
+	ClusterResourceName                                 = "clusters"
 	ClusterRoleTemplateBindingResourceName              = "clusterroletemplatebindings"
 	ClusterScanResourceName                             = "clusterscans"
 	ClusterTemplateResourceName                         = "clustertemplates"
@@ -59,38 +62,38 @@
 	FleetWorkspaceResourceName                          = "fleetworkspaces"
 	FreeIpaProviderResourceName                         = "freeipaproviders"
 	GithubProviderResourceName                          = "githubproviders"
-	GlobalDnsResourceName                               = "globaldnses"
 	GlobalDnsProviderResourceName                       = "globaldnsproviders"
-	GlobalRoleResourceName                              = "globalroles"
+	GlobalDnsResourceName                               = "globaldnses"
 	GlobalRoleBindingResourceName                       = "globalrolebindings"
+	GlobalRoleResourceName                              = "globalroles"
 	GoogleOAuthProviderResourceName                     = "googleoauthproviders"
-	GroupResourceName                                   = "groups"
 	GroupMemberResourceName                             = "groupmembers"
+	GroupResourceName                                   = "groups"
 	KontainerDriverResourceName                         = "kontainerdrivers"
 	LocalProviderResourceName                           = "localproviders"
 	ManagedChartResourceName                            = "managedcharts"
 	MonitorMetricResourceName                           = "monitormetrics"
 	MultiClusterAppResourceName                         = "multiclusterapps"
 	MultiClusterAppRevisionResourceName                 = "multiclusterapprevisions"
-	NodeResourceName                                    = "nodes"
 	NodeDriverResourceName                              = "nodedrivers"
 	NodePoolResourceName                                = "nodepools"
+	NodeResourceName                                    = "nodes"
 	NodeTemplateResourceName                            = "nodetemplates"
 	NotifierResourceName                                = "notifiers"
 	OIDCProviderResourceName                            = "oidcproviders"
 	OpenLdapProviderResourceName                        = "openldapproviders"
-	PodSecurityPolicyTemplateResourceName               = "podsecuritypolicytemplates"
 	PodSecurityPolicyTemplateProjectBindingResourceName = "podsecuritypolicytemplateprojectbindings"
+	PodSecurityPolicyTemplateResourceName               = "podsecuritypolicytemplates"
 	PreferenceResourceName                              = "preferences"
 	PrincipalResourceName                               = "principals"
-	ProjectResourceName                                 = "projects"
-	ProjectAlertResourceName                            = "projectalerts"
 	ProjectAlertGroupResourceName                       = "projectalertgroups"
+	ProjectAlertResourceName                            = "projectalerts"
 	ProjectAlertRuleResourceName                        = "projectalertrules"
 	ProjectCatalogResourceName                          = "projectcatalogs"
 	ProjectLoggingResourceName                          = "projectloggings"
 	ProjectMonitorGraphResourceName                     = "projectmonitorgraphs"
 	ProjectNetworkPolicyResourceName                    = "projectnetworkpolicies"
+	ProjectResourceName                                 = "projects"
 	ProjectRoleTemplateBindingResourceName              = "projectroletemplatebindings"
 	RkeAddonResourceName                                = "rkeaddons"
 	RkeK8sServiceOptionResourceName                     = "rkek8sserviceoptions"
@@ -99,12 +102,12 @@
 	SamlProviderResourceName                            = "samlproviders"
 	SamlTokenResourceName                               = "samltokens"
 	SettingResourceName                                 = "settings"
-	TemplateResourceName                                = "templates"
 	TemplateContentResourceName                         = "templatecontents"
+	TemplateResourceName                                = "templates"
 	TemplateVersionResourceName                         = "templateversions"
 	TokenResourceName                                   = "tokens"
-	UserResourceName                                    = "users"
 	UserAttributeResourceName                           = "userattributes"
+	UserResourceName                                    = "users"
 )
 
 // SchemeGroupVersion is group version used to register these objects
@@ -146,6 +149,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CatalogTemplateList{},
 		&CatalogTemplateVersion{},
 		&CatalogTemplateVersionList{},
+		&CisBenchmarkProfile{},
+		&CisBenchmarkProfileList{},
 		&CisBenchmarkVersion{},
 		&CisBenchmarkVersionList{},
 		&CisConfig{},
@@ -158,6 +163,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterAlertList{},
 		&ClusterAlertGroup{},
 		&ClusterAlertGroupList{},
+		&AlertMaintenanceWindow{},
+		&AlertMaintenanceWindowList{},
 		&ClusterAlertRule{},
 		&ClusterAlertRuleList{},
 		&ClusterCatalog{},
@@ -166,6 +173,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterLoggingList{},
 		&ClusterMonitorGraph{},
 		&ClusterMonitorGraphList{},
+		&AlertHistory{},
+		&AlertHistoryList{},
 		&ClusterRegistrationToken{},
 		&ClusterRegistrationTokenList{},
 		&ClusterRoleTemplateBinding{},
@@ -29,7 +29,7 @@ type SourceCodeProvider struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	ProjectName string `json:"projectName" norman:"type=reference[project]"`
-	Type        string `json:"type" norman:"options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	Type        string `json:"type" norman:"options=github|gitlab|bitbucketcloud|bitbucketserver|git"`
 }
 
 func (s *SourceCodeProvider) ObjClusterName() string {
@@ -65,6 +65,10 @@ type BitbucketServerProvider struct {
 	OauthProvider `json:",inline"`
 }
 
+type GitProvider struct {
+	SourceCodeProvider `json:",inline"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -75,7 +79,7 @@ type SourceCodeProviderConfig struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	ProjectName string `json:"projectName" norman:"required,type=reference[project]"`
-	Type        string `json:"type" norman:"noupdate,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	Type        string `json:"type" norman:"noupdate,options=github|gitlab|bitbucketcloud|bitbucketserver|git"`
 	Enabled     bool   `json:"enabled,omitempty"`
 }
 
@@ -140,6 +144,15 @@ type BitbucketServerPipelineConfig struct {
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type GitPipelineConfig struct {
+	SourceCodeProviderConfig `json:",inline" mapstructure:",squash"`
+
+	CACerts string `json:"caCerts,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type Pipeline struct {
 	types.Namespaced
 
@@ -237,15 +250,18 @@ type PipelineStatus struct {
 	WebHookID            string                `json:"webhookId,omitempty" yaml:"webhookId,omitempty"`
 	Token                string                `json:"token,omitempty" yaml:"token,omitempty" norman:"writeOnly,noupdate"`
 	SourceCodeCredential *SourceCodeCredential `json:"sourceCodeCredential,omitempty" yaml:"sourceCodeCredential,omitempty"`
+	LastPolled           string                `json:"lastPolled,omitempty" yaml:"lastPolled,omitempty"`
+	PolledRefs           map[string]string     `json:"polledRefs,omitempty" yaml:"polledRefs,omitempty"`
 }
 
 type PipelineSpec struct {
 	ProjectName string `json:"projectName" yaml:"projectName" norman:"required,type=reference[project]"`
 
-	DisplayName        string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
-	TriggerWebhookPush bool   `json:"triggerWebhookPush,omitempty" yaml:"triggerWebhookPush,omitempty"`
-	TriggerWebhookPr   bool   `json:"triggerWebhookPr,omitempty" yaml:"triggerWebhookPr,omitempty"`
-	TriggerWebhookTag  bool   `json:"triggerWebhookTag,omitempty" yaml:"triggerWebhookTag,omitempty"`
+	DisplayName         string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	TriggerWebhookPush  bool   `json:"triggerWebhookPush,omitempty" yaml:"triggerWebhookPush,omitempty"`
+	TriggerWebhookPr    bool   `json:"triggerWebhookPr,omitempty" yaml:"triggerWebhookPr,omitempty"`
+	TriggerWebhookTag   bool   `json:"triggerWebhookTag,omitempty" yaml:"triggerWebhookTag,omitempty"`
+	TriggerPollInterval int    `json:"triggerPollInterval,omitempty" yaml:"triggerPollInterval,omitempty" norman:"min=0"`
 
 	RepositoryURL            string `json:"repositoryUrl,omitempty" yaml:"repositoryUrl,omitempty"`
 	SourceCodeCredentialName string `json:"sourceCodeCredentialName,omitempty" yaml:"sourceCodeCredentialName,omitempty" norman:"type=reference[sourceCodeCredential],noupdate"`
@@ -374,7 +390,7 @@ type PipelineExecutionSpec struct {
 	PipelineConfig  PipelineConfig `json:"pipelineConfig,omitempty" norman:"required"`
 	RepositoryURL   string         `json:"repositoryUrl,omitempty"`
 	Run             int            `json:"run,omitempty" norman:"required,min=1"`
-	TriggeredBy     string         `json:"triggeredBy,omitempty" norman:"required,options=user|cron|webhook"`
+	TriggeredBy     string         `json:"triggeredBy,omitempty" norman:"required,options=user|cron|webhook|poll"`
 	TriggerUserName string         `json:"triggerUserName,omitempty" norman:"type=reference[user]"`
 	Commit          string         `json:"commit,omitempty"`
 	Event           string         `json:"event,omitempty"`
@@ -418,18 +434,21 @@ type StepStatus struct {
 }
 
 type SourceCodeCredentialSpec struct {
-	ProjectName    string `json:"projectName" norman:"type=reference[project]"`
-	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"required,options=github|gitlab|bitbucketcloud|bitbucketserver"`
-	UserName       string `json:"userName" norman:"required,type=reference[user]"`
-	DisplayName    string `json:"displayName,omitempty" norman:"required"`
-	AvatarURL      string `json:"avatarUrl,omitempty"`
-	HTMLURL        string `json:"htmlUrl,omitempty"`
-	LoginName      string `json:"loginName,omitempty"`
-	GitLoginName   string `json:"gitLoginName,omitempty"`
-	GitCloneToken  string `json:"gitCloneToken,omitempty" norman:"writeOnly,noupdate"`
-	AccessToken    string `json:"accessToken,omitempty" norman:"writeOnly,noupdate"`
-	RefreshToken   string `json:"refreshToken,omitempty" norman:"writeOnly,noupdate"`
-	Expiry         string `json:"expiry,omitempty"`
+	ProjectName    string   `json:"projectName" norman:"type=reference[project]"`
+	SourceCodeType string   `json:"sourceCodeType,omitempty" norman:"required,options=github|gitlab|bitbucketcloud|bitbucketserver|git"`
+	UserName       string   `json:"userName" norman:"required,type=reference[user]"`
+	DisplayName    string   `json:"displayName,omitempty" norman:"required"`
+	AvatarURL      string   `json:"avatarUrl,omitempty"`
+	HTMLURL        string   `json:"htmlUrl,omitempty"`
+	LoginName      string   `json:"loginName,omitempty"`
+	GitLoginName   string   `json:"gitLoginName,omitempty"`
+	GitCloneToken  string   `json:"gitCloneToken,omitempty" norman:"writeOnly,noupdate"`
+	AccessToken    string   `json:"accessToken,omitempty" norman:"writeOnly,noupdate"`
+	RefreshToken   string   `json:"refreshToken,omitempty" norman:"writeOnly,noupdate"`
+	Expiry         string   `json:"expiry,omitempty"`
+	SSHPrivateKey  string   `json:"sshPrivateKey,omitempty" norman:"writeOnly,noupdate"`
+	SSHKnownHosts  string   `json:"sshKnownHosts,omitempty"`
+	RepositoryURLs []string `json:"repositoryUrls,omitempty"`
 }
 
 func (s *SourceCodeCredentialSpec) ObjClusterName() string {
@@ -445,7 +464,7 @@ type SourceCodeCredentialStatus struct {
 
 type SourceCodeRepositorySpec struct {
 	ProjectName              string   `json:"projectName" norman:"type=reference[project]"`
-	SourceCodeType           string   `json:"sourceCodeType,omitempty" norman:"required,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	SourceCodeType           string   `json:"sourceCodeType,omitempty" norman:"required,options=github|gitlab|bitbucketcloud|bitbucketserver|git"`
 	UserName                 string   `json:"userName" norman:"required,type=reference[user]"`
 	SourceCodeCredentialName string   `json:"sourceCodeCredentialName,omitempty" norman:"required,type=reference[sourceCodeCredential]"`
 	URL                      string   `json:"url,omitempty"`
@@ -476,7 +495,7 @@ type RunPipelineInput struct {
 
 type AuthAppInput struct {
 	InheritGlobal  bool   `json:"inheritGlobal,omitempty"`
-	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"type=string,required,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"type=string,required,options=github|gitlab|bitbucketcloud|bitbucketserver|git"`
 	RedirectURL    string `json:"redirectUrl,omitempty" norman:"type=string"`
 	TLS            bool   `json:"tls,omitempty"`
 	Host           string `json:"host,omitempty"`
@@ -486,7 +505,7 @@ type AuthAppInput struct {
 }
 
 type AuthUserInput struct {
-	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"type=string,required,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"type=string,required,options=github|gitlab|bitbucketcloud|bitbucketserver|git"`
 	RedirectURL    string `json:"redirectUrl,omitempty" norman:"type=string"`
 	Code           string `json:"code,omitempty" norman:"type=string,required"`
 }
@@ -536,6 +555,19 @@ type BitbucketServerApplyInput struct {
 	RedirectURL   string `json:"redirectUrl,omitempty"`
 }
 
+type GitLoginInput struct {
+	Username       string   `json:"username,omitempty"`
+	Token          string   `json:"token,omitempty" norman:"type=password"`
+	SSHPrivateKey  string   `json:"sshPrivateKey,omitempty" norman:"type=password"`
+	SSHKnownHosts  string   `json:"sshKnownHosts,omitempty"`
+	RepositoryURLs []string `json:"repositoryUrls,omitempty" norman:"required"`
+}
+
+type GitApplyInput struct {
+	GitLoginInput
+	CACerts string `json:"caCerts,omitempty"`
+}
+
 type BitbucketServerRequestLoginInput struct {
 	Hostname    string `json:"hostname,omitempty"`
 	TLS         bool   `json:"tls,omitempty"`
@@ -75,6 +75,7 @@ type Certificate struct {
 	SerialNumber            string   `json:"serialNumber" norman:"nocreate,noupdate"`
 	KeySize                 string   `json:"keySize" norman:"nocreate,noupdate"`
 	SubjectAlternativeNames []string `json:"subjectAlternativeNames" norman:"nocreate,noupdate"`
+	Warnings                []string `json:"warnings" norman:"nocreate,noupdate"`
 }
 
 // +genclient
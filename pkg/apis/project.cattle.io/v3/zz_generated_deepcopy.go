@@ -610,6 +610,11 @@ func (in *Certificate) DeepCopyInto(out *Certificate) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -815,6 +820,86 @@ func (in *EnvFrom) DeepCopy() *EnvFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitApplyInput) DeepCopyInto(out *GitApplyInput) {
+	*out = *in
+	in.GitLoginInput.DeepCopyInto(&out.GitLoginInput)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitApplyInput.
+func (in *GitApplyInput) DeepCopy() *GitApplyInput {
+	if in == nil {
+		return nil
+	}
+	out := new(GitApplyInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLoginInput) DeepCopyInto(out *GitLoginInput) {
+	*out = *in
+	if in.RepositoryURLs != nil {
+		in, out := &in.RepositoryURLs, &out.RepositoryURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLoginInput.
+func (in *GitLoginInput) DeepCopy() *GitLoginInput {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLoginInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitPipelineConfig) DeepCopyInto(out *GitPipelineConfig) {
+	*out = *in
+	in.SourceCodeProviderConfig.DeepCopyInto(&out.SourceCodeProviderConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitPipelineConfig.
+func (in *GitPipelineConfig) DeepCopy() *GitPipelineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GitPipelineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitPipelineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitProvider) DeepCopyInto(out *GitProvider) {
+	*out = *in
+	in.SourceCodeProvider.DeepCopyInto(&out.SourceCodeProvider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitProvider.
+func (in *GitProvider) DeepCopy() *GitProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GitProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GithubApplyInput) DeepCopyInto(out *GithubApplyInput) {
 	*out = *in
@@ -1004,6 +1089,11 @@ func (in *NamespacedCertificate) DeepCopyInto(out *NamespacedCertificate) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1614,6 +1704,13 @@ func (in *PipelineStatus) DeepCopyInto(out *PipelineStatus) {
 		*out = new(SourceCodeCredential)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PolledRefs != nil {
+		in, out := &in.PolledRefs, &out.PolledRefs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1957,7 +2054,7 @@ func (in *SourceCodeCredential) DeepCopyInto(out *SourceCodeCredential) {
 	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 	return
 }
@@ -2016,6 +2113,11 @@ func (in *SourceCodeCredentialList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceCodeCredentialSpec) DeepCopyInto(out *SourceCodeCredentialSpec) {
 	*out = *in
+	if in.RepositoryURLs != nil {
+		in, out := &in.RepositoryURLs, &out.RepositoryURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
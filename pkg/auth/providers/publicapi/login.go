@@ -41,6 +41,10 @@
 
 const (
 	CookieName = "R_SESS"
+
+	// requestTokenResponseType is returned by createLoginToken when the token was handed to a login helper
+	// through the authTokens API instead of being returned to the caller
+	requestTokenResponseType = "requestToken"
 )
 
 func newLoginHandler(ctx context.Context, mgmt *config.ScaledContext) *loginHandler {
@@ -85,7 +89,7 @@ func (h *loginHandler) login(actionName string, action *types.Action, request *t
 			HttpOnly: true,
 		}
 		http.SetCookie(w, tokenCookie)
-	} else if responseType == "saml" {
+	} else if responseType == "saml" || responseType == requestTokenResponseType {
 		return nil
 	} else {
 		tokenData, err := tokens.ConvertTokenResource(request.Schemas.Schema(&schema.PublicVersion, client.TokenType), token)
@@ -221,6 +225,17 @@ func (h *loginHandler) createLoginToken(request *types.APIContext) (v3.Token, st
 			return v3.Token{}, "", "", httperror.NewAPIError(httperror.ServerError,
 				fmt.Sprintf("Failed to create cluster auth token for cluster [%s], cluster auth endpoint may fail: %v", token.ClusterName, err))
 		}
+
+		if oidcLogin, ok := input.(*v32.OIDCLogin); ok && oidcLogin.RequestID != "" {
+			requestToken, err := tokens.NewRequestAuthToken(oidcLogin.RequestID, oidcLogin.PublicKey, token, tokenValue)
+			if err != nil {
+				return v3.Token{}, "", "", httperror.WrapAPIError(err, httperror.InvalidBodyContent, "invalid public key")
+			}
+			if _, err := h.scaledContext.Management.SamlTokens("").Create(requestToken); err != nil {
+				return v3.Token{}, "", "", err
+			}
+			return *token, "", requestTokenResponseType, nil
+		}
 		return *token, tokenValue, responseType, nil
 	}
 
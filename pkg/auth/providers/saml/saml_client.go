@@ -1,12 +1,8 @@
 package saml
 
 import (
-	"crypto/rand"
 	"crypto/rsa"
-	"crypto/sha256"
 	"crypto/x509"
-	"encoding/base64"
-	"encoding/json"
 	"encoding/pem"
 	"encoding/xml"
 	"fmt"
@@ -24,10 +20,8 @@
 	"github.com/rancher/rancher/pkg/auth/settings"
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
-	"github.com/rancher/rancher/pkg/namespace"
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type IDPMetadata struct {
@@ -407,40 +401,12 @@ func (s *Provider) HandleSamlAssertion(w http.ResponseWriter, r *http.Request, a
 				return
 			}
 
-			keyBytes, err := base64.StdEncoding.DecodeString(publicKey)
-			if err != nil {
-				log.Errorf("SAML: base64 DecodeString error %v", err)
-				http.Redirect(w, r, redirectURL+"errorCode=500", http.StatusFound)
-				return
-			}
-			pubKey := &rsa.PublicKey{}
-			err = json.Unmarshal(keyBytes, pubKey)
-			if err != nil {
-				log.Errorf("SAML: getPublicKey error %v", err)
-				http.Redirect(w, r, redirectURL+"errorCode=500", http.StatusFound)
-				return
-			}
-			encryptedToken, err := rsa.EncryptOAEP(
-				sha256.New(),
-				rand.Reader,
-				pubKey,
-				[]byte(fmt.Sprintf("%s:%s", token.ObjectMeta.Name, tokenValue)),
-				nil)
+			samlToken, err := tokens.NewRequestAuthToken(requestID, publicKey, token, tokenValue)
 			if err != nil {
 				log.Errorf("SAML: getEncryptedToken error %v", err)
 				http.Redirect(w, r, redirectURL+"errorCode=500", http.StatusFound)
 				return
 			}
-			encoded := base64.StdEncoding.EncodeToString(encryptedToken)
-
-			samlToken := &v3.SamlToken{
-				Token:     encoded,
-				ExpiresAt: token.ExpiresAt,
-				ObjectMeta: v1.ObjectMeta{
-					Name:      requestID,
-					Namespace: namespace.GlobalNamespace,
-				},
-			}
 
 			_, err = s.samlTokens.Create(samlToken)
 			if err != nil {
@@ -1,7 +1,11 @@
 package tokens
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,8 +16,10 @@
 	"github.com/rancher/norman/types/convert"
 	"github.com/rancher/rancher/pkg/features"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/rancher/pkg/user"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func getAuthProviderName(principalID string) string {
@@ -121,6 +127,37 @@ func GetKubeConfigToken(userName, responseType string, userMGR user.Manager, use
 	return token, tokenVal, nil
 }
 
+// NewRequestAuthToken encrypts token with publicKey and wraps it in a SamlToken named after requestID, so that the
+// client which started the login, usually a kubeconfig login helper, can collect it through the public authTokens API.
+func NewRequestAuthToken(requestID, publicKey string, token *v3.Token, tokenValue string) (*v3.SamlToken, error) {
+	keyBytes, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode public key")
+	}
+	pubKey := &rsa.PublicKey{}
+	if err := json.Unmarshal(keyBytes, pubKey); err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	}
+	encryptedToken, err := rsa.EncryptOAEP(
+		sha256.New(),
+		rand.Reader,
+		pubKey,
+		[]byte(fmt.Sprintf("%s:%s", token.ObjectMeta.Name, tokenValue)),
+		nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt token")
+	}
+
+	return &v3.SamlToken{
+		Token:     base64.StdEncoding.EncodeToString(encryptedToken),
+		ExpiresAt: token.ExpiresAt,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      requestID,
+			Namespace: namespace.GlobalNamespace,
+		},
+	}, nil
+}
+
 func extractClusterIDFromResponseType(responseType string) string {
 	responseSplit := strings.SplitN(responseType, "_", 2)
 	if len(responseSplit) != 2 {
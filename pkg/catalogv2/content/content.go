@@ -8,6 +8,7 @@
 	"io"
 	"io/ioutil"
 	"net/url"
+	"strings"
 	"sync"
 
 	"github.com/Masterminds/semver/v3"
@@ -17,6 +18,7 @@
 	"github.com/rancher/rancher/pkg/catalogv2/git"
 	"github.com/rancher/rancher/pkg/catalogv2/helm"
 	helmhttp "github.com/rancher/rancher/pkg/catalogv2/http"
+	"github.com/rancher/rancher/pkg/catalogv2/oci"
 	catalogcontrollers "github.com/rancher/rancher/pkg/generated/controllers/catalog.cattle.io/v1"
 	"github.com/rancher/rancher/pkg/settings"
 	corecontrollers "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
@@ -101,6 +103,56 @@ func (c *Manager) readBytes(cm *corev1.ConfigMap) ([]byte, error) {
 	return bytes, nil
 }
 
+// readIndex reads the index stored in a configmap, merging the entries of its shards if it is sharded.
+func (c *Manager) readIndex(cm *corev1.ConfigMap) (*repo.IndexFile, error) {
+	index := &repo.IndexFile{}
+	if err := c.decode(cm, index); err != nil {
+		return nil, err
+	}
+
+	shards := cm.Annotations["catalog.cattle.io/shards"]
+	if shards == "" {
+		return index, nil
+	}
+	if index.Entries == nil {
+		index.Entries = map[string]repo.ChartVersions{}
+	}
+	for _, name := range strings.Split(shards, ",") {
+		shardCM, err := c.configMaps.Get(cm.Namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		shard := &repo.IndexFile{}
+		if err := c.decode(shardCM, shard); err != nil {
+			return nil, err
+		}
+		for chartName, versions := range shard.Entries {
+			index.Entries[chartName] = versions
+		}
+	}
+	return index, nil
+}
+
+func (c *Manager) decode(cm *corev1.ConfigMap, index *repo.IndexFile) error {
+	data, err := c.readBytes(cm)
+	if err != nil {
+		return err
+	}
+
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	data, err = ioutil.ReadAll(gz)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, index)
+}
+
 func (c *Manager) Index(namespace, name string, skipFilter bool) (*repo.IndexFile, error) {
 	r, err := c.getRepo(namespace, name)
 	if err != nil {
@@ -130,26 +182,10 @@ func (c *Manager) Index(namespace, name string, skipFilter bool) (*repo.IndexFil
 		return nil, validation.Unauthorized
 	}
 
-	data, err := c.readBytes(cm)
-	if err != nil {
-		return nil, err
-	}
-
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	index, err := c.readIndex(cm)
 	if err != nil {
 		return nil, err
 	}
-	defer gz.Close()
-
-	data, err = ioutil.ReadAll(gz)
-	if err != nil {
-		return nil, err
-	}
-
-	index := &repo.IndexFile{}
-	if err := json.Unmarshal(data, index); err != nil {
-		return nil, err
-	}
 
 	c.lock.Lock()
 	c.IndexCache[fmt.Sprintf("%s/%s", r.status.IndexConfigMapNamespace, r.status.IndexConfigMapName)] = indexCache{
@@ -275,6 +311,10 @@ func (c *Manager) Icon(namespace, name, chartName, version string) (io.ReadClose
 		return nil, "", err
 	}
 
+	if oci.IsOCI(repo.status.URL) {
+		return oci.Icon(secret, repo.status.URL, repo.spec.CABundle, repo.spec.InsecureSkipTLSverify, chart)
+	}
+
 	return helmhttp.Icon(secret, repo.status.URL, repo.spec.CABundle, repo.spec.InsecureSkipTLSverify, repo.spec.DisableSameOriginCheck, chart)
 }
 
@@ -308,6 +348,10 @@ func (c *Manager) Chart(namespace, name, chartName, version string, skipFilter b
 		return nil, err
 	}
 
+	if oci.IsOCI(repo.status.URL) {
+		return oci.Chart(secret, repo.status.URL, repo.spec.CABundle, repo.spec.InsecureSkipTLSverify, chart)
+	}
+
 	return helmhttp.Chart(secret, repo.status.URL, repo.spec.CABundle, repo.spec.InsecureSkipTLSverify, repo.spec.DisableSameOriginCheck, chart)
 }
 
@@ -316,7 +360,20 @@ func (c *Manager) Info(namespace, name, chartName, version string) (*types.Chart
 	if err != nil {
 		return nil, err
 	}
-	defer chart.Close()
+	data, err := ioutil.ReadAll(chart)
+	chart.Close()
+	if err != nil {
+		return nil, err
+	}
 
-	return helm.InfoFromTarball(chart)
+	info, err := helm.InfoFromTarball(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	info.Signature, err = c.Verify(namespace, name, chartName, version, data)
+	if err != nil {
+		logrus.Warnf("failed to verify the signature of chart %s version %s of repo %s/%s: %v", chartName, version, namespace, name, err)
+	}
+	return info, nil
 }
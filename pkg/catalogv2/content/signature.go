@@ -0,0 +1,126 @@
+package content
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/rancher/rancher/pkg/api/steve/catalog/types"
+	"github.com/rancher/rancher/pkg/catalogv2"
+	helmhttp "github.com/rancher/rancher/pkg/catalogv2/http"
+	"github.com/rancher/rancher/pkg/catalogv2/oci"
+	"github.com/rancher/rancher/pkg/catalogv2/verify"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+)
+
+// Verify checks the signature of the archive of a chart version against the keys trusted by its repo. Charts of http
+// repos are signed with Helm provenance files, charts of OCI repos with cosign. Charts of git repos can't be signed.
+func (c *Manager) Verify(namespace, name, chartName, version string, chart []byte) (*types.ChartSignature, error) {
+	index, err := c.Index(namespace, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	chartVersion, err := index.Get(chartName, version)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := c.getRepo(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if repo.status.Commit != "" {
+		return &types.ChartSignature{State: types.SignatureUnsigned}, nil
+	}
+
+	secret, err := catalogv2.GetSecret(c.secrets, repo.spec, repo.metadata.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationSecret, err := catalogv2.GetVerificationSecret(c.secrets, repo.spec, repo.metadata.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := verify.KeysFromSecret(verificationSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if oci.IsOCI(repo.status.URL) {
+		sigs, err := oci.Signatures(secret, repo.status.URL, repo.spec.CABundle, repo.spec.InsecureSkipTLSverify, chartVersion)
+		if err != nil {
+			return unsigned(err), nil
+		}
+		return verifyCosign(keys, sigs, chart), nil
+	}
+
+	signature := &types.ChartSignature{Type: verify.ProvenanceType}
+	prov, fileName, err := helmhttp.Provenance(secret, repo.status.URL, repo.spec.CABundle, repo.spec.InsecureSkipTLSverify, repo.spec.DisableSameOriginCheck, chartVersion)
+	if err != nil {
+		return unsigned(err), nil
+	}
+	if len(keys.Keyring) == 0 {
+		signature.State = types.SignatureUnverified
+		return signature, nil
+	}
+
+	signature.Signer, err = keys.VerifyProvenance(fileName, chart, prov)
+	if err != nil {
+		signature.State = types.SignatureInvalid
+		signature.Message = err.Error()
+		return signature, nil
+	}
+	signature.State = types.SignatureVerified
+	return signature, nil
+}
+
+func verifyCosign(keys *verify.Keys, sigs []oci.Signature, chart []byte) *types.ChartSignature {
+	signature := &types.ChartSignature{Type: verify.CosignType}
+	if len(keys.CosignKeys) == 0 {
+		signature.State = types.SignatureUnverified
+		return signature
+	}
+
+	// The signatures cover the manifest of the chart, which must reference the archive that was downloaded.
+	var (
+		digest = fmt.Sprintf("sha256:%x", sha256.Sum256(chart))
+		err    error
+	)
+	for _, sig := range sigs {
+		if sig.ChartDigest != digest {
+			err = fmt.Errorf("signed chart digest %q doesn't match the chart digest %q", sig.ChartDigest, digest)
+			continue
+		}
+		signer, verifyErr := keys.VerifyCosign(sig.ManifestDigest, sig.Payload, sig.Signature)
+		if verifyErr != nil {
+			err = verifyErr
+			continue
+		}
+		signature.State = types.SignatureVerified
+		signature.Signer = signer
+		return signature
+	}
+
+	signature.State = types.SignatureInvalid
+	signature.Message = err.Error()
+	return signature
+}
+
+// unsigned reports a chart whose signature couldn't be downloaded. The chart is then only rejected where signed charts
+// are required, failures other than a missing signature are kept in the message.
+func unsigned(err error) *types.ChartSignature {
+	signature := &types.ChartSignature{State: types.SignatureUnsigned}
+	if !isNotFound(err) {
+		signature.Message = fmt.Sprintf("failed to download the signature: %v", err)
+	}
+	return signature
+}
+
+func isNotFound(err error) bool {
+	var code validation.ErrorCode
+	return errors.As(err, &code) && code.Status == http.StatusNotFound
+}
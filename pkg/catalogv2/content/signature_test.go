@@ -0,0 +1,20 @@
+package content
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/api/steve/catalog/types"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnsigned(t *testing.T) {
+	signature := unsigned(validation.ErrorCode{Status: http.StatusNotFound})
+	assert.Equal(t, types.SignatureUnsigned, signature.State)
+	assert.Empty(t, signature.Message)
+
+	signature = unsigned(validation.ErrorCode{Status: http.StatusForbidden})
+	assert.Equal(t, types.SignatureUnsigned, signature.State)
+	assert.Contains(t, signature.Message, "failed to download the signature")
+}
@@ -0,0 +1,341 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// checkoutFile records the settings of a checkout in its .git directory, the checkout is cloned again when they change.
+const checkoutFile = "rancher-checkout"
+
+// Options are the settings of the checkout of a git repo. The repo is cloned the default way, which is a shallow
+// clone of the whole branch, unless a tag, a sub-path or submodules are set.
+type Options struct {
+	// Branch is the branch to follow, the default branch of the repo when empty
+	Branch string
+	// Tag is checked out instead of the branch when set
+	Tag string
+	// SubPath is the only directory of the repo checked out, using a sparse checkout and a partial clone
+	SubPath string
+	// Submodules checks out the submodules of the repo, limited to the sub-path
+	Submodules bool
+
+	InsecureSkipTLS bool
+}
+
+func (o Options) custom() bool {
+	return o.Tag != "" || o.SubPath != "" || o.Submodules
+}
+
+func (o Options) ref() string {
+	switch {
+	case o.Tag != "":
+		return "refs/tags/" + o.Tag
+	case o.Branch != "":
+		return "refs/heads/" + o.Branch
+	}
+	return "HEAD"
+}
+
+// CleanSubPath validates the sub-path of a repo and returns it relative to the root of the repo.
+func CleanSubPath(subPath string) (string, error) {
+	if subPath == "" {
+		return "", nil
+	}
+	cleaned := path.Clean(strings.TrimPrefix(subPath, "/"))
+	if cleaned == "." {
+		return "", nil
+	}
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("invalid git sub-path %s: must be a directory of the repo", subPath)
+	}
+	return cleaned, nil
+}
+
+// checkout runs the git commands of the custom checkouts, with the credentials of the repo.
+type checkout struct {
+	dir     string
+	url     string
+	opts    Options
+	args    []string
+	env     []string
+	tempDir string
+}
+
+func newCheckout(secret *corev1.Secret, dir, gitURL string, opts Options) (*checkout, error) {
+	c := &checkout{
+		dir:  dir,
+		url:  gitURL,
+		opts: opts,
+		env:  []string{"GIT_TERMINAL_PROMPT=0"},
+	}
+
+	if uuid := settings.InstallUUID.Get(); uuid != "" {
+		c.args = append(c.args, "-c", "http.extraHeader=X-Install-Uuid: "+uuid)
+	}
+	if opts.InsecureSkipTLS {
+		c.env = append(c.env, "GIT_SSL_NO_VERIFY=true")
+	}
+
+	if err := c.setCredential(secret); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *checkout) setCredential(secret *corev1.Secret) error {
+	if secret == nil {
+		c.env = append(c.env, "GIT_SSH_COMMAND=ssh -o StrictHostKeyChecking=accept-new")
+		return nil
+	}
+
+	switch secret.Type {
+	case corev1.SecretTypeBasicAuth:
+		username, password := secret.Data[corev1.BasicAuthUsernameKey], secret.Data[corev1.BasicAuthPasswordKey]
+		if len(username) == 0 && len(password) == 0 {
+			return nil
+		}
+		u, err := url.Parse(c.url)
+		if err != nil {
+			return err
+		}
+		u.User = url.User(string(username))
+		c.url = u.String()
+		// the password is only given to the host of the repo, not to the hosts of its submodules
+		c.args = append(c.args, "-c", fmt.Sprintf("credential.%s://%s.helper=/bin/sh -c 'echo password=$GIT_PASSWORD'", u.Scheme, u.Host))
+		c.env = append(c.env, "GIT_PASSWORD="+string(password))
+	case corev1.SecretTypeSSHAuth:
+		tempDir, err := ioutil.TempDir("", "git-ssh")
+		if err != nil {
+			return err
+		}
+		c.tempDir = tempDir
+		key := filepath.Join(tempDir, "id")
+		if err := ioutil.WriteFile(key, secret.Data[corev1.SSHAuthPrivateKey], 0600); err != nil {
+			return err
+		}
+		sshCommand := fmt.Sprintf("ssh -i %s -o IdentitiesOnly=yes -o StrictHostKeyChecking=accept-new", key)
+		if knownHosts := secret.Data["known_hosts"]; len(knownHosts) > 0 {
+			file := filepath.Join(tempDir, "known_hosts")
+			if err := ioutil.WriteFile(file, knownHosts, 0600); err != nil {
+				return err
+			}
+			sshCommand = fmt.Sprintf("ssh -i %s -o IdentitiesOnly=yes -o UserKnownHostsFile=%s", key, file)
+		}
+		c.env = append(c.env, "GIT_SSH_COMMAND="+sshCommand)
+	}
+	return nil
+}
+
+func (c *checkout) Close() {
+	if c.tempDir != "" {
+		os.RemoveAll(c.tempDir)
+	}
+}
+
+func (c *checkout) git(args ...string) (string, error) {
+	cmd := exec.Command("git", append(c.args, args...)...)
+	cmd.Env = append(os.Environ(), c.env...)
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s error: %w, detail: %v", strings.Join(args, " "), err, stderr.String())
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func (c *checkout) settings() string {
+	return fmt.Sprintf("url=%s\nsubPath=%s\nsubmodules=%t\n", c.url, c.opts.SubPath, c.opts.Submodules)
+}
+
+// init creates an empty repo configured for the checkout, unless the existing one was created with the same settings.
+func (c *checkout) init() error {
+	settingsFile := filepath.Join(c.dir, ".git", checkoutFile)
+	if existing, err := ioutil.ReadFile(settingsFile); err == nil && string(existing) == c.settings() {
+		return nil
+	}
+
+	if err := os.RemoveAll(c.dir); err != nil {
+		return fmt.Errorf("failed to remove directory %s: %v", c.dir, err)
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	if _, err := c.git("-C", c.dir, "init", "-q"); err != nil {
+		return err
+	}
+	if _, err := c.git("-C", c.dir, "remote", "add", "origin", c.url); err != nil {
+		return err
+	}
+	if c.opts.SubPath != "" {
+		if _, err := c.git("-C", c.dir, "config", "core.sparseCheckout", "true"); err != nil {
+			return err
+		}
+		sparse := filepath.Join(c.dir, ".git", "info", "sparse-checkout")
+		if err := os.MkdirAll(filepath.Dir(sparse), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(sparse, []byte("/"+c.opts.SubPath+"/\n"), 0644); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(settingsFile, []byte(c.settings()), 0644)
+}
+
+func (c *checkout) currentCommit() string {
+	commit, err := c.git("-C", c.dir, "rev-parse", "--verify", "-q", "HEAD")
+	if err != nil {
+		return ""
+	}
+	return commit
+}
+
+// remoteCommit returns the commit of a ref of the remote, the commit an annotated tag points to for tags.
+func (c *checkout) remoteCommit(ref string) (string, error) {
+	output, err := c.git("ls-remote", c.url, ref, ref+"^{}")
+	if err != nil {
+		return "", err
+	}
+
+	var commit string
+	s := bufio.NewScanner(strings.NewReader(output))
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if commit == "" || strings.HasSuffix(fields[1], "^{}") {
+			commit = fields[0]
+		}
+	}
+	if commit == "" {
+		return "", fmt.Errorf("no commit for %s in git repo %s", ref, c.url)
+	}
+	return commit, nil
+}
+
+// fetch checks out a ref or a commit, only fetching its last commit and, with a sub-path, the files of the sub-path.
+func (c *checkout) fetch(rev string) error {
+	args := []string{"-C", c.dir, "fetch", "-q", "--depth=1"}
+	if c.opts.SubPath != "" {
+		args = append(args, "--filter=blob:none")
+	}
+	if _, err := c.git(append(args, "origin", rev)...); err != nil {
+		return err
+	}
+	if _, err := c.git("-C", c.dir, "reset", "-q", "--hard", "FETCH_HEAD"); err != nil {
+		return err
+	}
+	if !c.opts.Submodules {
+		return nil
+	}
+
+	args = []string{"-C", c.dir, "submodule", "update", "-q", "--init", "--recursive", "--depth=1"}
+	if c.opts.SubPath != "" {
+		args = append(args, "--", c.opts.SubPath)
+	}
+	_, err := c.git(args...)
+	return err
+}
+
+func (c *checkout) head() (string, error) {
+	if err := c.init(); err != nil {
+		return "", err
+	}
+	if commit := c.currentCommit(); commit != "" {
+		return commit, nil
+	}
+	return c.update()
+}
+
+func (c *checkout) update() (string, error) {
+	if err := c.init(); err != nil {
+		return "", err
+	}
+
+	remote, err := c.remoteCommit(c.opts.ref())
+	if err != nil {
+		return "", err
+	}
+	if remote == c.currentCommit() {
+		return remote, nil
+	}
+	if err := c.fetch(c.opts.ref()); err != nil {
+		return "", err
+	}
+	return c.currentCommit(), nil
+}
+
+func (c *checkout) ensure(commit string) error {
+	if err := c.init(); err != nil {
+		return err
+	}
+	if commit == c.currentCommit() {
+		return nil
+	}
+	return c.fetch(commit)
+}
+
+// LatestTag returns the latest tag of a git repo which is a semantic version matching the constraint.
+func LatestTag(secret *corev1.Secret, gitURL, constraint string, insecureSkipTLS bool) (string, error) {
+	if err := validateURL(gitURL); err != nil {
+		return "", err
+	}
+	constraints, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return "", fmt.Errorf("invalid git tag constraint %s: %w", constraint, err)
+	}
+
+	c, err := newCheckout(secret, "", gitURL, Options{InsecureSkipTLS: insecureSkipTLS})
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+
+	output, err := c.git("ls-remote", "--tags", "--refs", c.url)
+	if err != nil {
+		return "", err
+	}
+	tag := latestTag(output, constraints)
+	if tag == "" {
+		return "", fmt.Errorf("no tag of git repo %s matches %s", gitURL, constraint)
+	}
+	return tag, nil
+}
+
+func latestTag(lsRemote string, constraints *semver.Constraints) string {
+	var (
+		latest    *semver.Version
+		latestTag string
+	)
+	s := bufio.NewScanner(strings.NewReader(lsRemote))
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "refs/tags/") {
+			continue
+		}
+		tag := strings.TrimPrefix(fields[1], "refs/tags/")
+		version, err := semver.NewVersion(tag)
+		if err != nil || !constraints.Check(version) {
+			continue
+		}
+		if latest == nil || version.GreaterThan(latest) {
+			latest, latestTag = version, tag
+		}
+	}
+	return latestTag
+}
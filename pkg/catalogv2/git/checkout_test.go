@@ -0,0 +1,131 @@
+package git
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatestTag(t *testing.T) {
+	lsRemote := "a1\trefs/tags/v1.0.0\n" +
+		"a2\trefs/tags/v1.2.0\n" +
+		"a3\trefs/tags/v1.3.0-rc1\n" +
+		"a4\trefs/tags/v2.0.0\n" +
+		"a5\trefs/tags/latest\n"
+
+	tests := []struct {
+		constraint string
+		expected   string
+	}{
+		{"*", "v2.0.0"},
+		{"~1", "v1.2.0"},
+		{">= 1.2.1-0, < 1.4.0-0", "v1.3.0-rc1"},
+		{"> 3", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.constraint, func(t *testing.T) {
+			constraints, err := semver.NewConstraint(test.constraint)
+			if assert.NoError(t, err) {
+				assert.Equal(t, test.expected, latestTag(lsRemote, constraints))
+			}
+		})
+	}
+}
+
+func TestCleanSubPath(t *testing.T) {
+	for input, expected := range map[string]string{
+		"":              "",
+		"/":             "",
+		"charts":        "charts",
+		"/charts/":      "charts",
+		"charts/../app": "app",
+	} {
+		subPath, err := CleanSubPath(input)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, subPath)
+	}
+
+	for _, input := range []string{"..", "../other", "charts/../../other"} {
+		_, err := CleanSubPath(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func gitCmd(t *testing.T, dir string, args ...string) {
+	cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v: %s", args, err, output)
+	}
+}
+
+func TestCustomCheckout(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	tmp, err := ioutil.TempDir("", "git-checkout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	source := filepath.Join(tmp, "source")
+	files := map[string]string{
+		"charts/mychart/Chart.yaml":  "apiVersion: v2\nname: mychart\nversion: 1.0.0\n",
+		"charts/mychart/values.yaml": "replicas: 1\n",
+		"app/main.go":                "package main\n",
+	}
+	for name, content := range files {
+		file := filepath.Join(source, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gitCmd(t, source, "init", "-q")
+	gitCmd(t, source, "config", "uploadpack.allowAnySHA1InWant", "true")
+	gitCmd(t, source, "add", ".")
+	gitCmd(t, source, "commit", "-q", "-m", "initial")
+	gitCmd(t, source, "tag", "v1.0.0")
+
+	dir := filepath.Join(tmp, "checkout")
+	c, err := newCheckout(nil, dir, "file://"+source, Options{Tag: "v1.0.0", SubPath: "charts"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer c.Close()
+
+	commit, err := c.head()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotEmpty(t, commit)
+	assert.FileExists(t, filepath.Join(dir, "charts/mychart/Chart.yaml"))
+	assert.NoFileExists(t, filepath.Join(dir, "app/main.go"))
+
+	index, err := buildOrGetIndex(dir, "charts")
+	if assert.NoError(t, err) && assert.Len(t, index.Entries["mychart"], 1) {
+		assert.Equal(t, []string{"charts/mychart"}, index.Entries["mychart"][0].URLs)
+	}
+
+	// a new tag isn't followed until it is the one checked out
+	gitCmd(t, source, "commit", "-q", "--allow-empty", "-m", "next")
+	gitCmd(t, source, "tag", "v1.1.0")
+	updated, err := c.update()
+	assert.NoError(t, err)
+	assert.Equal(t, commit, updated)
+
+	c.opts.Tag = "v1.1.0"
+	updated, err = c.update()
+	assert.NoError(t, err)
+	assert.NotEqual(t, commit, updated)
+
+	assert.NoError(t, c.ensure(commit))
+	assert.Equal(t, commit, c.currentCommit())
+}
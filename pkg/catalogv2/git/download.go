@@ -27,37 +27,64 @@ func gitDir(namespace, name, gitURL string) string {
 	return filepath.Join(stateDir, namespace, name, hash(gitURL))
 }
 
-func Head(secret *corev1.Secret, namespace, name, gitURL, branch string, insecureSkipTLS bool) (string, error) {
-	git, err := gitForRepo(secret, namespace, name, gitURL, insecureSkipTLS)
+func Head(secret *corev1.Secret, namespace, name, gitURL string, opts Options) (string, error) {
+	if opts.custom() {
+		c, err := customCheckout(secret, namespace, name, gitURL, opts)
+		if err != nil {
+			return "", err
+		}
+		defer c.Close()
+		return c.head()
+	}
+
+	git, err := gitForRepo(secret, namespace, name, gitURL, opts.InsecureSkipTLS)
 	if err != nil {
 		return "", err
 	}
 
-	return git.Head(branch)
+	return git.Head(opts.Branch)
 }
 
-func Update(secret *corev1.Secret, namespace, name, gitURL, branch string, insecureSkipTLS bool) (string, error) {
-	git, err := gitForRepo(secret, namespace, name, gitURL, insecureSkipTLS)
+func Update(secret *corev1.Secret, namespace, name, gitURL string, opts Options) (string, error) {
+	if opts.custom() {
+		c, err := customCheckout(secret, namespace, name, gitURL, opts)
+		if err != nil {
+			return "", err
+		}
+		defer c.Close()
+		return c.update()
+	}
+
+	git, err := gitForRepo(secret, namespace, name, gitURL, opts.InsecureSkipTLS)
 	if err != nil {
 		return "", err
 	}
 
 	if isBundled(git) && settings.SystemCatalog.Get() == "bundled" {
-		return Head(secret, namespace, name, gitURL, branch, insecureSkipTLS)
+		return Head(secret, namespace, name, gitURL, opts)
 	}
 
-	commit, err := git.Update(branch)
+	commit, err := git.Update(opts.Branch)
 	if err != nil && isBundled(git) {
-		return Head(secret, namespace, name, gitURL, branch, insecureSkipTLS)
+		return Head(secret, namespace, name, gitURL, opts)
 	}
 	return commit, err
 }
 
-func Ensure(secret *corev1.Secret, namespace, name, gitURL, commit string, insecureSkipTLS bool) error {
+func Ensure(secret *corev1.Secret, namespace, name, gitURL, commit string, opts Options) error {
 	if commit == "" {
 		return nil
 	}
-	git, err := gitForRepo(secret, namespace, name, gitURL, insecureSkipTLS)
+	if opts.custom() {
+		c, err := customCheckout(secret, namespace, name, gitURL, opts)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		return c.ensure(commit)
+	}
+
+	git, err := gitForRepo(secret, namespace, name, gitURL, opts.InsecureSkipTLS)
 	if err != nil {
 		return err
 	}
@@ -69,17 +96,42 @@ func isBundled(git *git.Git) bool {
 	return strings.HasPrefix(git.Directory, staticDir)
 }
 
-func gitForRepo(secret *corev1.Secret, namespace, name, gitURL string, insecureSkipTLS bool) (*git.Git, error) {
+func validateURL(gitURL string) error {
 	if !strings.HasPrefix(gitURL, "git@") {
 		u, err := url.Parse(gitURL)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse URL %s: %w", gitURL, err)
+			return fmt.Errorf("failed to parse URL %s: %w", gitURL, err)
 		}
 		if u.Scheme != "http" && u.Scheme != "https" {
-			return nil, fmt.Errorf("invalid git URL scheme %s, only http(s) and git supported", u.Scheme)
+			return fmt.Errorf("invalid git URL scheme %s, only http(s) and git supported", u.Scheme)
 		}
 	}
+	return nil
+}
+
+func customCheckout(secret *corev1.Secret, namespace, name, gitURL string, opts Options) (*checkout, error) {
+	if err := validateURL(gitURL); err != nil {
+		return nil, err
+	}
+	subPath, err := CleanSubPath(opts.SubPath)
+	if err != nil {
+		return nil, err
+	}
+	opts.SubPath = subPath
+	return newCheckout(secret, gitDir(namespace, name, gitURL), gitURL, opts)
+}
+
+func gitForRepo(secret *corev1.Secret, namespace, name, gitURL string, insecureSkipTLS bool) (*git.Git, error) {
+	if err := validateURL(gitURL); err != nil {
+		return nil, err
+	}
 	dir := gitDir(namespace, name, gitURL)
+	// a custom checkout is cloned again the default way
+	if _, err := os.Stat(filepath.Join(dir, ".git", checkoutFile)); err == nil {
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, fmt.Errorf("failed to remove directory %s: %v", dir, err)
+		}
+	}
 	headers := map[string]string{}
 	if settings.InstallUUID.Get() != "" {
 		headers["X-Install-Uuid"] = settings.InstallUUID.Get()
@@ -10,13 +10,19 @@
 	"helm.sh/helm/v3/pkg/repo"
 )
 
-func BuildOrGetIndex(namespace, name, gitURL string) (*repo.IndexFile, error) {
+// BuildOrGetIndex returns the index of the charts of a git repo, only the charts of the sub-path are indexed when set.
+func BuildOrGetIndex(namespace, name, gitURL, subPath string) (*repo.IndexFile, error) {
+	subPath, err := CleanSubPath(subPath)
+	if err != nil {
+		return nil, err
+	}
 	dir := gitDir(namespace, name, gitURL)
-	return buildOrGetIndex(dir)
+	return buildOrGetIndex(dir, subPath)
 }
 
-func buildOrGetIndex(dir string) (*repo.IndexFile, error) {
-	if err := ensureNoSymlinks(dir); err != nil {
+func buildOrGetIndex(dir, subPath string) (*repo.IndexFile, error) {
+	root := filepath.Join(dir, subPath)
+	if err := ensureNoSymlinks(root); err != nil {
 		return nil, err
 	}
 
@@ -26,7 +32,13 @@ func buildOrGetIndex(dir string) (*repo.IndexFile, error) {
 		builtIndex    = repo.NewIndexFile()
 	)
 
-	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
 		if info.Name() == "index.yaml" {
 			if indexPath == "" || len(path) < len(indexPath) {
 				if index, err := repo.LoadIndexFile(path); err == nil {
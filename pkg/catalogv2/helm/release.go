@@ -10,6 +10,7 @@
 
 	"github.com/rancher/wrangler/pkg/data"
 	"github.com/rancher/wrangler/pkg/yaml"
+	"helm.sh/helm/v3/pkg/release"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	meta2 "k8s.io/apimachinery/pkg/api/meta"
@@ -79,6 +80,24 @@ func ToRelease(obj runtime.Object, isNamespaced IsNamespaced) (*v1.ReleaseSpec,
 	return nil, ErrNotHelmRelease
 }
 
+// Helm3Release decodes the helm 3 release stored in a secret or a configmap.
+func Helm3Release(obj runtime.Object) (*release.Release, error) {
+	releaseData, err := getReleaseDataAndKind(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isHelm3(meta.GetLabels()) {
+		return nil, ErrNotHelmRelease
+	}
+	return decodeHelm3(releaseData)
+}
+
 func getReleaseDataAndKind(obj runtime.Object) (string, error) {
 	switch t := obj.(type) {
 	case *unstructured.Unstructured:
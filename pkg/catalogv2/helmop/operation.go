@@ -22,7 +22,9 @@
 	types2 "github.com/rancher/rancher/pkg/api/steve/catalog/types"
 	catalog "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/catalogv2"
 	"github.com/rancher/rancher/pkg/catalogv2/content"
+	"github.com/rancher/rancher/pkg/features"
 	catalogcontrollers "github.com/rancher/rancher/pkg/generated/controllers/catalog.cattle.io/v1"
 	namespaces "github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/rancher/pkg/settings"
@@ -656,6 +658,10 @@ func (s *Operations) getChartCommand(namespace, name, chartName, chartVersion st
 		return Command{}, err
 	}
 
+	if err := s.verifyChart(namespace, name, chartName, chartVersion, chartData); err != nil {
+		return Command{}, err
+	}
+
 	chartData, err = injectAnnotation(chartData, annotations)
 	if err != nil {
 		return Command{}, err
@@ -678,6 +684,39 @@ func (s *Operations) getChartCommand(namespace, name, chartName, chartVersion st
 	return c, nil
 }
 
+// verifyChart checks the signature of a chart before it is installed. Charts with an invalid signature are always
+// rejected, charts which aren't verified are rejected only if the require-signed-charts feature is enabled or their
+// repo requires signed charts. The bundled repos are trusted as the system charts are installed from them.
+func (s *Operations) verifyChart(namespace, name, chartName, chartVersion string, chartData []byte) error {
+	if catalogv2.IsBundledRepo(namespace, name) {
+		return nil
+	}
+
+	signature, err := s.contentManager.Verify(namespace, name, chartName, chartVersion, chartData)
+	if err != nil {
+		return fmt.Errorf("failed to verify chart %s version %s: %w", chartName, chartVersion, err)
+	}
+	if signature.State == types2.SignatureInvalid {
+		return fmt.Errorf("chart %s version %s has an invalid %s signature: %s: %w", chartName, chartVersion, signature.Type, signature.Message, validation.PermissionDenied)
+	}
+	if signature.State == types2.SignatureVerified {
+		return nil
+	}
+
+	clusterRepo, err := s.clusterRepos.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !features.RequireSignedCharts.Enabled() && !clusterRepo.Spec.RequireSignedCharts {
+		return nil
+	}
+	state := string(signature.State)
+	if signature.Message != "" {
+		state += " (" + signature.Message + ")"
+	}
+	return fmt.Errorf("chart %s version %s is %s, only charts with a verified signature can be installed from repo %s: %w", chartName, chartVersion, state, name, validation.PermissionDenied)
+}
+
 func (s *Operations) getInstallCommand(repoNamespace, repoName string, body io.Reader) (catalog.OperationStatus, Commands, error) {
 	installArgs := &types2.ChartInstallAction{}
 	err := json.NewDecoder(body).Decode(installArgs)
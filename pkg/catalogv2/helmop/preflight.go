@@ -0,0 +1,309 @@
+package helmop
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/rancher/apiserver/pkg/types"
+	types2 "github.com/rancher/rancher/pkg/api/steve/catalog/types"
+	"github.com/rancher/rancher/pkg/catalogv2/helm"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// Preflight validates the values of the charts of an upgrade against their values.schema.json and renders them
+// the way helm would, returning the manifest of each chart and its diff against the deployed release. Nothing is
+// installed, the current releases are read with the permissions of the user.
+func (s *Operations) Preflight(apiOp *types.APIRequest, repoNamespace, repoName string, body io.Reader) (*types2.ChartPreflightOutput, error) {
+	upgradeArgs := &types2.ChartUpgradeAction{}
+	if err := json.NewDecoder(body).Decode(upgradeArgs); err != nil {
+		return nil, err
+	}
+
+	client, err := s.cg.K8sInterface(apiOp)
+	if err != nil {
+		return nil, err
+	}
+	caps, err := s.capabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	output := &types2.ChartPreflightOutput{}
+	releaseNamespace := namespace(upgradeArgs.Namespace)
+	for _, chartUpgrade := range upgradeArgs.Charts {
+		preflight, err := s.preflight(apiOp, client, caps, repoNamespace, repoName, releaseNamespace, chartUpgrade)
+		if err != nil {
+			return nil, err
+		}
+		output.Charts = append(output.Charts, *preflight)
+	}
+
+	return output, nil
+}
+
+func (s *Operations) preflight(apiOp *types.APIRequest, client kubernetes.Interface, caps *chartutil.Capabilities, repoNamespace, repoName, releaseNamespace string, chartUpgrade types2.ChartUpgrade) (*types2.ChartPreflight, error) {
+	result := &types2.ChartPreflight{
+		ChartName:   chartUpgrade.ChartName,
+		Version:     chartUpgrade.Version,
+		ReleaseName: chartUpgrade.ReleaseName,
+	}
+	if result.ReleaseName == "" {
+		result.ReleaseName = chartUpgrade.ChartName
+	}
+
+	chartData, err := s.contentManager.Chart(repoNamespace, repoName, chartUpgrade.ChartName, chartUpgrade.Version, true)
+	if err != nil {
+		return nil, err
+	}
+	defer chartData.Close()
+
+	chrt, err := loader.LoadArchive(chartData)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := deployedRelease(apiOp, client, releaseNamespace, result.ReleaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}(chartUpgrade.Values)
+	options := chartutil.ReleaseOptions{
+		Name:      result.ReleaseName,
+		Namespace: releaseNamespace,
+		Revision:  1,
+		IsInstall: true,
+	}
+	var currentManifest string
+	if current != nil {
+		result.CurrentRevision = current.Version
+		if current.Chart != nil && current.Chart.Metadata != nil {
+			result.CurrentVersion = current.Chart.Metadata.Version
+		}
+		currentManifest = current.Manifest
+		// same as helm upgrade without --reuse-values, the values of the current release are kept if none are given
+		if !chartUpgrade.ResetValues && len(values) == 0 && len(current.Config) > 0 {
+			values = current.Config
+		}
+		options.Revision = current.Version + 1
+		options.IsInstall = false
+		options.IsUpgrade = true
+	}
+
+	result.Manifest, result.Errors = render(chrt, values, options, caps)
+	result.Valid = len(result.Errors) == 0
+	if !result.Valid {
+		return result, nil
+	}
+
+	result.Diff, err = manifestDiff(currentManifest, result.Manifest,
+		fmt.Sprintf("%s (revision %d)", result.ReleaseName, result.CurrentRevision),
+		fmt.Sprintf("%s (%s %s)", result.ReleaseName, chartUpgrade.ChartName, chartUpgrade.Version))
+	if err != nil {
+		return nil, err
+	}
+	result.Added, result.Removed, result.Changed = resourceChanges(currentManifest, result.Manifest, releaseNamespace)
+	return result, nil
+}
+
+// capabilities returns the capabilities of the cluster the charts are rendered for.
+func (s *Operations) capabilities() (*chartutil.Capabilities, error) {
+	client, err := s.cg.AdminK8sInterface()
+	if err != nil {
+		return nil, err
+	}
+	serverVersion, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	versions, err := action.GetVersionSet(client.Discovery())
+	if err != nil {
+		return nil, err
+	}
+	return &chartutil.Capabilities{
+		KubeVersion: chartutil.KubeVersion{
+			Version: serverVersion.GitVersion,
+			Major:   serverVersion.Major,
+			Minor:   serverVersion.Minor,
+		},
+		APIVersions: versions,
+		HelmVersion: chartutil.DefaultCapabilities.HelmVersion,
+	}, nil
+}
+
+// deployedRelease returns the deployed revision of a helm 3 release, or nil if the release isn't installed.
+func deployedRelease(apiOp *types.APIRequest, client kubernetes.Interface, namespace, name string) (*release.Release, error) {
+	secrets, err := client.CoreV1().Secrets(namespace).List(apiOp.Context(), metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			"owner":  "helm",
+			"name":   name,
+			"status": string(release.StatusDeployed),
+		}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		latest  *release.Release
+		version int
+	)
+	for i := range secrets.Items {
+		v, err := strconv.Atoi(secrets.Items[i].Labels["version"])
+		if err != nil || v <= version {
+			continue
+		}
+		rel, err := helm.Helm3Release(&secrets.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		latest, version = rel, v
+	}
+	return latest, nil
+}
+
+// render renders the manifest of a chart the same way helm template does, hooks and notes aren't part of it.
+// Values which don't match the schema of the chart and rendering failures are returned as errors.
+func render(chrt *chart.Chart, values map[string]interface{}, options chartutil.ReleaseOptions, caps *chartutil.Capabilities) (string, []string) {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	if err := chartutil.ProcessDependencies(chrt, values); err != nil {
+		return "", []string{err.Error()}
+	}
+
+	coalesced, err := chartutil.CoalesceValues(chrt, values)
+	if err != nil {
+		return "", []string{err.Error()}
+	}
+	if err := chartutil.ValidateAgainstSchema(chrt, coalesced); err != nil {
+		return "", schemaErrors(err)
+	}
+
+	renderValues, err := chartutil.ToRenderValues(chrt, values, options, caps)
+	if err != nil {
+		return "", []string{err.Error()}
+	}
+	files, err := engine.Render(chrt, renderValues)
+	if err != nil {
+		return "", []string{err.Error()}
+	}
+	for name := range files {
+		if strings.HasSuffix(name, "NOTES.txt") {
+			delete(files, name)
+		}
+	}
+
+	_, manifests, err := releaseutil.SortManifests(files, caps.APIVersions, releaseutil.InstallOrder)
+	if err != nil {
+		return "", []string{err.Error()}
+	}
+
+	buf := &bytes.Buffer{}
+	for _, m := range manifests {
+		fmt.Fprintf(buf, "---\n# Source: %s\n%s\n", m.Name, m.Content)
+	}
+	return buf.String(), nil
+}
+
+// schemaErrors splits the error of the schema validation into one error per invalid value.
+func schemaErrors(err error) []string {
+	var result []string
+	for _, line := range strings.Split(err.Error(), "\n") {
+		if strings.HasPrefix(line, "- ") {
+			result = append(result, strings.TrimPrefix(line, "- "))
+		}
+	}
+	if len(result) == 0 {
+		return []string{err.Error()}
+	}
+	return result
+}
+
+func manifestDiff(from, to, fromName, toName string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+}
+
+type resourceHead struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+}
+
+// resourceChanges compares the resources of two manifests, resources are identified as
+// apiVersion/kind/namespace/name and ones without a namespace are assumed to be in the release namespace.
+func resourceChanges(from, to, namespace string) (added, removed, changed []string) {
+	fromResources := manifestResources(from, namespace)
+	toResources := manifestResources(to, namespace)
+
+	for key, content := range toResources {
+		fromContent, ok := fromResources[key]
+		switch {
+		case !ok:
+			added = append(added, key)
+		case fromContent != content:
+			changed = append(changed, key)
+		}
+	}
+	for key := range fromResources {
+		if _, ok := toResources[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	return
+}
+
+func manifestResources(manifest, namespace string) map[string]string {
+	result := map[string]string{}
+	for _, content := range releaseutil.SplitManifests(manifest) {
+		var head resourceHead
+		if err := yaml.Unmarshal([]byte(content), &head); err != nil || head.Kind == "" {
+			continue
+		}
+		if head.Metadata.Namespace == "" {
+			head.Metadata.Namespace = namespace
+		}
+		key := strings.Join([]string{head.APIVersion, head.Kind, head.Metadata.Namespace, head.Metadata.Name}, "/")
+		result[key] = stripSource(content)
+	}
+	return result
+}
+
+// stripSource removes the "# Source:" comment helm adds before each resource, so a template moved to
+// another file isn't reported as a change.
+func stripSource(content string) string {
+	lines := strings.Split(content, "\n")
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "# Source: ") {
+		lines = lines[1:]
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
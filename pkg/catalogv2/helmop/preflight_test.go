@@ -0,0 +1,73 @@
+package helmop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+)
+
+func testChart() *chart.Chart {
+	return &chart.Chart{
+		Metadata: &chart.Metadata{
+			APIVersion: chart.APIVersionV2,
+			Name:       "mychart",
+			Version:    "1.0.0",
+		},
+		Values: map[string]interface{}{
+			"replicas": 1,
+		},
+		Schema: []byte(`{"type":"object","properties":{"replicas":{"type":"integer","minimum":1},"image":{"type":"string"}}}`),
+		Templates: []*chart.File{
+			{
+				Name: "templates/configmap.yaml",
+				Data: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Release.Name }}\ndata:\n  replicas: \"{{ .Values.replicas }}\"\n"),
+			},
+			{
+				Name: "templates/NOTES.txt",
+				Data: []byte("installed {{ .Release.Name }}"),
+			},
+		},
+	}
+}
+
+func TestPreflightRender(t *testing.T) {
+	options := chartutil.ReleaseOptions{
+		Name:      "test",
+		Namespace: "default",
+		Revision:  1,
+		IsInstall: true,
+	}
+
+	manifest, errs := render(testChart(), map[string]interface{}{"replicas": 3}, options, chartutil.DefaultCapabilities)
+	assert.Empty(t, errs)
+	assert.Equal(t, "---\n# Source: mychart/templates/configmap.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\ndata:\n  replicas: \"3\"\n", manifest)
+
+	_, errs = render(testChart(), map[string]interface{}{"replicas": 0, "image": 1}, options, chartutil.DefaultCapabilities)
+	assert.Len(t, errs, 2)
+}
+
+func TestResourceChanges(t *testing.T) {
+	from := "---\n# Source: mychart/templates/a.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\ndata:\n  key: old\n" +
+		"---\n# Source: mychart/templates/b.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n" +
+		"---\n# Source: mychart/templates/c.yaml\napiVersion: v1\nkind: Secret\nmetadata:\n  name: c\n  namespace: other\n"
+	to := "---\n# Source: mychart/templates/a.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\ndata:\n  key: new\n" +
+		"---\n# Source: mychart/templates/moved.yaml\napiVersion: v1\nkind: Secret\nmetadata:\n  name: c\n  namespace: other\n" +
+		"---\n# Source: mychart/templates/d.yaml\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: d\n"
+
+	added, removed, changed := resourceChanges(from, to, "default")
+	assert.Equal(t, []string{"apps/v1/Deployment/default/d"}, added)
+	assert.Equal(t, []string{"v1/ConfigMap/default/b"}, removed)
+	assert.Equal(t, []string{"v1/ConfigMap/default/a"}, changed)
+
+	diff, err := manifestDiff(from, to, "test (revision 1)", "test (mychart 1.1.0)")
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "--- test (revision 1)\n+++ test (mychart 1.1.0)\n")
+	assert.Contains(t, diff, "-  key: old\n+  key: new\n")
+
+	added, removed, changed = resourceChanges("", to, "default")
+	assert.Len(t, added, 3)
+	assert.Empty(t, removed)
+	assert.Empty(t, changed)
+}
@@ -76,7 +76,59 @@ func Chart(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipT
 	}
 	defer client.CloseIdleConnections()
 
-	u, err := url.Parse(chart.URLs[0])
+	u, err := chartURL(repoURL, chart.URLs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	return ioutil.NopCloser(bytes.NewBuffer(data)), err
+}
+
+// Provenance downloads the Helm provenance file published next to the archive of a chart version, and returns the
+// file name of the archive it is expected to sign. A NotFound error is returned if the chart isn't signed.
+func Provenance(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool, disableSameOriginCheck bool, chart *repo.ChartVersion) ([]byte, string, error) {
+	if len(chart.URLs) == 0 {
+		return nil, "", fmt.Errorf("failed to find chartName %s version %s: %w", chart.Name, chart.Version, validation.NotFound)
+	}
+
+	client, err := HelmClient(secret, caBundle, insecureSkipTLSVerify, disableSameOriginCheck, repoURL)
+	if err != nil {
+		return nil, "", err
+	}
+	defer client.CloseIdleConnections()
+
+	u, err := chartURL(repoURL, chart.URLs[0])
+	if err != nil {
+		return nil, "", err
+	}
+	fileName := path.Base(u.Path)
+	u.Path += ".prov"
+
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", validation.ErrorCode{
+			Status: resp.StatusCode,
+		}
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	return data, fileName, err
+}
+
+func chartURL(repoURL, chartURL string) (*url.URL, error) {
+	u, err := url.Parse(chartURL)
 	if err != nil {
 		return nil, err
 	}
@@ -93,27 +145,28 @@ func Chart(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipT
 		// contain an access credential.
 		u.RawQuery = base.RawQuery
 	}
+	return u, nil
+}
 
-	resp, err := client.Get(u.String())
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	data, err := ioutil.ReadAll(resp.Body)
-	return ioutil.NopCloser(bytes.NewBuffer(data)), err
+// IndexVersion holds the validators the server returned with an index.yaml, they are sent with the next download
+// so the index is only transferred and parsed again once it changed.
+type IndexVersion struct {
+	ETag         string
+	LastModified string
 }
 
-func DownloadIndex(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool, disableSameOriginCheck bool) (*repo.IndexFile, error) {
+// DownloadIndex downloads the index.yaml of a repo. A nil index is returned if the server reports the index didn't
+// change since the given version.
+func DownloadIndex(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool, disableSameOriginCheck bool, version IndexVersion) (*repo.IndexFile, IndexVersion, error) {
 	client, err := HelmClient(secret, caBundle, insecureSkipTLSVerify, disableSameOriginCheck, repoURL)
 	if err != nil {
-		return nil, err
+		return nil, version, err
 	}
 	defer client.CloseIdleConnections()
 
 	parsedURL, err := url.Parse(repoURL)
 	if err != nil {
-		return nil, err
+		return nil, version, err
 	}
 
 	parsedURL.RawPath = path.Join(parsedURL.RawPath, "index.yaml")
@@ -124,19 +177,30 @@ func DownloadIndex(secret *corev1.Secret, repoURL string, caBundle []byte, insec
 
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		return nil, err
+		return nil, version, err
 	}
 	req.Header.Set("X-Install-Uuid", settings.InstallUUID.Get())
+	if version.ETag != "" {
+		req.Header.Set("If-None-Match", version.ETag)
+	}
+	if version.LastModified != "" {
+		req.Header.Set("If-Modified-Since", version.LastModified)
+	}
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, version, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified {
+		logrus.Debugf("Repo index %s not modified", url)
+		return nil, version, nil
+	}
+
 	bytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, version, err
 	}
 
 	// Marshall to file to ensure it matches the schema and this component doesn't just
@@ -144,12 +208,15 @@ func DownloadIndex(secret *corev1.Secret, repoURL string, caBundle []byte, insec
 	index := &repo.IndexFile{}
 	if err := yaml.Unmarshal(bytes, index); err != nil {
 		logrus.Errorf("failed to unmarshal %s: %v", url, err)
-		return nil, fmt.Errorf("failed to parse response from %s", url)
+		return nil, version, fmt.Errorf("failed to parse response from %s", url)
 	}
 
 	if index.APIVersion == "" {
-		return nil, repo.ErrNoAPIVersion
+		return nil, version, repo.ErrNoAPIVersion
 	}
 
-	return index, nil
+	return index, IndexVersion{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, nil
 }
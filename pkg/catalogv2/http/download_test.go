@@ -0,0 +1,39 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadIndexNotModified(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			rw.WriteHeader(http.StatusNotModified)
+			return
+		}
+		rw.Header().Set("ETag", `"v1"`)
+		rw.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		rw.Write([]byte("apiVersion: v1\nentries:\n  mychart:\n  - name: mychart\n    version: 1.0.0\n    urls:\n    - mychart-1.0.0.tgz\n"))
+	}))
+	defer server.Close()
+
+	index, version, err := DownloadIndex(nil, server.URL, nil, false, false, IndexVersion{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.NotNil(t, index) {
+		assert.Len(t, index.Entries["mychart"], 1)
+	}
+	assert.Equal(t, IndexVersion{ETag: `"v1"`, LastModified: "Mon, 02 Jan 2006 15:04:05 GMT"}, version)
+
+	index, notModified, err := DownloadIndex(nil, server.URL, nil, false, false, version)
+	assert.NoError(t, err)
+	assert.Nil(t, index)
+	assert.Equal(t, version, notModified)
+	assert.Equal(t, 2, requests)
+}
@@ -0,0 +1,330 @@
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	helmhttp "github.com/rancher/rancher/pkg/catalogv2/http"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	Scheme = "oci://"
+
+	// dockerManifestMediaType is still used by cosign to store signatures on some registries.
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	catalogScope = "registry:catalog:*"
+	pageSize     = 1000
+)
+
+var (
+	challengeParams = regexp.MustCompile(`(\w+)="([^"]*)"`)
+	nextLink        = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+)
+
+// IsOCI returns true if the URL of a repo points to an OCI registry.
+func IsOCI(repoURL string) bool {
+	return strings.HasPrefix(repoURL, Scheme)
+}
+
+// Client talks to the distribution API of a registry. Bearer tokens are requested from the realm of the challenges
+// returned by the registry with the basic auth credentials of the repo, and cached per scope.
+type Client struct {
+	client   *http.Client
+	baseURL  string
+	host     string
+	username string
+	password string
+	tokens   map[string]string
+}
+
+func NewClient(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool) (*Client, error) {
+	host, _, err := parseReference(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		host:   host,
+		tokens: map[string]string{},
+	}
+	// The basic auth credentials are handled here as the registry may ask for a bearer token instead, the other
+	// secret types only configure the transport.
+	if secret != nil && secret.Type == corev1.SecretTypeBasicAuth {
+		c.username = string(secret.Data[corev1.BasicAuthUsernameKey])
+		c.password = string(secret.Data[corev1.BasicAuthPasswordKey])
+		secret = nil
+	}
+	c.client, err = helmhttp.HelmClient(secret, caBundle, insecureSkipTLSVerify, false, repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := "https"
+	if isLoopback(host) {
+		scheme = "http"
+	}
+	c.baseURL = scheme + "://" + host
+	return c, nil
+}
+
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
+
+// parseReference splits an oci:// URL into the registry host and the repository path, the tag is left in the path.
+func parseReference(ref string) (string, string, error) {
+	if !IsOCI(ref) {
+		return "", "", fmt.Errorf("invalid OCI reference %s: missing %s scheme", ref, Scheme)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(ref, Scheme), "/", 2)
+	if parts[0] == "" {
+		return "", "", fmt.Errorf("invalid OCI reference %s: missing registry host", ref)
+	}
+	if len(parts) == 1 {
+		return parts[0], "", nil
+	}
+	return parts[0], strings.Trim(parts[1], "/"), nil
+}
+
+func isLoopback(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func repositoryScope(repository string) string {
+	return fmt.Sprintf("repository:%s:pull", repository)
+}
+
+// get requests a path of the registry API and returns the response when its status is 200. The body of the response
+// must be closed by the caller.
+func (c *Client) get(path, scope string, accept ...string) (*http.Response, error) {
+	resp, err := c.do(path, scope, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.tokens[scope] == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		drain(resp)
+		if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+			return nil, validation.ErrorCode{Status: http.StatusUnauthorized}
+		}
+		token, err := c.token(challenge, scope)
+		if err != nil {
+			return nil, err
+		}
+		c.tokens[scope] = token
+		resp, err = c.do(path, scope, accept)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		drain(resp)
+		return nil, validation.ErrorCode{
+			Status: resp.StatusCode,
+		}
+	}
+	return resp, nil
+}
+
+func (c *Client) do(path, scope string, accept []string) (*http.Response, error) {
+	u := path
+	if !strings.HasPrefix(u, "http://") && !strings.HasPrefix(u, "https://") {
+		u = c.baseURL + path
+	}
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, mediaType := range accept {
+		req.Header.Add("Accept", mediaType)
+	}
+	// absolute urls come from the Link headers of the registry, the credentials of the repo are only sent to its host
+	if req.URL.Host != c.host {
+		return c.client.Do(req)
+	}
+	if token := c.tokens[scope]; token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if c.username != "" || c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return c.client.Do(req)
+}
+
+// token requests a bearer token for a scope from the realm of a challenge.
+func (c *Client) token(challenge, scope string) (string, error) {
+	params := map[string]string{}
+	for _, match := range challengeParams.FindAllStringSubmatch(challenge, -1) {
+		params[strings.ToLower(match[1])] = match[2]
+	}
+	if params["realm"] == "" {
+		return "", fmt.Errorf("invalid authentication challenge from %s: missing realm", c.host)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", err
+	}
+	q := realm.Query()
+	if params["service"] != "" {
+		q.Set("service", params["service"])
+	}
+	q.Set("scope", scope)
+	realm.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.username != "" || c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer drain(resp)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get a token for %s from %s: %s", scope, realm.Host, resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
+
+// getJSON decodes the response of a path, and returns the next page link if any.
+func (c *Client) getJSON(path, scope string, obj interface{}, accept ...string) (string, error) {
+	resp, err := c.get(path, scope, accept...)
+	if err != nil {
+		return "", err
+	}
+	defer drain(resp)
+
+	if err := json.NewDecoder(resp.Body).Decode(obj); err != nil {
+		return "", err
+	}
+	next := ""
+	if match := nextLink.FindStringSubmatch(resp.Header.Get("Link")); match != nil {
+		next = match[1]
+	}
+	return next, nil
+}
+
+// Repositories lists the repositories of the registry under a path with the catalog API.
+func (c *Client) Repositories(prefix string) ([]string, error) {
+	var (
+		result []string
+		next   = fmt.Sprintf("/v2/_catalog?n=%d", pageSize)
+	)
+	for next != "" {
+		var page struct {
+			Repositories []string `json:"repositories"`
+		}
+		var err error
+		next, err = c.getJSON(next, catalogScope, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, repository := range page.Repositories {
+			if prefix == "" || strings.HasPrefix(repository, prefix+"/") {
+				result = append(result, repository)
+			}
+		}
+	}
+	return result, nil
+}
+
+// Tags lists the tags of a repository.
+func (c *Client) Tags(repository string) ([]string, error) {
+	var (
+		result []string
+		next   = fmt.Sprintf("/v2/%s/tags/list?n=%d", repository, pageSize)
+	)
+	for next != "" {
+		var page struct {
+			Tags []string `json:"tags"`
+		}
+		var err error
+		next, err = c.getJSON(next, repositoryScope(repository), &page)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.Tags...)
+	}
+	return result, nil
+}
+
+// Manifest returns the OCI manifest of a tag of a repository.
+func (c *Client) Manifest(repository, tag string) (*Manifest, error) {
+	resp, err := c.get(fmt.Sprintf("/v2/%s/manifests/%s", repository, tag), repositoryScope(repository), ManifestMediaType, dockerManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	defer drain(resp)
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	manifest.Digest = fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+	return manifest, nil
+}
+
+// Blob returns the content of a blob of a repository, the caller must close it.
+// Blob downloads a blob and checks that its content matches its digest.
+func (c *Client) Blob(repository, digest string) ([]byte, error) {
+	if !strings.HasPrefix(digest, "sha256:") {
+		return nil, fmt.Errorf("unsupported digest %s of a blob in %s", digest, repository)
+	}
+	resp, err := c.get(fmt.Sprintf("/v2/%s/blobs/%s", repository, digest), repositoryScope(repository))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if actual := fmt.Sprintf("sha256:%x", sha256.Sum256(data)); actual != digest {
+		return nil, fmt.Errorf("digest mismatch for blob %s in %s: got %s", digest, repository, actual)
+	}
+	return data, nil
+}
+
+func drain(resp *http.Response) {
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
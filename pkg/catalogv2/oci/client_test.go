@@ -0,0 +1,201 @@
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/repo"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	testToken  = "secret-token"
+	chartData  = "chart-archive"
+	configData = `{"apiVersion":"v2","name":"mychart","version":"1.0.0+build"}`
+)
+
+var (
+	chartDigest  = digestOf(chartData)
+	configDigest = digestOf(configData)
+)
+
+func digestOf(data string) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(data)))
+}
+
+// newRegistry returns a fake registry hosting the chart charts/mychart in version 1.0.0+build, pushed with the tag
+// 1.0.0_build, a latest tag and an image that must be ignored. The registry requires a bearer token issued for the
+// credentials user/pass.
+func newRegistry(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	manifest, _ := json.Marshal(Manifest{
+		SchemaVersion: 2,
+		Config:        Descriptor{MediaType: HelmConfigMediaType, Digest: configDigest},
+		Layers:        []Descriptor{{MediaType: HelmContentMediaType, Digest: chartDigest}},
+		Annotations:   map[string]string{createdAnnotation: "2021-01-02T15:04:05Z"},
+	})
+	image, _ := json.Marshal(Manifest{
+		SchemaVersion: 2,
+		Config:        Descriptor{MediaType: "application/vnd.oci.image.config.v1+json", Digest: "sha256:image"},
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(rw http.ResponseWriter, req *http.Request) {
+		if user, pass, ok := req.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(rw, `{"token":"%s"}`, testToken)
+	})
+	mux.HandleFunc("/v2/", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer "+testToken {
+			rw.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, server.URL))
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch req.URL.Path {
+		case "/v2/_catalog":
+			fmt.Fprint(rw, `{"repositories":["charts/mychart","other/image"]}`)
+		case "/v2/charts/mychart/tags/list":
+			fmt.Fprint(rw, `{"tags":["1.0.0_build","latest"]}`)
+		case "/v2/charts/mychart/manifests/1.0.0_build":
+			assert.Equal(t, ManifestMediaType, req.Header.Get("Accept"))
+			rw.Write(manifest)
+		case "/v2/charts/mychart/blobs/" + configDigest:
+			fmt.Fprint(rw, configData)
+		case "/v2/other/image/tags/list":
+			fmt.Fprint(rw, `{"tags":["2.0.0"]}`)
+		case "/v2/other/image/manifests/2.0.0":
+			rw.Write(image)
+		case "/v2/charts/mychart/blobs/" + chartDigest:
+			fmt.Fprint(rw, chartData)
+		case "/v2/charts/mychart/blobs/" + digestOf("tampered"):
+			fmt.Fprint(rw, chartData)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	})
+	server = httptest.NewServer(mux)
+	return server
+}
+
+func basicAuthSecret() *corev1.Secret {
+	return &corev1.Secret{
+		Type: corev1.SecretTypeBasicAuth,
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("user"),
+			corev1.BasicAuthPasswordKey: []byte("pass"),
+		},
+	}
+}
+
+func TestDownloadIndex(t *testing.T) {
+	server := newRegistry(t)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	tests := []struct {
+		name    string
+		repoURL string
+	}{
+		{"chart repository", Scheme + host + "/charts/mychart"},
+		{"namespace", Scheme + host + "/charts"},
+		{"registry", Scheme + host},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index, err := DownloadIndex(basicAuthSecret(), test.repoURL, nil, false)
+			if !assert.NoError(t, err) {
+				return
+			}
+			if assert.Len(t, index.Entries["mychart"], 1) {
+				version := index.Entries["mychart"][0]
+				assert.Equal(t, "1.0.0+build", version.Version)
+				assert.Equal(t, []string{Scheme + host + "/charts/mychart:1.0.0_build"}, version.URLs)
+				assert.Equal(t, chartDigest, version.Digest)
+				assert.Equal(t, 2021, version.Created.Year())
+			}
+			assert.Len(t, index.Entries, 1)
+		})
+	}
+}
+
+func TestDownloadIndexUnauthorized(t *testing.T) {
+	server := newRegistry(t)
+	defer server.Close()
+
+	_, err := DownloadIndex(nil, Scheme+strings.TrimPrefix(server.URL, "http://")+"/charts/mychart", nil, false)
+	assert.Error(t, err)
+}
+
+func TestChart(t *testing.T) {
+	server := newRegistry(t)
+	defer server.Close()
+	repoURL := Scheme + strings.TrimPrefix(server.URL, "http://") + "/charts/mychart"
+
+	chart, err := Chart(basicAuthSecret(), repoURL, nil, false, &repo.ChartVersion{
+		URLs: []string{repoURL + ":1.0.0_build"},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer chart.Close()
+	data, err := ioutil.ReadAll(chart)
+	assert.NoError(t, err)
+	assert.Equal(t, chartData, string(data))
+
+	_, err = Chart(basicAuthSecret(), repoURL, nil, false, &repo.ChartVersion{
+		URLs: []string{"oci://example.com/charts/mychart:1.0.0_build"},
+	})
+	assert.Error(t, err)
+}
+
+func TestBlobDigestMismatch(t *testing.T) {
+	server := newRegistry(t)
+	defer server.Close()
+
+	client, err := NewClient(basicAuthSecret(), Scheme+strings.TrimPrefix(server.URL, "http://"), nil, false)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = client.Blob("charts/mychart", digestOf("tampered"))
+	assert.Error(t, err)
+	_, err = client.Blob("charts/mychart", "md5:"+chartDigest)
+	assert.Error(t, err)
+}
+
+func TestCredentialsOnlySentToRegistry(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Empty(t, req.Header.Get("Authorization"))
+		fmt.Fprint(rw, `{"repositories":[]}`)
+	}))
+	defer other.Close()
+
+	client, err := NewClient(basicAuthSecret(), Scheme+"registry.example.com", nil, false)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp, err := client.do(other.URL+"/v2/_catalog?last=a", catalogScope, nil)
+	if assert.NoError(t, err) {
+		drain(resp)
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	host, path, err := parseReference("oci://registry.example.com:5000/charts/mychart/")
+	assert.NoError(t, err)
+	assert.Equal(t, "registry.example.com:5000", host)
+	assert.Equal(t, "charts/mychart", path)
+
+	_, _, err = parseReference("https://registry.example.com")
+	assert.Error(t, err)
+	_, _, err = parseReference("oci:///charts")
+	assert.Error(t, err)
+}
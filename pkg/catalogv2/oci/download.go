@@ -0,0 +1,264 @@
+package oci
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	"github.com/sirupsen/logrus"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/repo"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	ManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	HelmConfigMediaType  = "application/vnd.cncf.helm.config.v1+json"
+	HelmContentMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	createdAnnotation = "org.opencontainers.image.created"
+)
+
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type Manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType,omitempty"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+
+	// Digest is the sha256 digest of the manifest content returned by the registry.
+	Digest string `json:"-"`
+}
+
+func (m *Manifest) chartLayer() (Descriptor, bool) {
+	for _, layer := range m.Layers {
+		if layer.MediaType == HelmContentMediaType {
+			return layer, true
+		}
+	}
+	return Descriptor{}, false
+}
+
+// DownloadIndex synthesizes the index of an OCI repo. The URL of the repo is either a chart repository, in which
+// case its tags are the versions of the chart, or a namespace of the registry whose chart repositories are listed
+// with the catalog API. Tags that are not semantic versions and artifacts that are not Helm charts are ignored.
+func DownloadIndex(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool) (*repo.IndexFile, error) {
+	client, err := NewClient(secret, repoURL, caBundle, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	_, repoPath, err := parseReference(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	logrus.Infof("Building repo index from %s", repoURL)
+
+	repositories := []string{repoPath}
+	if _, err := client.Tags(repoPath); repoPath == "" || isNotFound(err) {
+		repositories, err = client.Repositories(repoPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the repositories of %s: %w", repoURL, err)
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	index := repo.NewIndexFile()
+	for _, repository := range repositories {
+		versions, err := chartVersions(client, repository)
+		if err != nil {
+			return nil, fmt.Errorf("failed to index %s%s/%s: %w", Scheme, client.host, repository, err)
+		}
+		for _, version := range versions {
+			index.Entries[version.Name] = append(index.Entries[version.Name], version)
+		}
+	}
+	return index, nil
+}
+
+func chartVersions(client *Client, repository string) ([]*repo.ChartVersion, error) {
+	tags, err := client.Tags(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*repo.ChartVersion
+	for _, tag := range tags {
+		// OCI tags can't contain '+', Helm replaces it with '_' when pushing charts.
+		if _, err := semver.StrictNewVersion(strings.ReplaceAll(tag, "_", "+")); err != nil {
+			continue
+		}
+		manifest, err := client.Manifest(repository, tag)
+		if err != nil {
+			return nil, err
+		}
+		layer, ok := manifest.chartLayer()
+		if manifest.Config.MediaType != HelmConfigMediaType || !ok {
+			continue
+		}
+		metadata, err := chartMetadata(client, repository, manifest.Config.Digest)
+		if err != nil {
+			return nil, err
+		}
+		if err := metadata.Validate(); err != nil {
+			logrus.Warnf("skipping invalid chart %s%s/%s:%s: %v", Scheme, client.host, repository, tag, err)
+			continue
+		}
+		version := &repo.ChartVersion{
+			Metadata: metadata,
+			URLs:     []string{fmt.Sprintf("%s%s/%s:%s", Scheme, client.host, repository, tag)},
+			Digest:   layer.Digest,
+		}
+		if created, err := time.Parse(time.RFC3339, manifest.Annotations[createdAnnotation]); err == nil {
+			version.Created = created
+		}
+		result = append(result, version)
+	}
+	return result, nil
+}
+
+func chartMetadata(client *Client, repository, digest string) (*chart.Metadata, error) {
+	blob, err := client.Blob(repository, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &chart.Metadata{}
+	return metadata, json.Unmarshal(blob, metadata)
+}
+
+// Chart downloads the archive of a chart version from the registry.
+func Chart(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool, chart *repo.ChartVersion) (io.ReadCloser, error) {
+	if len(chart.URLs) == 0 {
+		return nil, fmt.Errorf("failed to find chartName %s version %s: %w", chart.Name, chart.Version, validation.NotFound)
+	}
+
+	client, err := NewClient(secret, repoURL, caBundle, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	data, err := chartArchive(client, chart.URLs[0])
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewBuffer(data)), nil
+}
+
+func chartArchive(client *Client, chartURL string) ([]byte, error) {
+	repository, tag, err := chartReference(client, chartURL)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := client.Manifest(repository, tag)
+	if err != nil {
+		return nil, err
+	}
+	layer, ok := manifest.chartLayer()
+	if !ok {
+		return nil, fmt.Errorf("%s is not a helm chart: %w", chartURL, validation.NotFound)
+	}
+	return client.Blob(repository, layer.Digest)
+}
+
+// chartReference returns the repository and the tag of a chart version hosted by the registry of the client.
+func chartReference(client *Client, chartURL string) (string, string, error) {
+	host, repoPath, err := parseReference(chartURL)
+	if err != nil {
+		return "", "", err
+	}
+	// Only download from the registry of the repo so that its credentials are not sent elsewhere.
+	if host != client.host {
+		return "", "", fmt.Errorf("chart %s is not hosted by registry %s", chartURL, client.host)
+	}
+	i := strings.LastIndex(repoPath, ":")
+	if i < 0 {
+		return "", "", fmt.Errorf("invalid chart reference %s: missing tag", chartURL)
+	}
+	return repoPath[:i], repoPath[i+1:], nil
+}
+
+// Icon downloads the icon of a chart version. Icons with a http URL are downloaded without the credentials of the
+// registry, relative and file:// icons are read from the chart archive.
+func Icon(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool, chart *repo.ChartVersion) (io.ReadCloser, string, error) {
+	if len(chart.Icon) == 0 || len(chart.URLs) == 0 {
+		return nil, "", fmt.Errorf("failed to find chartName %s version %s: %w", chart.Name, chart.Version, validation.NotFound)
+	}
+
+	client, err := NewClient(secret, repoURL, caBundle, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, "", err
+	}
+	defer client.Close()
+
+	u, err := url.Parse(chart.Icon)
+	if err != nil {
+		return nil, "", err
+	}
+	if u.Scheme == "http" || u.Scheme == "https" {
+		data, err := download(client.client, u.String())
+		if err != nil {
+			return nil, "", err
+		}
+		return ioutil.NopCloser(bytes.NewBuffer(data)), path.Ext(u.Path), nil
+	}
+
+	archive, err := chartArchive(client, chart.URLs[0])
+	if err != nil {
+		return nil, "", err
+	}
+	files, err := loader.LoadArchiveFiles(bytes.NewBuffer(archive))
+	if err != nil {
+		return nil, "", err
+	}
+	name := path.Clean(strings.TrimPrefix(strings.TrimPrefix(chart.Icon, "file://"), "/"))
+	for _, file := range files {
+		if file.Name == name {
+			return ioutil.NopCloser(bytes.NewBuffer(file.Data)), path.Ext(name), nil
+		}
+	}
+	return nil, "", fmt.Errorf("failed to find icon %s of chartName %s version %s: %w", chart.Icon, chart.Name, chart.Version, validation.NotFound)
+}
+
+func download(client *http.Client, u string) ([]byte, error) {
+	resp, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		defer ioutil.ReadAll(resp.Body)
+		return nil, validation.ErrorCode{
+			Status: resp.StatusCode,
+		}
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func isNotFound(err error) bool {
+	var code validation.ErrorCode
+	return errors.As(err, &code) && code.Status == http.StatusNotFound
+}
@@ -0,0 +1,77 @@
+package oci
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	"helm.sh/helm/v3/pkg/repo"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	cosignSignatureMediaType  = "application/vnd.dev.cosign.simplesigning.v1+json"
+)
+
+// Signature is a cosign signature of the manifest of a chart version.
+type Signature struct {
+	ManifestDigest string
+	ChartDigest    string
+	Payload        []byte
+	Signature      string
+}
+
+// Signatures returns the cosign signatures of a chart version. Cosign stores them as the layers of the manifest tagged
+// after the digest of the signed manifest. A NotFound error is returned if the chart isn't signed.
+func Signatures(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool, chart *repo.ChartVersion) ([]Signature, error) {
+	if len(chart.URLs) == 0 {
+		return nil, fmt.Errorf("failed to find chartName %s version %s: %w", chart.Name, chart.Version, validation.NotFound)
+	}
+
+	client, err := NewClient(secret, repoURL, caBundle, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	repository, tag, err := chartReference(client, chart.URLs[0])
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := client.Manifest(repository, tag)
+	if err != nil {
+		return nil, err
+	}
+	chartLayer, ok := manifest.chartLayer()
+	if !ok {
+		return nil, fmt.Errorf("%s is not a helm chart: %w", chart.URLs[0], validation.NotFound)
+	}
+
+	signatures, err := client.Manifest(repository, strings.Replace(manifest.Digest, ":", "-", 1)+".sig")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Signature
+	for _, layer := range signatures.Layers {
+		signature := layer.Annotations[cosignSignatureAnnotation]
+		if layer.MediaType != cosignSignatureMediaType || signature == "" {
+			continue
+		}
+		payload, err := client.Blob(repository, layer.Digest)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, Signature{
+			ManifestDigest: manifest.Digest,
+			ChartDigest:    chartLayer.Digest,
+			Payload:        payload,
+			Signature:      signature,
+		})
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no signature found for %s: %w", chart.URLs[0], validation.NotFound)
+	}
+	return result, nil
+}
@@ -0,0 +1,13 @@
+package catalogv2
+
+// bundledRepos are the repos Rancher creates to install its own charts from. The partner charts repo is not one of
+// them as its charts are not built by Rancher.
+var bundledRepos = map[string]bool{
+	"rancher-charts":      true,
+	"rancher-rke2-charts": true,
+}
+
+// IsBundledRepo returns whether a cluster repo is one of the repos Rancher creates and installs its own charts from.
+func IsBundledRepo(namespace, name string) bool {
+	return namespace == "" && bundledRepos[name]
+}
@@ -0,0 +1,178 @@
+package verify
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/clearsign"
+	"helm.sh/helm/v3/pkg/provenance"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// KeyringKey is the key of the verification secret holding the PGP keyring, armored or binary.
+	KeyringKey = "keyring"
+	// CosignKey is the key of the verification secret holding the PEM encoded cosign public keys.
+	CosignKey = "cosign.pub"
+
+	ProvenanceType = "provenance"
+	CosignType     = "cosign"
+)
+
+// Keys are the keys of a repo trusted to sign its charts.
+type Keys struct {
+	Keyring    openpgp.EntityList
+	CosignKeys []crypto.PublicKey
+}
+
+// KeysFromSecret loads the trusted keys of a repo from its verification secret.
+func KeysFromSecret(secret *corev1.Secret) (*Keys, error) {
+	keys := &Keys{}
+	if secret == nil {
+		return keys, nil
+	}
+
+	if data := secret.Data[KeyringKey]; len(data) > 0 {
+		var err error
+		if bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN")) {
+			keys.Keyring, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+		} else {
+			keys.Keyring, err = openpgp.ReadKeyRing(bytes.NewReader(data))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyring in secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+	}
+
+	rest := secret.Data[CosignKey]
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cosign public key in secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		keys.CosignKeys = append(keys.CosignKeys, key)
+	}
+
+	return keys, nil
+}
+
+// VerifyProvenance verifies the Helm provenance file of a chart archive and returns the identity of the signer. The
+// provenance must be signed by a key of the keyring and hold the digest of the archive under its file name.
+func (k *Keys) VerifyProvenance(fileName string, chart, prov []byte) (string, error) {
+	if len(k.Keyring) == 0 {
+		return "", errors.New("no PGP keyring is trusted")
+	}
+
+	block, _ := clearsign.Decode(prov)
+	if block == nil {
+		return "", errors.New("signature block not found")
+	}
+	signer, err := openpgp.CheckDetachedSignature(k.Keyring, bytes.NewBuffer(block.Bytes), block.ArmoredSignature.Body)
+	if err != nil {
+		return "", err
+	}
+
+	// The signed message is the chart metadata and the digests of the files, separated by a YAML document end.
+	parts := bytes.Split(block.Plaintext, []byte("\n...\n"))
+	if len(parts) < 2 {
+		return "", errors.New("message block must have at least two parts")
+	}
+	sums := &provenance.SumCollection{}
+	if err := yaml.Unmarshal(parts[1], sums); err != nil {
+		return "", err
+	}
+	sum := fmt.Sprintf("sha256:%x", sha256.Sum256(chart))
+	if sha, ok := sums.Files[fileName]; !ok {
+		return "", fmt.Errorf("provenance does not contain a SHA for a file named %q", fileName)
+	} else if sha != sum {
+		return "", fmt.Errorf("sha256 sum does not match for %s: %q != %q", fileName, sha, sum)
+	}
+
+	for name := range signer.Identities {
+		return name, nil
+	}
+	return fmt.Sprintf("%X", signer.PrimaryKey.Fingerprint), nil
+}
+
+// simpleSigning is the payload signed by cosign.
+type simpleSigning struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// VerifyCosign verifies a cosign signature of an OCI artifact and returns the fingerprint of the key which signed it. The
+// signature is the base64 encoded signature of the payload, which must reference the digest of the manifest of the
+// artifact.
+func (k *Keys) VerifyCosign(manifestDigest string, payload []byte, signature string) (string, error) {
+	if len(k.CosignKeys) == 0 {
+		return "", errors.New("no cosign public key is trusted")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return "", fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	var signer crypto.PublicKey
+	for _, key := range k.CosignKeys {
+		if verifySignature(key, payload, sig) {
+			signer = key
+			break
+		}
+	}
+	if signer == nil {
+		return "", errors.New("signature is not signed by a trusted key")
+	}
+
+	var signed simpleSigning
+	if err := json.Unmarshal(payload, &signed); err != nil {
+		return "", fmt.Errorf("invalid signature payload: %w", err)
+	}
+	if signed.Critical.Image.DockerManifestDigest != manifestDigest {
+		return "", fmt.Errorf("signature is for digest %q instead of %q", signed.Critical.Image.DockerManifestDigest, manifestDigest)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(signer)
+	if err != nil {
+		return "", err
+	}
+	fingerprint := sha256.Sum256(der)
+	return "SHA256:" + strings.ToUpper(hex.EncodeToString(fingerprint[:])), nil
+}
+
+func verifySignature(key crypto.PublicKey, payload, sig []byte) bool {
+	digest := sha256.Sum256(payload)
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil ||
+			rsa.VerifyPSS(key, crypto.SHA256, digest[:], sig, nil) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, payload, sig)
+	}
+	return false
+}
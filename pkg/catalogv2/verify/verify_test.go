@@ -0,0 +1,138 @@
+package verify
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/openpgp/clearsign"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func newEntity(t *testing.T, name string) (*openpgp.Entity, []byte) {
+	entity, err := openpgp.NewEntity(name, "", name+"@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	return entity, buf.Bytes()
+}
+
+func signProvenance(t *testing.T, signer *openpgp.Entity, fileName string, chart []byte) []byte {
+	message := fmt.Sprintf("apiVersion: v2\nname: mychart\nversion: 1.0.0\n\n...\nfiles:\n  %s: sha256:%x\n", fileName, sha256.Sum256(chart))
+	buf := &bytes.Buffer{}
+	w, err := clearsign.Encode(buf, signer.PrivateKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte(message))
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestVerifyProvenance(t *testing.T) {
+	trusted, keyring := newEntity(t, "trusted")
+	untrusted, _ := newEntity(t, "untrusted")
+	chart := []byte("chart-archive")
+
+	keys, err := KeysFromSecret(&corev1.Secret{
+		Data: map[string][]byte{
+			KeyringKey: keyring,
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	signer, err := keys.VerifyProvenance("mychart-1.0.0.tgz", chart, signProvenance(t, trusted, "mychart-1.0.0.tgz", chart))
+	assert.NoError(t, err)
+	assert.Equal(t, "trusted <trusted@example.com>", signer)
+
+	_, err = keys.VerifyProvenance("mychart-1.0.0.tgz", chart, signProvenance(t, untrusted, "mychart-1.0.0.tgz", chart))
+	assert.Error(t, err)
+
+	_, err = keys.VerifyProvenance("mychart-1.0.0.tgz", []byte("tampered"), signProvenance(t, trusted, "mychart-1.0.0.tgz", chart))
+	assert.Error(t, err)
+
+	_, err = keys.VerifyProvenance("mychart-1.0.0.tgz", chart, signProvenance(t, trusted, "other-1.0.0.tgz", chart))
+	assert.Error(t, err)
+
+	_, err = keys.VerifyProvenance("mychart-1.0.0.tgz", chart, []byte("not signed"))
+	assert.Error(t, err)
+}
+
+func TestVerifyCosign(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !assert.NoError(t, err) {
+		return
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if !assert.NoError(t, err) {
+		return
+	}
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	keys, err := KeysFromSecret(&corev1.Secret{
+		Data: map[string][]byte{
+			CosignKey: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}),
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	sign := func(key *ecdsa.PrivateKey, payload []byte) string {
+		digest := sha256.Sum256(payload)
+		sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+	payload := []byte(`{"critical":{"identity":{"docker-reference":"registry.example.com/charts/mychart"},"image":{"docker-manifest-digest":"sha256:manifest"},"type":"cosign container image signature"}}`)
+
+	signer, err := keys.VerifyCosign("sha256:manifest", payload, sign(key, payload))
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("SHA256:%X", sha256.Sum256(der)), signer)
+
+	_, err = keys.VerifyCosign("sha256:other", payload, sign(key, payload))
+	assert.Error(t, err)
+
+	_, err = keys.VerifyCosign("sha256:manifest", payload, sign(other, payload))
+	assert.Error(t, err)
+}
+
+func TestKeysFromSecretInvalid(t *testing.T) {
+	_, err := KeysFromSecret(&corev1.Secret{
+		Data: map[string][]byte{
+			KeyringKey: []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----\ninvalid\n-----END PGP PUBLIC KEY BLOCK-----\n"),
+		},
+	})
+	assert.Error(t, err)
+
+	keys, err := KeysFromSecret(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, keys.Keyring)
+	assert.Empty(t, keys.CosignKeys)
+}
@@ -4,6 +4,7 @@
 	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/sha1"
 	"crypto/x509"
@@ -16,6 +17,9 @@
 	"github.com/pkg/errors"
 )
 
+// expiryWarningWindow is how far ahead of NotAfter a certificate starts being reported as expiring.
+const expiryWarningWindow = 30 * 24 * time.Hour
+
 type CertificateInfo struct {
 	Algorithm               string    `json:"algorithm"`
 	CN                      string    `json:"cn"`
@@ -27,6 +31,7 @@ type CertificateInfo struct {
 	SerialNumber            string    `json:"serialNumber"`
 	SubjectAlternativeNames []string  `json:"subjectAlternativeNames"`
 	Version                 int       `json:"version"`
+	Warnings                []string  `json:"warnings"`
 }
 
 func matchAndKeySize(publicKey crypto.PublicKey, privateKey crypto.PrivateKey) (string, int, bool) {
@@ -34,6 +39,28 @@ func matchAndKeySize(publicKey crypto.PublicKey, privateKey crypto.PrivateKey) (
 		return algo, size, ok
 	}
 
+	if algo, size, ok := ecdsaMatchAndKeySize(publicKey, privateKey); ok {
+		return algo, size, ok
+	}
+
+	return ed25519MatchAndKeySize(publicKey, privateKey)
+}
+
+func rsaMatchAndKeySize(publicKey crypto.PublicKey, privateKey crypto.PrivateKey) (string, int, bool) {
+	pubKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return "", 0, false
+	}
+
+	privKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return "", 0, false
+	}
+
+	return "RSA", len(privKey.N.Bytes()), pubKey.N.Cmp(privKey.N) == 0
+}
+
+func ecdsaMatchAndKeySize(publicKey crypto.PublicKey, privateKey crypto.PrivateKey) (string, int, bool) {
 	pubKey, ok := publicKey.(*ecdsa.PublicKey)
 	if !ok {
 		return "", 0, false
@@ -44,24 +71,37 @@ func matchAndKeySize(publicKey crypto.PublicKey, privateKey crypto.PrivateKey) (
 		return "", 0, false
 	}
 
-	return "ECC", 256, privKey.X.Cmp(pubKey.X) == 0 && privKey.Y.Cmp(privKey.Y) == 0
+	return "ECC " + pubKey.Curve.Params().Name, pubKey.Curve.Params().BitSize, pubKey.Equal(&privKey.PublicKey)
 }
 
-func rsaMatchAndKeySize(publicKey crypto.PublicKey, privateKey crypto.PrivateKey) (string, int, bool) {
-	pubKey, ok := publicKey.(*rsa.PublicKey)
+func ed25519MatchAndKeySize(publicKey crypto.PublicKey, privateKey crypto.PrivateKey) (string, int, bool) {
+	pubKey, ok := publicKey.(ed25519.PublicKey)
 	if !ok {
 		return "", 0, false
 	}
 
-	privKey, ok := privateKey.(*rsa.PrivateKey)
-	if !ok {
+	var privKey ed25519.PrivateKey
+	switch k := privateKey.(type) {
+	case ed25519.PrivateKey:
+		privKey = k
+	case *ed25519.PrivateKey:
+		privKey = *k
+	default:
 		return "", 0, false
 	}
 
-	return "RSA", len(privKey.N.Bytes()), pubKey.N.Cmp(privKey.N) == 0
+	return "Ed25519", ed25519.PublicKeySize * 8, pubKey.Equal(privKey.Public())
 }
 
+// Info returns the details of the certificate in pemCerts that matches pemKey. Problems with the
+// chain, such as ordering, expiry or an untrusted issuer, are reported in Warnings rather than as errors.
 func Info(pemCerts, pemKey string) (*CertificateInfo, error) {
+	return InfoWithRoots(pemCerts, pemKey, "")
+}
+
+// InfoWithRoots is like Info but additionally trusts the PEM encoded CA certificates in pemRoots
+// when validating the chain.
+func InfoWithRoots(pemCerts, pemKey, pemRoots string) (*CertificateInfo, error) {
 	block, _ := pem.Decode([]byte(pemKey))
 	if block == nil {
 		return nil, errors.New("failed to decode key: not valid pem format")
@@ -77,47 +117,129 @@ func Info(pemCerts, pemKey string) (*CertificateInfo, error) {
 		}
 	}
 
+	chain, raw, err := parseCerts(pemCerts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, cert := range chain {
+		algo, size, ok := matchAndKeySize(cert.PublicKey, key)
+		if !ok {
+			continue
+		}
+
+		certInfo := &CertificateInfo{
+			Algorithm:    algo,
+			Fingerprint:  fingerprint(raw[i]),
+			CN:           cert.Subject.CommonName,
+			ExpiresAt:    cert.NotAfter,
+			IssuedAt:     cert.NotBefore,
+			Issuer:       cert.Issuer.CommonName,
+			KeySize:      size,
+			SerialNumber: cert.SerialNumber.String(),
+			Version:      cert.Version,
+		}
+
+		for _, name := range cert.DNSNames {
+			certInfo.SubjectAlternativeNames = append(certInfo.SubjectAlternativeNames, name)
+		}
+
+		for _, ip := range cert.IPAddresses {
+			certInfo.SubjectAlternativeNames = append(certInfo.SubjectAlternativeNames, ip.String())
+		}
+
+		certInfo.Warnings = validate(chain, i, pemRoots, time.Now())
+		return certInfo, nil
+	}
+
+	return nil, fmt.Errorf("failed to find cert that matched private key")
+}
+
+func parseCerts(pemCerts string) ([]*x509.Certificate, [][]byte, error) {
+	var (
+		certs []*x509.Certificate
+		raw   [][]byte
+		block *pem.Block
+	)
+
 	rest := []byte(pemCerts)
 	for {
 		block, rest = pem.Decode(rest)
-		var certInfo CertificateInfo
-
 		if block == nil {
 			break
 		}
 
 		cert, err := x509.ParseCertificate(block.Bytes)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to parse certificate")
+			return nil, nil, errors.Wrap(err, "failed to parse certificate")
 		}
+		certs = append(certs, cert)
+		raw = append(raw, block.Bytes)
+	}
 
-		algo, size, ok := matchAndKeySize(cert.PublicKey, key)
-		if !ok {
-			continue
-		}
+	return certs, raw, nil
+}
 
-		certInfo.Algorithm = algo
-		certInfo.Fingerprint = fingerprint(block.Bytes)
-		certInfo.CN = cert.Subject.CommonName
-		certInfo.ExpiresAt = cert.NotAfter
-		certInfo.IssuedAt = cert.NotBefore
-		certInfo.Issuer = cert.Issuer.CommonName
-		certInfo.KeySize = size
-		certInfo.SerialNumber = cert.SerialNumber.String()
-		certInfo.Version = cert.Version
+// validate checks the chain whose leaf is chain[leaf] and returns a human readable warning for each problem found.
+func validate(chain []*x509.Certificate, leaf int, pemRoots string, now time.Time) []string {
+	var warnings []string
+	cert := chain[leaf]
 
-		for _, name := range cert.DNSNames {
-			certInfo.SubjectAlternativeNames = append(certInfo.SubjectAlternativeNames, name)
+	if leaf != 0 {
+		warnings = append(warnings, fmt.Sprintf("certificate matching the key is at position %d of the chain, it should be first", leaf+1))
+	}
+
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			warnings = append(warnings, fmt.Sprintf("certificate %d [%s] is not signed by the next certificate in the chain [%s]",
+				i+1, chain[i].Subject.CommonName, chain[i+1].Subject.CommonName))
 		}
+	}
 
-		for _, ip := range cert.IPAddresses {
-			certInfo.SubjectAlternativeNames = append(certInfo.SubjectAlternativeNames, ip.String())
+	for _, c := range chain {
+		switch {
+		case now.After(c.NotAfter):
+			warnings = append(warnings, fmt.Sprintf("certificate [%s] expired at %s", c.Subject.CommonName, c.NotAfter.Format(time.RFC3339)))
+		case now.Before(c.NotBefore):
+			warnings = append(warnings, fmt.Sprintf("certificate [%s] is not valid until %s", c.Subject.CommonName, c.NotBefore.Format(time.RFC3339)))
+		case now.Add(expiryWarningWindow).After(c.NotAfter):
+			warnings = append(warnings, fmt.Sprintf("certificate [%s] expires soon at %s", c.Subject.CommonName, c.NotAfter.Format(time.RFC3339)))
 		}
+	}
 
-		return &certInfo, nil
+	if len(cert.DNSNames) == 0 && len(cert.IPAddresses) == 0 {
+		warnings = append(warnings, "certificate has no subject alternative names, clients will not match the common name against the hostname")
+	} else if cert.Subject.CommonName != "" && cert.VerifyHostname(cert.Subject.CommonName) != nil {
+		warnings = append(warnings, fmt.Sprintf("common name [%s] is not covered by the subject alternative names", cert.Subject.CommonName))
 	}
 
-	return nil, fmt.Errorf("failed to find cert that matched private key")
+	if _, err := cert.Verify(verifyOptions(chain, leaf, pemRoots, now)); err != nil {
+		warnings = append(warnings, fmt.Sprintf("failed to verify certificate chain: %v", err))
+	}
+
+	return warnings
+}
+
+func verifyOptions(chain []*x509.Certificate, leaf int, pemRoots string, now time.Time) x509.VerifyOptions {
+	roots, err := x509.SystemCertPool()
+	if err != nil || roots == nil {
+		roots = x509.NewCertPool()
+	}
+	roots.AppendCertsFromPEM([]byte(pemRoots))
+
+	intermediates := x509.NewCertPool()
+	for i, c := range chain {
+		if i != leaf {
+			intermediates.AddCert(c)
+		}
+	}
+
+	return x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
 }
 
 func fingerprint(data []byte) string {
@@ -0,0 +1,170 @@
+package cert
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+	pem  string
+}
+
+func newTestCert(t *testing.T, cn string, key crypto.Signer, parent *testCert, notAfter time.Time, dnsNames ...string) *testCert {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		DNSNames:              dnsNames,
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	issuer, signer := template, key
+	if parent != nil {
+		issuer, signer = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, key.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCert{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func keyPEM(t *testing.T, key crypto.Signer) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestInfoAlgorithms(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	p256Key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	p384Key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	tests := []struct {
+		name      string
+		key       crypto.Signer
+		algorithm string
+		keySize   int
+	}{
+		{name: "rsa", key: rsaKey, algorithm: "RSA", keySize: 256},
+		{name: "p256", key: p256Key, algorithm: "ECC P-256", keySize: 256},
+		{name: "p384", key: p384Key, algorithm: "ECC P-384", keySize: 384},
+		{name: "ed25519", key: edKey, algorithm: "Ed25519", keySize: 256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCert(t, "example.com", tt.key, nil, time.Now().Add(365*24*time.Hour), "example.com")
+			info, err := Info(c.pem, keyPEM(t, tt.key))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Algorithm != tt.algorithm || info.KeySize != tt.keySize {
+				t.Errorf("got %s/%d, expected %s/%d", info.Algorithm, info.KeySize, tt.algorithm, tt.keySize)
+			}
+		})
+	}
+}
+
+func TestInfoMismatchedKey(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	other, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c := newTestCert(t, "example.com", key, nil, time.Now().Add(time.Hour), "example.com")
+
+	if _, err := Info(c.pem, keyPEM(t, other)); err == nil {
+		t.Error("expected error for key that does not match any certificate")
+	}
+}
+
+func TestInfoChainWarnings(t *testing.T) {
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	leafKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ca := newTestCert(t, "test-ca", caKey, nil, time.Now().Add(365*24*time.Hour))
+	leaf := newTestCert(t, "example.com", leafKey, ca, time.Now().Add(365*24*time.Hour), "example.com")
+	expired := newTestCert(t, "example.com", leafKey, ca, time.Now().Add(-time.Minute), "example.com")
+	noSAN := newTestCert(t, "example.com", leafKey, ca, time.Now().Add(365*24*time.Hour))
+
+	tests := []struct {
+		name     string
+		certs    string
+		roots    string
+		warnings []string
+	}{
+		{
+			name:  "valid chain with custom root",
+			certs: leaf.pem + ca.pem,
+			roots: ca.pem,
+		},
+		{
+			name:     "untrusted root",
+			certs:    leaf.pem + ca.pem,
+			warnings: []string{"failed to verify certificate chain"},
+		},
+		{
+			name:     "reversed chain",
+			certs:    ca.pem + leaf.pem,
+			roots:    ca.pem,
+			warnings: []string{"should be first", "is not signed by the next certificate"},
+		},
+		{
+			name:     "expired",
+			certs:    expired.pem + ca.pem,
+			roots:    ca.pem,
+			warnings: []string{"expired at", "failed to verify certificate chain"},
+		},
+		{
+			name:     "no subject alternative names",
+			certs:    noSAN.pem + ca.pem,
+			roots:    ca.pem,
+			warnings: []string{"no subject alternative names"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := InfoWithRoots(tt.certs, keyPEM(t, leafKey), tt.roots)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(info.Warnings) != len(tt.warnings) {
+				t.Fatalf("expected %d warnings, got %q", len(tt.warnings), info.Warnings)
+			}
+			for i, w := range tt.warnings {
+				if !strings.Contains(info.Warnings[i], w) {
+					t.Errorf("expected warning %q to contain %q", info.Warnings[i], w)
+				}
+			}
+		})
+	}
+}
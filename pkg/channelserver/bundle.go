@@ -0,0 +1,258 @@
+package channelserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/blang/semver"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// BundleConfigMapName is the configmap in the system namespace holding the last imported bundle, so that
+	// every rancher replica can install it locally.
+	BundleConfigMapName = "rke-metadata-bundle"
+	BundleConfigMapKey  = "bundle.tar.gz"
+
+	bundleMetadataFile  = "metadata.json"
+	bundleSignatureFile = "metadata.json.sig"
+	bundleDataFile      = "data.json"
+	maxBundleFileSize   = 64 << 20
+)
+
+var (
+	bundleDataPath = filepath.Join("./management-state", "driver-metadata", "bundle", bundleDataFile)
+
+	installedBundleLock sync.Mutex
+	installedBundle     *BundleMetadata
+)
+
+// BundleMetadata describes a release metadata bundle. It is the signed part of the bundle, and pins the
+// content of data.json through its checksum.
+type BundleMetadata struct {
+	Version    string `json:"version"`
+	DataSHA256 string `json:"dataSHA256"`
+}
+
+// Bundle is a verified release metadata bundle: a gzipped tarball of metadata.json, its detached Ed25519
+// signature metadata.json.sig and the KDM data.json it describes.
+type Bundle struct {
+	Metadata BundleMetadata
+	Data     []byte
+}
+
+// Offline reports whether release metadata should only be served from imported bundles instead of being
+// fetched from the rke-metadata-config url.
+func Offline() bool {
+	return settings.RkeMetadataOffline.Get() == "true"
+}
+
+// BundleDataPath returns the local path of the data.json of the installed bundle.
+func BundleDataPath() string {
+	return bundleDataPath
+}
+
+// InstalledBundle returns the metadata of the bundle installed on this replica, or nil if there is none.
+func InstalledBundle() *BundleMetadata {
+	installedBundleLock.Lock()
+	defer installedBundleLock.Unlock()
+	return installedBundle
+}
+
+// ParseBundle extracts a bundle and verifies it against the keys trusted in the
+// rke-metadata-bundle-public-keys setting. Unsigned bundles, bundles signed by any other key and bundles
+// whose data doesn't match the signed checksum are rejected.
+func ParseBundle(content []byte) (*Bundle, error) {
+	keys, err := parsePublicKeys(settings.RkeMetadataBundlePublicKeys.Get())
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no trusted keys configured in setting %s", settings.RkeMetadataBundlePublicKeys.Name)
+	}
+
+	files, err := readBundleFiles(content)
+	if err != nil {
+		return nil, err
+	}
+	metadata, signature, data := files[bundleMetadataFile], files[bundleSignatureFile], files[bundleDataFile]
+	if metadata == nil || data == nil {
+		return nil, fmt.Errorf("bundle must contain %s and %s", bundleMetadataFile, bundleDataFile)
+	}
+	if signature == nil {
+		return nil, fmt.Errorf("bundle is not signed, %s is missing", bundleSignatureFile)
+	}
+	if !verifySignature(keys, metadata, signature) {
+		return nil, fmt.Errorf("bundle signature does not match any trusted key")
+	}
+
+	bundle := &Bundle{Data: data}
+	if err := json.Unmarshal(metadata, &bundle.Metadata); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", bundleMetadataFile, err)
+	}
+	if _, err := semver.ParseTolerant(bundle.Metadata.Version); err != nil {
+		return nil, fmt.Errorf("invalid bundle version %q: %w", bundle.Metadata.Version, err)
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != bundle.Metadata.DataSHA256 {
+		return nil, fmt.Errorf("checksum of %s does not match the signed metadata", bundleDataFile)
+	}
+	return bundle, nil
+}
+
+// ReadBundleMetadata returns the metadata of a bundle that was verified when it was imported. The signature
+// isn't checked again, so the version of the current bundle can still be read after the trusted keys rotate.
+func ReadBundleMetadata(content []byte) (*BundleMetadata, error) {
+	files, err := readBundleFiles(content)
+	if err != nil {
+		return nil, err
+	}
+	if files[bundleMetadataFile] == nil {
+		return nil, fmt.Errorf("bundle must contain %s", bundleMetadataFile)
+	}
+	metadata := &BundleMetadata{}
+	if err := json.Unmarshal(files[bundleMetadataFile], metadata); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", bundleMetadataFile, err)
+	}
+	return metadata, nil
+}
+
+// CheckUpgrade returns an error if next is older than current or if the version of current can't be
+// compared. Importing the same version again is allowed.
+func CheckUpgrade(current *BundleMetadata, next BundleMetadata) error {
+	if current == nil {
+		return nil
+	}
+	currentVersion, err := semver.ParseTolerant(current.Version)
+	if err != nil {
+		return fmt.Errorf("invalid version %q of the installed bundle: %w", current.Version, err)
+	}
+	nextVersion, err := semver.ParseTolerant(next.Version)
+	if err != nil {
+		return err
+	}
+	if nextVersion.LT(currentVersion) {
+		return fmt.Errorf("bundle version %s is older than the installed version %s", next.Version, current.Version)
+	}
+	return nil
+}
+
+// InstallBundle verifies content and writes its data where the channel server and the RKE metadata
+// controller read it from in offline mode.
+func InstallBundle(content []byte) (*BundleMetadata, error) {
+	bundle, err := ParseBundle(content)
+	if err != nil {
+		return nil, err
+	}
+
+	installedBundleLock.Lock()
+	defer installedBundleLock.Unlock()
+	if err := CheckUpgrade(installedBundle, bundle.Metadata); err != nil {
+		return nil, err
+	}
+	if installedBundle != nil && *installedBundle == bundle.Metadata {
+		return installedBundle, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(bundleDataPath), 0755); err != nil {
+		return nil, err
+	}
+	tmp := bundleDataPath + ".tmp"
+	if err := ioutil.WriteFile(tmp, bundle.Data, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, bundleDataPath); err != nil {
+		return nil, err
+	}
+
+	installedBundle = &bundle.Metadata
+	logrus.Infof("Installed release metadata bundle version %s", bundle.Metadata.Version)
+	return installedBundle, nil
+}
+
+func readBundleFiles(content []byte) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("reading bundle: %w", err)
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("reading bundle: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := filepath.Base(header.Name)
+		switch name {
+		case bundleMetadataFile, bundleSignatureFile, bundleDataFile:
+		default:
+			continue
+		}
+		if _, ok := files[name]; ok {
+			return nil, fmt.Errorf("bundle contains %s more than once", name)
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(reader, maxBundleFileSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s from bundle: %w", name, err)
+		}
+		if len(data) > maxBundleFileSize {
+			return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", name, maxBundleFileSize)
+		}
+		files[name] = data
+	}
+	return files, nil
+}
+
+func verifySignature(keys []ed25519.PublicKey, message, signature []byte) bool {
+	for _, key := range keys {
+		if ed25519.Verify(key, message, signature) {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePublicKeys(pemData string) ([]ed25519.PublicKey, error) {
+	var keys []ed25519.PublicKey
+	rest := []byte(pemData)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return keys, nil
+		}
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", settings.RkeMetadataBundlePublicKeys.Name, err)
+		}
+		edKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("%s must only contain Ed25519 keys", settings.RkeMetadataBundlePublicKeys.Name)
+		}
+		keys = append(keys, edKey)
+	}
+}
@@ -0,0 +1,136 @@
+package channelserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func trustKey(t *testing.T) ed25519.PrivateKey {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+	require.NoError(t, settings.RkeMetadataBundlePublicKeys.Set(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))))
+	return private
+}
+
+func newBundle(t *testing.T, key ed25519.PrivateKey, version string, data []byte, signed bool) []byte {
+	sum := sha256.Sum256(data)
+	metadata, err := json.Marshal(BundleMetadata{Version: version, DataSHA256: hex.EncodeToString(sum[:])})
+	require.NoError(t, err)
+
+	files := map[string][]byte{
+		bundleMetadataFile: metadata,
+		bundleDataFile:     data,
+	}
+	if signed {
+		files[bundleSignatureFile] = ed25519.Sign(key, metadata)
+	}
+	return writeBundle(t, files)
+}
+
+func writeBundle(t *testing.T, files map[string][]byte) []byte {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestParseBundle(t *testing.T) {
+	key := trustKey(t)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	bundle, err := ParseBundle(newBundle(t, key, "v2.6.3", []byte(`{"k3s":{}}`), true))
+	require.NoError(t, err)
+	assert.Equal(t, "v2.6.3", bundle.Metadata.Version)
+	assert.Equal(t, []byte(`{"k3s":{}}`), bundle.Data)
+
+	_, err = ParseBundle(newBundle(t, key, "v2.6.3", []byte(`{}`), false))
+	assert.EqualError(t, err, "bundle is not signed, metadata.json.sig is missing")
+
+	_, err = ParseBundle(newBundle(t, otherKey, "v2.6.3", []byte(`{}`), true))
+	assert.EqualError(t, err, "bundle signature does not match any trusted key")
+
+	_, err = ParseBundle([]byte("not a tarball"))
+	assert.Error(t, err)
+}
+
+func TestParseBundleChecksum(t *testing.T) {
+	key := trustKey(t)
+	data := []byte(`{"k3s":{}}`)
+	sum := sha256.Sum256([]byte("something else"))
+	metadata, err := json.Marshal(BundleMetadata{Version: "v1.0.0", DataSHA256: hex.EncodeToString(sum[:])})
+	require.NoError(t, err)
+
+	_, err = ParseBundle(writeBundle(t, map[string][]byte{
+		bundleMetadataFile:  metadata,
+		bundleSignatureFile: ed25519.Sign(key, metadata),
+		bundleDataFile:      data,
+	}))
+	assert.EqualError(t, err, "checksum of data.json does not match the signed metadata")
+}
+
+func TestCheckUpgrade(t *testing.T) {
+	assert.NoError(t, CheckUpgrade(nil, BundleMetadata{Version: "v1.0.0"}))
+	assert.NoError(t, CheckUpgrade(&BundleMetadata{Version: "v1.0.0"}, BundleMetadata{Version: "v1.0.0"}))
+	assert.NoError(t, CheckUpgrade(&BundleMetadata{Version: "v1.0.0"}, BundleMetadata{Version: "v1.1.0"}))
+	assert.Error(t, CheckUpgrade(&BundleMetadata{Version: "v1.1.0"}, BundleMetadata{Version: "v1.0.0"}))
+	assert.Error(t, CheckUpgrade(&BundleMetadata{Version: "junk"}, BundleMetadata{Version: "v1.0.0"}))
+}
+
+func TestReadBundleMetadata(t *testing.T) {
+	content := newBundle(t, trustKey(t), "v2.6.3", []byte(`{}`), true)
+
+	// the imported bundle was signed by a key that is no longer trusted
+	trustKey(t)
+	metadata, err := ReadBundleMetadata(content)
+	require.NoError(t, err)
+	assert.Equal(t, "v2.6.3", metadata.Version)
+
+	_, err = ReadBundleMetadata(writeBundle(t, map[string][]byte{bundleDataFile: []byte(`{}`)}))
+	assert.EqualError(t, err, "bundle must contain metadata.json")
+
+	_, err = ReadBundleMetadata([]byte("not a tarball"))
+	assert.Error(t, err)
+}
+
+func TestInstallBundle(t *testing.T) {
+	key := trustKey(t)
+	bundleDataPath = filepath.Join(t.TempDir(), "data.json")
+	defer func() { installedBundle = nil }()
+
+	metadata, err := InstallBundle(newBundle(t, key, "v2.0.0", []byte(`{"v":2}`), true))
+	require.NoError(t, err)
+	assert.Equal(t, "v2.0.0", metadata.Version)
+	assert.Equal(t, metadata, InstalledBundle())
+
+	_, err = InstallBundle(newBundle(t, key, "v1.0.0", []byte(`{"v":1}`), true))
+	assert.Error(t, err)
+
+	content, err := ioutil.ReadFile(BundleDataPath())
+	require.NoError(t, err)
+	assert.Equal(t, `{"v":2}`, string(content))
+}
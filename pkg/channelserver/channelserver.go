@@ -56,9 +56,14 @@ type DynamicInterval struct{
 
 func (d *DynamicInterval) Wait(ctx context.Context) bool {
 	start := time.Now()
+	offline, bundle := Offline(), InstalledBundle()
 	for {
 		select {
 		case <-time.After(time.Second):
+			// reload as soon as a new bundle is installed or offline mode is toggled
+			if offline != Offline() || bundle != InstalledBundle() {
+				return true
+			}
 			_, duration := GetURLAndInterval()
 			if start.Add(duration).Before(time.Now()) {
 				return true
@@ -82,6 +87,9 @@ func Refresh() {
 type DynamicSource struct{}
 
 func (d *DynamicSource) URL() string {
+	if Offline() {
+		return BundleDataPath()
+	}
 	url, _ := GetURLAndInterval()
 	return url
 }
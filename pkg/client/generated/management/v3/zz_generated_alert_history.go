@@ -0,0 +1,134 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	AlertHistoryType                 = "alertHistory"
+	AlertHistoryFieldAnnotations     = "annotations"
+	AlertHistoryFieldClusterID       = "clusterId"
+	AlertHistoryFieldCreated         = "created"
+	AlertHistoryFieldCreatorID       = "creatorId"
+	AlertHistoryFieldDisplayName     = "displayName"
+	AlertHistoryFieldEntries         = "entries"
+	AlertHistoryFieldGroupName       = "groupName"
+	AlertHistoryFieldLabels          = "labels"
+	AlertHistoryFieldName            = "name"
+	AlertHistoryFieldNamespaceId     = "namespaceId"
+	AlertHistoryFieldOwnerReferences = "ownerReferences"
+	AlertHistoryFieldProjectID       = "projectId"
+	AlertHistoryFieldRemoved         = "removed"
+	AlertHistoryFieldRuleName        = "ruleName"
+	AlertHistoryFieldSeverity        = "severity"
+	AlertHistoryFieldUUID            = "uuid"
+)
+
+type AlertHistory struct {
+	types.Resource
+	Annotations     map[string]string   `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterID       string              `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created         string              `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID       string              `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DisplayName     string              `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Entries         []AlertHistoryEntry `json:"entries,omitempty" yaml:"entries,omitempty"`
+	GroupName       string              `json:"groupName,omitempty" yaml:"groupName,omitempty"`
+	Labels          map[string]string   `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name            string              `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId     string              `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences []OwnerReference    `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ProjectID       string              `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Removed         string              `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RuleName        string              `json:"ruleName,omitempty" yaml:"ruleName,omitempty"`
+	Severity        string              `json:"severity,omitempty" yaml:"severity,omitempty"`
+	UUID            string              `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type AlertHistoryCollection struct {
+	types.Collection
+	Data   []AlertHistory `json:"data,omitempty"`
+	client *AlertHistoryClient
+}
+
+type AlertHistoryClient struct {
+	apiClient *Client
+}
+
+type AlertHistoryOperations interface {
+	List(opts *types.ListOpts) (*AlertHistoryCollection, error)
+	ListAll(opts *types.ListOpts) (*AlertHistoryCollection, error)
+	Create(opts *AlertHistory) (*AlertHistory, error)
+	Update(existing *AlertHistory, updates interface{}) (*AlertHistory, error)
+	Replace(existing *AlertHistory) (*AlertHistory, error)
+	ByID(id string) (*AlertHistory, error)
+	Delete(container *AlertHistory) error
+}
+
+func newAlertHistoryClient(apiClient *Client) *AlertHistoryClient {
+	return &AlertHistoryClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *AlertHistoryClient) Create(container *AlertHistory) (*AlertHistory, error) {
+	resp := &AlertHistory{}
+	err := c.apiClient.Ops.DoCreate(AlertHistoryType, container, resp)
+	return resp, err
+}
+
+func (c *AlertHistoryClient) Update(existing *AlertHistory, updates interface{}) (*AlertHistory, error) {
+	resp := &AlertHistory{}
+	err := c.apiClient.Ops.DoUpdate(AlertHistoryType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *AlertHistoryClient) Replace(obj *AlertHistory) (*AlertHistory, error) {
+	resp := &AlertHistory{}
+	err := c.apiClient.Ops.DoReplace(AlertHistoryType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *AlertHistoryClient) List(opts *types.ListOpts) (*AlertHistoryCollection, error) {
+	resp := &AlertHistoryCollection{}
+	err := c.apiClient.Ops.DoList(AlertHistoryType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *AlertHistoryClient) ListAll(opts *types.ListOpts) (*AlertHistoryCollection, error) {
+	resp := &AlertHistoryCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *AlertHistoryCollection) Next() (*AlertHistoryCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &AlertHistoryCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *AlertHistoryClient) ByID(id string) (*AlertHistory, error) {
+	resp := &AlertHistory{}
+	err := c.apiClient.Ops.DoByID(AlertHistoryType, id, resp)
+	return resp, err
+}
+
+func (c *AlertHistoryClient) Delete(container *AlertHistory) error {
+	return c.apiClient.Ops.DoResourceDelete(AlertHistoryType, &container.Resource)
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	AlertHistoryEntryType               = "alertHistoryEntry"
+	AlertHistoryEntryFieldChangedBy     = "changedBy"
+	AlertHistoryEntryFieldPreviousState = "previousState"
+	AlertHistoryEntryFieldState         = "state"
+	AlertHistoryEntryFieldTime          = "time"
+)
+
+type AlertHistoryEntry struct {
+	ChangedBy     string `json:"changedBy,omitempty" yaml:"changedBy,omitempty"`
+	PreviousState string `json:"previousState,omitempty" yaml:"previousState,omitempty"`
+	State         string `json:"state,omitempty" yaml:"state,omitempty"`
+	Time          string `json:"time,omitempty" yaml:"time,omitempty"`
+}
@@ -0,0 +1,22 @@
+package client
+
+const (
+	AlertHistorySpecType             = "alertHistorySpec"
+	AlertHistorySpecFieldClusterID   = "clusterId"
+	AlertHistorySpecFieldDisplayName = "displayName"
+	AlertHistorySpecFieldEntries     = "entries"
+	AlertHistorySpecFieldGroupName   = "groupName"
+	AlertHistorySpecFieldProjectID   = "projectId"
+	AlertHistorySpecFieldRuleName    = "ruleName"
+	AlertHistorySpecFieldSeverity    = "severity"
+)
+
+type AlertHistorySpec struct {
+	ClusterID   string              `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	DisplayName string              `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Entries     []AlertHistoryEntry `json:"entries,omitempty" yaml:"entries,omitempty"`
+	GroupName   string              `json:"groupName,omitempty" yaml:"groupName,omitempty"`
+	ProjectID   string              `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	RuleName    string              `json:"ruleName,omitempty" yaml:"ruleName,omitempty"`
+	Severity    string              `json:"severity,omitempty" yaml:"severity,omitempty"`
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	AlertMaintenanceWindowType                      = "alertMaintenanceWindow"
+	AlertMaintenanceWindowFieldActive               = "active"
+	AlertMaintenanceWindowFieldAnnotations          = "annotations"
+	AlertMaintenanceWindowFieldClusterID            = "clusterId"
+	AlertMaintenanceWindowFieldCreated              = "created"
+	AlertMaintenanceWindowFieldCreatorID            = "creatorId"
+	AlertMaintenanceWindowFieldCurrentEndsAt        = "currentEndsAt"
+	AlertMaintenanceWindowFieldCurrentStartsAt      = "currentStartsAt"
+	AlertMaintenanceWindowFieldDescription          = "description"
+	AlertMaintenanceWindowFieldEndsAt               = "endsAt"
+	AlertMaintenanceWindowFieldGroupName            = "groupName"
+	AlertMaintenanceWindowFieldLabels               = "labels"
+	AlertMaintenanceWindowFieldName                 = "name"
+	AlertMaintenanceWindowFieldNamespaceId          = "namespaceId"
+	AlertMaintenanceWindowFieldNodeSelector         = "nodeSelector"
+	AlertMaintenanceWindowFieldOwnerReferences      = "ownerReferences"
+	AlertMaintenanceWindowFieldProjectID            = "projectId"
+	AlertMaintenanceWindowFieldRecurrence           = "recurrence"
+	AlertMaintenanceWindowFieldRemoved              = "removed"
+	AlertMaintenanceWindowFieldStartsAt             = "startsAt"
+	AlertMaintenanceWindowFieldState                = "state"
+	AlertMaintenanceWindowFieldTransitioning        = "transitioning"
+	AlertMaintenanceWindowFieldTransitioningMessage = "transitioningMessage"
+	AlertMaintenanceWindowFieldUUID                 = "uuid"
+)
+
+type AlertMaintenanceWindow struct {
+	types.Resource
+	Active               bool               `json:"active,omitempty" yaml:"active,omitempty"`
+	Annotations          map[string]string  `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterID            string             `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created              string             `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string             `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	CurrentEndsAt        string             `json:"currentEndsAt,omitempty" yaml:"currentEndsAt,omitempty"`
+	CurrentStartsAt      string             `json:"currentStartsAt,omitempty" yaml:"currentStartsAt,omitempty"`
+	Description          string             `json:"description,omitempty" yaml:"description,omitempty"`
+	EndsAt               string             `json:"endsAt,omitempty" yaml:"endsAt,omitempty"`
+	GroupName            string             `json:"groupName,omitempty" yaml:"groupName,omitempty"`
+	Labels               map[string]string  `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string             `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string             `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	NodeSelector         map[string]string  `json:"nodeSelector,omitempty" yaml:"nodeSelector,omitempty"`
+	OwnerReferences      []OwnerReference   `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ProjectID            string             `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Recurrence           *MaintenanceWindow `json:"recurrence,omitempty" yaml:"recurrence,omitempty"`
+	Removed              string             `json:"removed,omitempty" yaml:"removed,omitempty"`
+	StartsAt             string             `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+	State                string             `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning        string             `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string             `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string             `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type AlertMaintenanceWindowCollection struct {
+	types.Collection
+	Data   []AlertMaintenanceWindow `json:"data,omitempty"`
+	client *AlertMaintenanceWindowClient
+}
+
+type AlertMaintenanceWindowClient struct {
+	apiClient *Client
+}
+
+type AlertMaintenanceWindowOperations interface {
+	List(opts *types.ListOpts) (*AlertMaintenanceWindowCollection, error)
+	ListAll(opts *types.ListOpts) (*AlertMaintenanceWindowCollection, error)
+	Create(opts *AlertMaintenanceWindow) (*AlertMaintenanceWindow, error)
+	Update(existing *AlertMaintenanceWindow, updates interface{}) (*AlertMaintenanceWindow, error)
+	Replace(existing *AlertMaintenanceWindow) (*AlertMaintenanceWindow, error)
+	ByID(id string) (*AlertMaintenanceWindow, error)
+	Delete(container *AlertMaintenanceWindow) error
+}
+
+func newAlertMaintenanceWindowClient(apiClient *Client) *AlertMaintenanceWindowClient {
+	return &AlertMaintenanceWindowClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *AlertMaintenanceWindowClient) Create(container *AlertMaintenanceWindow) (*AlertMaintenanceWindow, error) {
+	resp := &AlertMaintenanceWindow{}
+	err := c.apiClient.Ops.DoCreate(AlertMaintenanceWindowType, container, resp)
+	return resp, err
+}
+
+func (c *AlertMaintenanceWindowClient) Update(existing *AlertMaintenanceWindow, updates interface{}) (*AlertMaintenanceWindow, error) {
+	resp := &AlertMaintenanceWindow{}
+	err := c.apiClient.Ops.DoUpdate(AlertMaintenanceWindowType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *AlertMaintenanceWindowClient) Replace(obj *AlertMaintenanceWindow) (*AlertMaintenanceWindow, error) {
+	resp := &AlertMaintenanceWindow{}
+	err := c.apiClient.Ops.DoReplace(AlertMaintenanceWindowType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *AlertMaintenanceWindowClient) List(opts *types.ListOpts) (*AlertMaintenanceWindowCollection, error) {
+	resp := &AlertMaintenanceWindowCollection{}
+	err := c.apiClient.Ops.DoList(AlertMaintenanceWindowType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *AlertMaintenanceWindowClient) ListAll(opts *types.ListOpts) (*AlertMaintenanceWindowCollection, error) {
+	resp := &AlertMaintenanceWindowCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *AlertMaintenanceWindowCollection) Next() (*AlertMaintenanceWindowCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &AlertMaintenanceWindowCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *AlertMaintenanceWindowClient) ByID(id string) (*AlertMaintenanceWindow, error) {
+	resp := &AlertMaintenanceWindow{}
+	err := c.apiClient.Ops.DoByID(AlertMaintenanceWindowType, id, resp)
+	return resp, err
+}
+
+func (c *AlertMaintenanceWindowClient) Delete(container *AlertMaintenanceWindow) error {
+	return c.apiClient.Ops.DoResourceDelete(AlertMaintenanceWindowType, &container.Resource)
+}
@@ -0,0 +1,26 @@
+package client
+
+const (
+	AlertMaintenanceWindowSpecType              = "alertMaintenanceWindowSpec"
+	AlertMaintenanceWindowSpecFieldClusterID    = "clusterId"
+	AlertMaintenanceWindowSpecFieldDescription  = "description"
+	AlertMaintenanceWindowSpecFieldDisplayName  = "displayName"
+	AlertMaintenanceWindowSpecFieldEndsAt       = "endsAt"
+	AlertMaintenanceWindowSpecFieldGroupName    = "groupName"
+	AlertMaintenanceWindowSpecFieldNodeSelector = "nodeSelector"
+	AlertMaintenanceWindowSpecFieldProjectID    = "projectId"
+	AlertMaintenanceWindowSpecFieldRecurrence   = "recurrence"
+	AlertMaintenanceWindowSpecFieldStartsAt     = "startsAt"
+)
+
+type AlertMaintenanceWindowSpec struct {
+	ClusterID    string             `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Description  string             `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName  string             `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	EndsAt       string             `json:"endsAt,omitempty" yaml:"endsAt,omitempty"`
+	GroupName    string             `json:"groupName,omitempty" yaml:"groupName,omitempty"`
+	NodeSelector map[string]string  `json:"nodeSelector,omitempty" yaml:"nodeSelector,omitempty"`
+	ProjectID    string             `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Recurrence   *MaintenanceWindow `json:"recurrence,omitempty" yaml:"recurrence,omitempty"`
+	StartsAt     string             `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+}
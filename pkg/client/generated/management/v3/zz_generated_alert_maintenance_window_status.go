@@ -0,0 +1,14 @@
+package client
+
+const (
+	AlertMaintenanceWindowStatusType                 = "alertMaintenanceWindowStatus"
+	AlertMaintenanceWindowStatusFieldActive          = "active"
+	AlertMaintenanceWindowStatusFieldCurrentEndsAt   = "currentEndsAt"
+	AlertMaintenanceWindowStatusFieldCurrentStartsAt = "currentStartsAt"
+)
+
+type AlertMaintenanceWindowStatus struct {
+	Active          bool   `json:"active,omitempty" yaml:"active,omitempty"`
+	CurrentEndsAt   string `json:"currentEndsAt,omitempty" yaml:"currentEndsAt,omitempty"`
+	CurrentStartsAt string `json:"currentStartsAt,omitempty" yaml:"currentStartsAt,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	CertificateRotationPolicyType                   = "certificateRotationPolicy"
+	CertificateRotationPolicyFieldCACertificates    = "caCertificates"
+	CertificateRotationPolicyFieldDaysBeforeExpiry  = "daysBeforeExpiry"
+	CertificateRotationPolicyFieldEnabled           = "enabled"
+	CertificateRotationPolicyFieldMaintenanceWindow = "maintenanceWindow"
+)
+
+type CertificateRotationPolicy struct {
+	CACertificates    bool               `json:"caCertificates,omitempty" yaml:"caCertificates,omitempty"`
+	DaysBeforeExpiry  int64              `json:"daysBeforeExpiry,omitempty" yaml:"daysBeforeExpiry,omitempty"`
+	Enabled           bool               `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty" yaml:"maintenanceWindow,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	CertificateRuleType            = "certificateRule"
+	CertificateRuleFieldExpiryDays = "expiryDays"
+	CertificateRuleFieldNamespace  = "namespace"
+	CertificateRuleFieldSelector   = "selector"
+)
+
+type CertificateRule struct {
+	ExpiryDays int64             `json:"expiryDays,omitempty" yaml:"expiryDays,omitempty"`
+	Namespace  string            `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Selector   map[string]string `json:"selector,omitempty" yaml:"selector,omitempty"`
+}
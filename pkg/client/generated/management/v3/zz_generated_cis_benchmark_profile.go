@@ -0,0 +1,130 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	CisBenchmarkProfileType                  = "cisBenchmarkProfile"
+	CisBenchmarkProfileFieldAcceptedRisks    = "acceptedRisks"
+	CisBenchmarkProfileFieldAnnotations      = "annotations"
+	CisBenchmarkProfileFieldBaseProfile      = "baseProfile"
+	CisBenchmarkProfileFieldBenchmarkVersion = "benchmarkVersion"
+	CisBenchmarkProfileFieldCreated          = "created"
+	CisBenchmarkProfileFieldCreatorID        = "creatorId"
+	CisBenchmarkProfileFieldDescription      = "description"
+	CisBenchmarkProfileFieldDisplayName      = "displayName"
+	CisBenchmarkProfileFieldLabels           = "labels"
+	CisBenchmarkProfileFieldName             = "name"
+	CisBenchmarkProfileFieldOwnerReferences  = "ownerReferences"
+	CisBenchmarkProfileFieldRemoved          = "removed"
+	CisBenchmarkProfileFieldSkipChecks       = "skipChecks"
+	CisBenchmarkProfileFieldUUID             = "uuid"
+)
+
+type CisBenchmarkProfile struct {
+	types.Resource
+	AcceptedRisks    []CisCheckException `json:"acceptedRisks,omitempty" yaml:"acceptedRisks,omitempty"`
+	Annotations      map[string]string   `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	BaseProfile      string              `json:"baseProfile,omitempty" yaml:"baseProfile,omitempty"`
+	BenchmarkVersion string              `json:"benchmarkVersion,omitempty" yaml:"benchmarkVersion,omitempty"`
+	Created          string              `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID        string              `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description      string              `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName      string              `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Labels           map[string]string   `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name             string              `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences  []OwnerReference    `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Removed          string              `json:"removed,omitempty" yaml:"removed,omitempty"`
+	SkipChecks       []CisCheckException `json:"skipChecks,omitempty" yaml:"skipChecks,omitempty"`
+	UUID             string              `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type CisBenchmarkProfileCollection struct {
+	types.Collection
+	Data   []CisBenchmarkProfile `json:"data,omitempty"`
+	client *CisBenchmarkProfileClient
+}
+
+type CisBenchmarkProfileClient struct {
+	apiClient *Client
+}
+
+type CisBenchmarkProfileOperations interface {
+	List(opts *types.ListOpts) (*CisBenchmarkProfileCollection, error)
+	ListAll(opts *types.ListOpts) (*CisBenchmarkProfileCollection, error)
+	Create(opts *CisBenchmarkProfile) (*CisBenchmarkProfile, error)
+	Update(existing *CisBenchmarkProfile, updates interface{}) (*CisBenchmarkProfile, error)
+	Replace(existing *CisBenchmarkProfile) (*CisBenchmarkProfile, error)
+	ByID(id string) (*CisBenchmarkProfile, error)
+	Delete(container *CisBenchmarkProfile) error
+}
+
+func newCisBenchmarkProfileClient(apiClient *Client) *CisBenchmarkProfileClient {
+	return &CisBenchmarkProfileClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *CisBenchmarkProfileClient) Create(container *CisBenchmarkProfile) (*CisBenchmarkProfile, error) {
+	resp := &CisBenchmarkProfile{}
+	err := c.apiClient.Ops.DoCreate(CisBenchmarkProfileType, container, resp)
+	return resp, err
+}
+
+func (c *CisBenchmarkProfileClient) Update(existing *CisBenchmarkProfile, updates interface{}) (*CisBenchmarkProfile, error) {
+	resp := &CisBenchmarkProfile{}
+	err := c.apiClient.Ops.DoUpdate(CisBenchmarkProfileType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *CisBenchmarkProfileClient) Replace(obj *CisBenchmarkProfile) (*CisBenchmarkProfile, error) {
+	resp := &CisBenchmarkProfile{}
+	err := c.apiClient.Ops.DoReplace(CisBenchmarkProfileType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *CisBenchmarkProfileClient) List(opts *types.ListOpts) (*CisBenchmarkProfileCollection, error) {
+	resp := &CisBenchmarkProfileCollection{}
+	err := c.apiClient.Ops.DoList(CisBenchmarkProfileType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *CisBenchmarkProfileClient) ListAll(opts *types.ListOpts) (*CisBenchmarkProfileCollection, error) {
+	resp := &CisBenchmarkProfileCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *CisBenchmarkProfileCollection) Next() (*CisBenchmarkProfileCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &CisBenchmarkProfileCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *CisBenchmarkProfileClient) ByID(id string) (*CisBenchmarkProfile, error) {
+	resp := &CisBenchmarkProfile{}
+	err := c.apiClient.Ops.DoByID(CisBenchmarkProfileType, id, resp)
+	return resp, err
+}
+
+func (c *CisBenchmarkProfileClient) Delete(container *CisBenchmarkProfile) error {
+	return c.apiClient.Ops.DoResourceDelete(CisBenchmarkProfileType, &container.Resource)
+}
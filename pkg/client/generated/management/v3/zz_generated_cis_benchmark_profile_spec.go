@@ -0,0 +1,20 @@
+package client
+
+const (
+	CisBenchmarkProfileSpecType                  = "cisBenchmarkProfileSpec"
+	CisBenchmarkProfileSpecFieldAcceptedRisks    = "acceptedRisks"
+	CisBenchmarkProfileSpecFieldBaseProfile      = "baseProfile"
+	CisBenchmarkProfileSpecFieldBenchmarkVersion = "benchmarkVersion"
+	CisBenchmarkProfileSpecFieldDescription      = "description"
+	CisBenchmarkProfileSpecFieldDisplayName      = "displayName"
+	CisBenchmarkProfileSpecFieldSkipChecks       = "skipChecks"
+)
+
+type CisBenchmarkProfileSpec struct {
+	AcceptedRisks    []CisCheckException `json:"acceptedRisks,omitempty" yaml:"acceptedRisks,omitempty"`
+	BaseProfile      string              `json:"baseProfile,omitempty" yaml:"baseProfile,omitempty"`
+	BenchmarkVersion string              `json:"benchmarkVersion,omitempty" yaml:"benchmarkVersion,omitempty"`
+	Description      string              `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName      string              `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	SkipChecks       []CisCheckException `json:"skipChecks,omitempty" yaml:"skipChecks,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	CisCheckExceptionType               = "cisCheckException"
+	CisCheckExceptionFieldID            = "id"
+	CisCheckExceptionFieldJustification = "justification"
+)
+
+type CisCheckException struct {
+	ID            string `json:"id,omitempty" yaml:"id,omitempty"`
+	Justification string `json:"justification,omitempty" yaml:"justification,omitempty"`
+}
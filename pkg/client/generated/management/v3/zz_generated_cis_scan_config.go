@@ -2,6 +2,7 @@
 
 const (
 	CisScanConfigType                          = "cisScanConfig"
+	CisScanConfigFieldBenchmarkProfile         = "benchmarkProfile"
 	CisScanConfigFieldDebugMaster              = "debugMaster"
 	CisScanConfigFieldDebugWorker              = "debugWorker"
 	CisScanConfigFieldOverrideBenchmarkVersion = "overrideBenchmarkVersion"
@@ -10,6 +11,7 @@
 )
 
 type CisScanConfig struct {
+	BenchmarkProfile         string   `json:"benchmarkProfile,omitempty" yaml:"benchmarkProfile,omitempty"`
 	DebugMaster              bool     `json:"debugMaster,omitempty" yaml:"debugMaster,omitempty"`
 	DebugWorker              bool     `json:"debugWorker,omitempty" yaml:"debugWorker,omitempty"`
 	OverrideBenchmarkVersion string   `json:"overrideBenchmarkVersion,omitempty" yaml:"overrideBenchmarkVersion,omitempty"`
@@ -2,6 +2,7 @@
 
 const (
 	CisScanStatusType               = "cisScanStatus"
+	CisScanStatusFieldAcceptedRisk  = "acceptedRisk"
 	CisScanStatusFieldFail          = "fail"
 	CisScanStatusFieldNotApplicable = "notApplicable"
 	CisScanStatusFieldPass          = "pass"
@@ -10,6 +11,7 @@
 )
 
 type CisScanStatus struct {
+	AcceptedRisk  int64 `json:"acceptedRisk,omitempty" yaml:"acceptedRisk,omitempty"`
 	Fail          int64 `json:"fail,omitempty" yaml:"fail,omitempty"`
 	NotApplicable int64 `json:"notApplicable,omitempty" yaml:"notApplicable,omitempty"`
 	Pass          int64 `json:"pass,omitempty" yaml:"pass,omitempty"`
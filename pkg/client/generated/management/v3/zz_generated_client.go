@@ -46,6 +46,8 @@ type Client struct {
 	ProjectAlert                            ProjectAlertOperations
 	Notifier                                NotifierOperations
 	ClusterAlertGroup                       ClusterAlertGroupOperations
+	AlertMaintenanceWindow                  AlertMaintenanceWindowOperations
+	AlertHistory                            AlertHistoryOperations
 	ProjectAlertGroup                       ProjectAlertGroupOperations
 	ClusterAlertRule                        ClusterAlertRuleOperations
 	ProjectAlertRule                        ProjectAlertRuleOperations
@@ -70,6 +72,7 @@ type Client struct {
 	RkeK8sServiceOption                     RkeK8sServiceOptionOperations
 	RkeAddon                                RkeAddonOperations
 	CisConfig                               CisConfigOperations
+	CisBenchmarkProfile                     CisBenchmarkProfileOperations
 	CisBenchmarkVersion                     CisBenchmarkVersionOperations
 	FleetWorkspace                          FleetWorkspaceOperations
 }
@@ -123,6 +126,8 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.ProjectAlert = newProjectAlertClient(client)
 	client.Notifier = newNotifierClient(client)
 	client.ClusterAlertGroup = newClusterAlertGroupClient(client)
+	client.AlertMaintenanceWindow = newAlertMaintenanceWindowClient(client)
+	client.AlertHistory = newAlertHistoryClient(client)
 	client.ProjectAlertGroup = newProjectAlertGroupClient(client)
 	client.ClusterAlertRule = newClusterAlertRuleClient(client)
 	client.ProjectAlertRule = newProjectAlertRuleClient(client)
@@ -147,6 +152,7 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.RkeK8sServiceOption = newRkeK8sServiceOptionClient(client)
 	client.RkeAddon = newRkeAddonClient(client)
 	client.CisConfig = newCisConfigClient(client)
+	client.CisBenchmarkProfile = newCisBenchmarkProfileClient(client)
 	client.CisBenchmarkVersion = newCisBenchmarkVersionClient(client)
 	client.FleetWorkspace = newFleetWorkspaceClient(client)
 
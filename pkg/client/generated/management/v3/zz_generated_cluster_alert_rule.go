@@ -8,6 +8,7 @@
 	ClusterAlertRuleType                       = "clusterAlertRule"
 	ClusterAlertRuleFieldAlertState            = "alertState"
 	ClusterAlertRuleFieldAnnotations           = "annotations"
+	ClusterAlertRuleFieldCertificateRule       = "certificateRule"
 	ClusterAlertRuleFieldClusterID             = "clusterId"
 	ClusterAlertRuleFieldClusterScanRule       = "clusterScanRule"
 	ClusterAlertRuleFieldCreated               = "created"
@@ -37,6 +38,7 @@ type ClusterAlertRule struct {
 	types.Resource
 	AlertState            string             `json:"alertState,omitempty" yaml:"alertState,omitempty"`
 	Annotations           map[string]string  `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	CertificateRule       *CertificateRule   `json:"certificateRule,omitempty" yaml:"certificateRule,omitempty"`
 	ClusterID             string             `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
 	ClusterScanRule       *ClusterScanRule   `json:"clusterScanRule,omitempty" yaml:"clusterScanRule,omitempty"`
 	Created               string             `json:"created,omitempty" yaml:"created,omitempty"`
@@ -2,6 +2,7 @@
 
 const (
 	ClusterAlertRuleSpecType                       = "clusterAlertRuleSpec"
+	ClusterAlertRuleSpecFieldCertificateRule       = "certificateRule"
 	ClusterAlertRuleSpecFieldClusterID             = "clusterId"
 	ClusterAlertRuleSpecFieldClusterScanRule       = "clusterScanRule"
 	ClusterAlertRuleSpecFieldDisplayName           = "displayName"
@@ -18,6 +19,7 @@
 )
 
 type ClusterAlertRuleSpec struct {
+	CertificateRule       *CertificateRule   `json:"certificateRule,omitempty" yaml:"certificateRule,omitempty"`
 	ClusterID             string             `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
 	ClusterScanRule       *ClusterScanRule   `json:"clusterScanRule,omitempty" yaml:"clusterScanRule,omitempty"`
 	DisplayName           string             `json:"displayName,omitempty" yaml:"displayName,omitempty"`
@@ -20,8 +20,10 @@
 	ClusterLoggingFieldIncludeSystemComponent = "includeSystemComponent"
 	ClusterLoggingFieldKafkaConfig            = "kafkaConfig"
 	ClusterLoggingFieldLabels                 = "labels"
+	ClusterLoggingFieldLokiConfig             = "lokiConfig"
 	ClusterLoggingFieldName                   = "name"
 	ClusterLoggingFieldNamespaceId            = "namespaceId"
+	ClusterLoggingFieldOTLPConfig             = "otlpConfig"
 	ClusterLoggingFieldOutputFlushInterval    = "outputFlushInterval"
 	ClusterLoggingFieldOutputTags             = "outputTags"
 	ClusterLoggingFieldOwnerReferences        = "ownerReferences"
@@ -50,8 +52,10 @@ type ClusterLogging struct {
 	IncludeSystemComponent *bool                  `json:"includeSystemComponent,omitempty" yaml:"includeSystemComponent,omitempty"`
 	KafkaConfig            *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
 	Labels                 map[string]string      `json:"labels,omitempty" yaml:"labels,omitempty"`
+	LokiConfig             *LokiConfig            `json:"lokiConfig,omitempty" yaml:"lokiConfig,omitempty"`
 	Name                   string                 `json:"name,omitempty" yaml:"name,omitempty"`
 	NamespaceId            string                 `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OTLPConfig             *OTLPConfig            `json:"otlpConfig,omitempty" yaml:"otlpConfig,omitempty"`
 	OutputFlushInterval    int64                  `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
 	OutputTags             map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
 	OwnerReferences        []OwnerReference       `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
@@ -85,6 +89,8 @@ type ClusterLoggingOperations interface {
 
 	CollectionActionDryRun(resource *ClusterLoggingCollection, input *ClusterTestInput) error
 
+	CollectionActionDryRunReport(resource *ClusterLoggingCollection, input *ClusterTestInput) (*LoggingDryRunReport, error)
+
 	CollectionActionTest(resource *ClusterLoggingCollection, input *ClusterTestInput) error
 }
 
@@ -162,6 +168,12 @@ func (c *ClusterLoggingClient) CollectionActionDryRun(resource *ClusterLoggingCo
 	return err
 }
 
+func (c *ClusterLoggingClient) CollectionActionDryRunReport(resource *ClusterLoggingCollection, input *ClusterTestInput) (*LoggingDryRunReport, error) {
+	resp := &LoggingDryRunReport{}
+	err := c.apiClient.Ops.DoCollectionAction(ClusterLoggingType, "dryRunReport", &resource.Collection, input, resp)
+	return resp, err
+}
+
 func (c *ClusterLoggingClient) CollectionActionTest(resource *ClusterLoggingCollection, input *ClusterTestInput) error {
 	err := c.apiClient.Ops.DoCollectionAction(ClusterLoggingType, "test", &resource.Collection, input, nil)
 	return err
@@ -10,6 +10,8 @@
 	ClusterLoggingSpecFieldFluentForwarderConfig  = "fluentForwarderConfig"
 	ClusterLoggingSpecFieldIncludeSystemComponent = "includeSystemComponent"
 	ClusterLoggingSpecFieldKafkaConfig            = "kafkaConfig"
+	ClusterLoggingSpecFieldLokiConfig             = "lokiConfig"
+	ClusterLoggingSpecFieldOTLPConfig             = "otlpConfig"
 	ClusterLoggingSpecFieldOutputFlushInterval    = "outputFlushInterval"
 	ClusterLoggingSpecFieldOutputTags             = "outputTags"
 	ClusterLoggingSpecFieldSplunkConfig           = "splunkConfig"
@@ -25,6 +27,8 @@ type ClusterLoggingSpec struct {
 	FluentForwarderConfig  *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	IncludeSystemComponent *bool                  `json:"includeSystemComponent,omitempty" yaml:"includeSystemComponent,omitempty"`
 	KafkaConfig            *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
+	LokiConfig             *LokiConfig            `json:"lokiConfig,omitempty" yaml:"lokiConfig,omitempty"`
+	OTLPConfig             *OTLPConfig            `json:"otlpConfig,omitempty" yaml:"otlpConfig,omitempty"`
 	OutputFlushInterval    int64                  `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
 	OutputTags             map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
 	SplunkConfig           *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
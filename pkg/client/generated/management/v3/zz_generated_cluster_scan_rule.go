@@ -1,12 +1,14 @@
 package client
 
 const (
-	ClusterScanRuleType              = "clusterScanRule"
-	ClusterScanRuleFieldFailuresOnly = "failuresOnly"
-	ClusterScanRuleFieldScanRunType  = "scanRunType"
+	ClusterScanRuleType                 = "clusterScanRule"
+	ClusterScanRuleFieldFailuresOnly    = "failuresOnly"
+	ClusterScanRuleFieldRegressionsOnly = "regressionsOnly"
+	ClusterScanRuleFieldScanRunType     = "scanRunType"
 )
 
 type ClusterScanRule struct {
-	FailuresOnly bool   `json:"failuresOnly,omitempty" yaml:"failuresOnly,omitempty"`
-	ScanRunType  string `json:"scanRunType,omitempty" yaml:"scanRunType,omitempty"`
+	FailuresOnly    bool   `json:"failuresOnly,omitempty" yaml:"failuresOnly,omitempty"`
+	RegressionsOnly bool   `json:"regressionsOnly,omitempty" yaml:"regressionsOnly,omitempty"`
+	ScanRunType     string `json:"scanRunType,omitempty" yaml:"scanRunType,omitempty"`
 }
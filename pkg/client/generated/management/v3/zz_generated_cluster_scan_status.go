@@ -2,11 +2,13 @@
 
 const (
 	ClusterScanStatusType               = "clusterScanStatus"
+	ClusterScanStatusFieldAcceptedRisks = "acceptedRisks"
 	ClusterScanStatusFieldCisScanStatus = "cisScanStatus"
 	ClusterScanStatusFieldConditions    = "conditions"
 )
 
 type ClusterScanStatus struct {
+	AcceptedRisks []CisCheckException    `json:"acceptedRisks,omitempty" yaml:"acceptedRisks,omitempty"`
 	CisScanStatus *CisScanStatus         `json:"cisScanStatus,omitempty" yaml:"cisScanStatus,omitempty"`
 	Conditions    []ClusterScanCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
 }
@@ -4,6 +4,7 @@
 	ClusterSpecBaseType                                     = "clusterSpecBase"
 	ClusterSpecBaseFieldAgentEnvVars                        = "agentEnvVars"
 	ClusterSpecBaseFieldAgentImageOverride                  = "agentImageOverride"
+	ClusterSpecBaseFieldCertificateRotationPolicy           = "certificateRotationPolicy"
 	ClusterSpecBaseFieldDefaultClusterRoleForProjectMembers = "defaultClusterRoleForProjectMembers"
 	ClusterSpecBaseFieldDefaultPodSecurityPolicyTemplateID  = "defaultPodSecurityPolicyTemplateId"
 	ClusterSpecBaseFieldDesiredAgentImage                   = "desiredAgentImage"
@@ -21,6 +22,7 @@
 type ClusterSpecBase struct {
 	AgentEnvVars                        []EnvVar                       `json:"agentEnvVars,omitempty" yaml:"agentEnvVars,omitempty"`
 	AgentImageOverride                  string                         `json:"agentImageOverride,omitempty" yaml:"agentImageOverride,omitempty"`
+	CertificateRotationPolicy           *CertificateRotationPolicy     `json:"certificateRotationPolicy,omitempty" yaml:"certificateRotationPolicy,omitempty"`
 	DefaultClusterRoleForProjectMembers string                         `json:"defaultClusterRoleForProjectMembers,omitempty" yaml:"defaultClusterRoleForProjectMembers,omitempty"`
 	DefaultPodSecurityPolicyTemplateID  string                         `json:"defaultPodSecurityPolicyTemplateId,omitempty" yaml:"defaultPodSecurityPolicyTemplateId,omitempty"`
 	DesiredAgentImage                   string                         `json:"desiredAgentImage,omitempty" yaml:"desiredAgentImage,omitempty"`
@@ -7,6 +7,8 @@
 	ClusterTestInputFieldElasticsearchConfig   = "elasticsearchConfig"
 	ClusterTestInputFieldFluentForwarderConfig = "fluentForwarderConfig"
 	ClusterTestInputFieldKafkaConfig           = "kafkaConfig"
+	ClusterTestInputFieldLokiConfig            = "lokiConfig"
+	ClusterTestInputFieldOTLPConfig            = "otlpConfig"
 	ClusterTestInputFieldOutputTags            = "outputTags"
 	ClusterTestInputFieldSplunkConfig          = "splunkConfig"
 	ClusterTestInputFieldSyslogConfig          = "syslogConfig"
@@ -18,6 +20,8 @@ type ClusterTestInput struct {
 	ElasticsearchConfig   *ElasticsearchConfig   `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
+	LokiConfig            *LokiConfig            `json:"lokiConfig,omitempty" yaml:"lokiConfig,omitempty"`
+	OTLPConfig            *OTLPConfig            `json:"otlpConfig,omitempty" yaml:"otlpConfig,omitempty"`
 	OutputTags            map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
 	SplunkConfig          *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
 	SyslogConfig          *SyslogConfig          `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
@@ -75,6 +75,8 @@ type KontainerDriverOperations interface {
 
 	ActionDeactivate(resource *KontainerDriver) error
 
+	CollectionActionImportMetadataBundle(resource *KontainerDriverCollection, input *MetadataBundleInput) (*MetadataBundleOutput, error)
+
 	CollectionActionRefresh(resource *KontainerDriverCollection) error
 }
 
@@ -157,6 +159,12 @@ func (c *KontainerDriverClient) ActionDeactivate(resource *KontainerDriver) erro
 	return err
 }
 
+func (c *KontainerDriverClient) CollectionActionImportMetadataBundle(resource *KontainerDriverCollection, input *MetadataBundleInput) (*MetadataBundleOutput, error) {
+	resp := &MetadataBundleOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(KontainerDriverType, "importMetadataBundle", &resource.Collection, input, resp)
+	return resp, err
+}
+
 func (c *KontainerDriverClient) CollectionActionRefresh(resource *KontainerDriverCollection) error {
 	err := c.apiClient.Ops.DoCollectionAction(KontainerDriverType, "refresh", &resource.Collection, nil, nil)
 	return err
@@ -0,0 +1,14 @@
+package client
+
+const (
+	LoggingDroppedRecordsType             = "loggingDroppedRecords"
+	LoggingDroppedRecordsFieldLastUpdated = "lastUpdated"
+	LoggingDroppedRecordsFieldNamespaces  = "namespaces"
+	LoggingDroppedRecordsFieldTotal       = "total"
+)
+
+type LoggingDroppedRecords struct {
+	LastUpdated string           `json:"lastUpdated,omitempty" yaml:"lastUpdated,omitempty"`
+	Namespaces  map[string]int64 `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	Total       int64            `json:"total,omitempty" yaml:"total,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	LoggingDryRunReportType         = "loggingDryRunReport"
+	LoggingDryRunReportFieldChecks  = "checks"
+	LoggingDryRunReportFieldSuccess = "success"
+	LoggingDryRunReportFieldTargets = "targets"
+)
+
+type LoggingDryRunReport struct {
+	Checks  []LoggingTargetCheck  `json:"checks,omitempty" yaml:"checks,omitempty"`
+	Success bool                  `json:"success,omitempty" yaml:"success,omitempty"`
+	Targets []LoggingTargetReport `json:"targets,omitempty" yaml:"targets,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	LoggingRateLimitType                 = "loggingRateLimit"
+	LoggingRateLimitFieldNamespaceLimit  = "namespaceLimit"
+	LoggingRateLimitFieldNamespaceLimits = "namespaceLimits"
+	LoggingRateLimitFieldPeriodSeconds   = "periodSeconds"
+	LoggingRateLimitFieldProjectLimit    = "projectLimit"
+)
+
+type LoggingRateLimit struct {
+	NamespaceLimit  int64            `json:"namespaceLimit,omitempty" yaml:"namespaceLimit,omitempty"`
+	NamespaceLimits map[string]int64 `json:"namespaceLimits,omitempty" yaml:"namespaceLimits,omitempty"`
+	PeriodSeconds   int64            `json:"periodSeconds,omitempty" yaml:"periodSeconds,omitempty"`
+	ProjectLimit    int64            `json:"projectLimit,omitempty" yaml:"projectLimit,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	LoggingTargetCheckType         = "loggingTargetCheck"
+	LoggingTargetCheckFieldMessage = "message"
+	LoggingTargetCheckFieldName    = "name"
+	LoggingTargetCheckFieldSkipped = "skipped"
+	LoggingTargetCheckFieldSuccess = "success"
+)
+
+type LoggingTargetCheck struct {
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+	Skipped bool   `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+	Success bool   `json:"success,omitempty" yaml:"success,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	LoggingTargetReportType          = "loggingTargetReport"
+	LoggingTargetReportFieldChecks   = "checks"
+	LoggingTargetReportFieldEndpoint = "endpoint"
+	LoggingTargetReportFieldSuccess  = "success"
+	LoggingTargetReportFieldTarget   = "target"
+)
+
+type LoggingTargetReport struct {
+	Checks   []LoggingTargetCheck `json:"checks,omitempty" yaml:"checks,omitempty"`
+	Endpoint string               `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Success  bool                 `json:"success,omitempty" yaml:"success,omitempty"`
+	Target   string               `json:"target,omitempty" yaml:"target,omitempty"`
+}
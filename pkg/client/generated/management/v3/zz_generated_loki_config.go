@@ -0,0 +1,26 @@
+package client
+
+const (
+	LokiConfigType              = "lokiConfig"
+	LokiConfigFieldAuthPassword = "authPassword"
+	LokiConfigFieldAuthUserName = "authUsername"
+	LokiConfigFieldCertificate  = "certificate"
+	LokiConfigFieldClientCert   = "clientCert"
+	LokiConfigFieldClientKey    = "clientKey"
+	LokiConfigFieldEndpoint     = "endpoint"
+	LokiConfigFieldLabels       = "labels"
+	LokiConfigFieldSSLVerify    = "sslVerify"
+	LokiConfigFieldTenantID     = "tenantId"
+)
+
+type LokiConfig struct {
+	AuthPassword string            `json:"authPassword,omitempty" yaml:"authPassword,omitempty"`
+	AuthUserName string            `json:"authUsername,omitempty" yaml:"authUsername,omitempty"`
+	Certificate  string            `json:"certificate,omitempty" yaml:"certificate,omitempty"`
+	ClientCert   string            `json:"clientCert,omitempty" yaml:"clientCert,omitempty"`
+	ClientKey    string            `json:"clientKey,omitempty" yaml:"clientKey,omitempty"`
+	Endpoint     string            `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	SSLVerify    bool              `json:"sslVerify,omitempty" yaml:"sslVerify,omitempty"`
+	TenantID     string            `json:"tenantId,omitempty" yaml:"tenantId,omitempty"`
+}
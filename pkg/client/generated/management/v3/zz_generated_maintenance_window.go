@@ -0,0 +1,12 @@
+package client
+
+const (
+	MaintenanceWindowType                 = "maintenanceWindow"
+	MaintenanceWindowFieldCronSchedule    = "cronSchedule"
+	MaintenanceWindowFieldDurationMinutes = "durationMinutes"
+)
+
+type MaintenanceWindow struct {
+	CronSchedule    string `json:"cronSchedule,omitempty" yaml:"cronSchedule,omitempty"`
+	DurationMinutes int64  `json:"durationMinutes,omitempty" yaml:"durationMinutes,omitempty"`
+}
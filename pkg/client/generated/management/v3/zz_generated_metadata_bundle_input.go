@@ -0,0 +1,10 @@
+package client
+
+const (
+	MetadataBundleInputType        = "metadataBundleInput"
+	MetadataBundleInputFieldBundle = "bundle"
+)
+
+type MetadataBundleInput struct {
+	Bundle string `json:"bundle,omitempty" yaml:"bundle,omitempty"`
+}
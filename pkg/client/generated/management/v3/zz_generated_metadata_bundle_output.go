@@ -0,0 +1,10 @@
+package client
+
+const (
+	MetadataBundleOutputType         = "metadataBundleOutput"
+	MetadataBundleOutputFieldVersion = "version"
+)
+
+type MetadataBundleOutput struct {
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+}
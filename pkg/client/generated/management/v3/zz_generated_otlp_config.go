@@ -0,0 +1,24 @@
+package client
+
+const (
+	OTLPConfigType             = "otlpConfig"
+	OTLPConfigFieldCertificate = "certificate"
+	OTLPConfigFieldClientCert  = "clientCert"
+	OTLPConfigFieldClientKey   = "clientKey"
+	OTLPConfigFieldCompress    = "compress"
+	OTLPConfigFieldEndpoint    = "endpoint"
+	OTLPConfigFieldHeaders     = "headers"
+	OTLPConfigFieldLabels      = "labels"
+	OTLPConfigFieldSSLVerify   = "sslVerify"
+)
+
+type OTLPConfig struct {
+	Certificate string            `json:"certificate,omitempty" yaml:"certificate,omitempty"`
+	ClientCert  string            `json:"clientCert,omitempty" yaml:"clientCert,omitempty"`
+	ClientKey   string            `json:"clientKey,omitempty" yaml:"clientKey,omitempty"`
+	Compress    bool              `json:"compress,omitempty" yaml:"compress,omitempty"`
+	Endpoint    string            `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Headers     []OTLPHeader      `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	SSLVerify   bool              `json:"sslVerify,omitempty" yaml:"sslVerify,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	OTLPHeaderType       = "otlpHeader"
+	OTLPHeaderFieldName  = "name"
+	OTLPHeaderFieldValue = "value"
+)
+
+type OTLPHeader struct {
+	Name  string `json:"name,omitempty" yaml:"name,omitempty"`
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	PersistentVolumeClaimRuleType                         = "persistentVolumeClaimRule"
+	PersistentVolumeClaimRuleFieldCondition               = "condition"
+	PersistentVolumeClaimRuleFieldPendingSeconds          = "pendingSeconds"
+	PersistentVolumeClaimRuleFieldPersistentVolumeClaimID = "persistentVolumeClaimId"
+	PersistentVolumeClaimRuleFieldSelector                = "selector"
+	PersistentVolumeClaimRuleFieldUsageThreshold          = "usageThreshold"
+)
+
+type PersistentVolumeClaimRule struct {
+	Condition               string            `json:"condition,omitempty" yaml:"condition,omitempty"`
+	PendingSeconds          int64             `json:"pendingSeconds,omitempty" yaml:"pendingSeconds,omitempty"`
+	PersistentVolumeClaimID string            `json:"persistentVolumeClaimId,omitempty" yaml:"persistentVolumeClaimId,omitempty"`
+	Selector                map[string]string `json:"selector,omitempty" yaml:"selector,omitempty"`
+	UsageThreshold          int64             `json:"usageThreshold,omitempty" yaml:"usageThreshold,omitempty"`
+}
@@ -5,57 +5,59 @@
 )
 
 const (
-	ProjectAlertRuleType                       = "projectAlertRule"
-	ProjectAlertRuleFieldAlertState            = "alertState"
-	ProjectAlertRuleFieldAnnotations           = "annotations"
-	ProjectAlertRuleFieldCreated               = "created"
-	ProjectAlertRuleFieldCreatorID             = "creatorId"
-	ProjectAlertRuleFieldGroupID               = "groupId"
-	ProjectAlertRuleFieldGroupIntervalSeconds  = "groupIntervalSeconds"
-	ProjectAlertRuleFieldGroupWaitSeconds      = "groupWaitSeconds"
-	ProjectAlertRuleFieldInherited             = "inherited"
-	ProjectAlertRuleFieldLabels                = "labels"
-	ProjectAlertRuleFieldMetricRule            = "metricRule"
-	ProjectAlertRuleFieldName                  = "name"
-	ProjectAlertRuleFieldNamespaceId           = "namespaceId"
-	ProjectAlertRuleFieldOwnerReferences       = "ownerReferences"
-	ProjectAlertRuleFieldPodRule               = "podRule"
-	ProjectAlertRuleFieldProjectID             = "projectId"
-	ProjectAlertRuleFieldRemoved               = "removed"
-	ProjectAlertRuleFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
-	ProjectAlertRuleFieldSeverity              = "severity"
-	ProjectAlertRuleFieldState                 = "state"
-	ProjectAlertRuleFieldTransitioning         = "transitioning"
-	ProjectAlertRuleFieldTransitioningMessage  = "transitioningMessage"
-	ProjectAlertRuleFieldUUID                  = "uuid"
-	ProjectAlertRuleFieldWorkloadRule          = "workloadRule"
+	ProjectAlertRuleType                           = "projectAlertRule"
+	ProjectAlertRuleFieldAlertState                = "alertState"
+	ProjectAlertRuleFieldAnnotations               = "annotations"
+	ProjectAlertRuleFieldCreated                   = "created"
+	ProjectAlertRuleFieldCreatorID                 = "creatorId"
+	ProjectAlertRuleFieldGroupID                   = "groupId"
+	ProjectAlertRuleFieldGroupIntervalSeconds      = "groupIntervalSeconds"
+	ProjectAlertRuleFieldGroupWaitSeconds          = "groupWaitSeconds"
+	ProjectAlertRuleFieldInherited                 = "inherited"
+	ProjectAlertRuleFieldLabels                    = "labels"
+	ProjectAlertRuleFieldMetricRule                = "metricRule"
+	ProjectAlertRuleFieldName                      = "name"
+	ProjectAlertRuleFieldNamespaceId               = "namespaceId"
+	ProjectAlertRuleFieldOwnerReferences           = "ownerReferences"
+	ProjectAlertRuleFieldPersistentVolumeClaimRule = "persistentVolumeClaimRule"
+	ProjectAlertRuleFieldPodRule                   = "podRule"
+	ProjectAlertRuleFieldProjectID                 = "projectId"
+	ProjectAlertRuleFieldRemoved                   = "removed"
+	ProjectAlertRuleFieldRepeatIntervalSeconds     = "repeatIntervalSeconds"
+	ProjectAlertRuleFieldSeverity                  = "severity"
+	ProjectAlertRuleFieldState                     = "state"
+	ProjectAlertRuleFieldTransitioning             = "transitioning"
+	ProjectAlertRuleFieldTransitioningMessage      = "transitioningMessage"
+	ProjectAlertRuleFieldUUID                      = "uuid"
+	ProjectAlertRuleFieldWorkloadRule              = "workloadRule"
 )
 
 type ProjectAlertRule struct {
 	types.Resource
-	AlertState            string            `json:"alertState,omitempty" yaml:"alertState,omitempty"`
-	Annotations           map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	Created               string            `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID             string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	GroupID               string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
-	GroupIntervalSeconds  int64             `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
-	GroupWaitSeconds      int64             `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
-	Inherited             *bool             `json:"inherited,omitempty" yaml:"inherited,omitempty"`
-	Labels                map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
-	MetricRule            *MetricRule       `json:"metricRule,omitempty" yaml:"metricRule,omitempty"`
-	Name                  string            `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceId           string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OwnerReferences       []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	PodRule               *PodRule          `json:"podRule,omitempty" yaml:"podRule,omitempty"`
-	ProjectID             string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
-	Removed               string            `json:"removed,omitempty" yaml:"removed,omitempty"`
-	RepeatIntervalSeconds int64             `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
-	Severity              string            `json:"severity,omitempty" yaml:"severity,omitempty"`
-	State                 string            `json:"state,omitempty" yaml:"state,omitempty"`
-	Transitioning         string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
-	TransitioningMessage  string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
-	UUID                  string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
-	WorkloadRule          *WorkloadRule     `json:"workloadRule,omitempty" yaml:"workloadRule,omitempty"`
+	AlertState                string                     `json:"alertState,omitempty" yaml:"alertState,omitempty"`
+	Annotations               map[string]string          `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created                   string                     `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID                 string                     `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	GroupID                   string                     `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	GroupIntervalSeconds      int64                      `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds          int64                      `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	Inherited                 *bool                      `json:"inherited,omitempty" yaml:"inherited,omitempty"`
+	Labels                    map[string]string          `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MetricRule                *MetricRule                `json:"metricRule,omitempty" yaml:"metricRule,omitempty"`
+	Name                      string                     `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId               string                     `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences           []OwnerReference           `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	PersistentVolumeClaimRule *PersistentVolumeClaimRule `json:"persistentVolumeClaimRule,omitempty" yaml:"persistentVolumeClaimRule,omitempty"`
+	PodRule                   *PodRule                   `json:"podRule,omitempty" yaml:"podRule,omitempty"`
+	ProjectID                 string                     `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Removed                   string                     `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RepeatIntervalSeconds     int64                      `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Severity                  string                     `json:"severity,omitempty" yaml:"severity,omitempty"`
+	State                     string                     `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning             string                     `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage      string                     `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                      string                     `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	WorkloadRule              *WorkloadRule              `json:"workloadRule,omitempty" yaml:"workloadRule,omitempty"`
 }
 
 type ProjectAlertRuleCollection struct {
@@ -15,12 +15,15 @@
 	ProjectLoggingFieldFluentForwarderConfig = "fluentForwarderConfig"
 	ProjectLoggingFieldKafkaConfig           = "kafkaConfig"
 	ProjectLoggingFieldLabels                = "labels"
+	ProjectLoggingFieldLokiConfig            = "lokiConfig"
 	ProjectLoggingFieldName                  = "name"
 	ProjectLoggingFieldNamespaceId           = "namespaceId"
+	ProjectLoggingFieldOTLPConfig            = "otlpConfig"
 	ProjectLoggingFieldOutputFlushInterval   = "outputFlushInterval"
 	ProjectLoggingFieldOutputTags            = "outputTags"
 	ProjectLoggingFieldOwnerReferences       = "ownerReferences"
 	ProjectLoggingFieldProjectID             = "projectId"
+	ProjectLoggingFieldRateLimit             = "rateLimit"
 	ProjectLoggingFieldRemoved               = "removed"
 	ProjectLoggingFieldSplunkConfig          = "splunkConfig"
 	ProjectLoggingFieldState                 = "state"
@@ -42,12 +45,15 @@ type ProjectLogging struct {
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
 	Labels                map[string]string      `json:"labels,omitempty" yaml:"labels,omitempty"`
+	LokiConfig            *LokiConfig            `json:"lokiConfig,omitempty" yaml:"lokiConfig,omitempty"`
 	Name                  string                 `json:"name,omitempty" yaml:"name,omitempty"`
 	NamespaceId           string                 `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OTLPConfig            *OTLPConfig            `json:"otlpConfig,omitempty" yaml:"otlpConfig,omitempty"`
 	OutputFlushInterval   int64                  `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
 	OutputTags            map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
 	OwnerReferences       []OwnerReference       `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
 	ProjectID             string                 `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	RateLimit             *LoggingRateLimit      `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
 	Removed               string                 `json:"removed,omitempty" yaml:"removed,omitempty"`
 	SplunkConfig          *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
 	State                 string                 `json:"state,omitempty" yaml:"state,omitempty"`
@@ -79,6 +85,8 @@ type ProjectLoggingOperations interface {
 
 	CollectionActionDryRun(resource *ProjectLoggingCollection, input *ProjectTestInput) error
 
+	CollectionActionDryRunReport(resource *ProjectLoggingCollection, input *ProjectTestInput) (*LoggingDryRunReport, error)
+
 	CollectionActionTest(resource *ProjectLoggingCollection, input *ProjectTestInput) error
 }
 
@@ -156,6 +164,12 @@ func (c *ProjectLoggingClient) CollectionActionDryRun(resource *ProjectLoggingCo
 	return err
 }
 
+func (c *ProjectLoggingClient) CollectionActionDryRunReport(resource *ProjectLoggingCollection, input *ProjectTestInput) (*LoggingDryRunReport, error) {
+	resp := &LoggingDryRunReport{}
+	err := c.apiClient.Ops.DoCollectionAction(ProjectLoggingType, "dryRunReport", &resource.Collection, input, resp)
+	return resp, err
+}
+
 func (c *ProjectLoggingClient) CollectionActionTest(resource *ProjectLoggingCollection, input *ProjectTestInput) error {
 	err := c.apiClient.Ops.DoCollectionAction(ProjectLoggingType, "test", &resource.Collection, input, nil)
 	return err
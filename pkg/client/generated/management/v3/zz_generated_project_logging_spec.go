@@ -8,9 +8,12 @@
 	ProjectLoggingSpecFieldEnableJSONParsing     = "enableJSONParsing"
 	ProjectLoggingSpecFieldFluentForwarderConfig = "fluentForwarderConfig"
 	ProjectLoggingSpecFieldKafkaConfig           = "kafkaConfig"
+	ProjectLoggingSpecFieldLokiConfig            = "lokiConfig"
+	ProjectLoggingSpecFieldOTLPConfig            = "otlpConfig"
 	ProjectLoggingSpecFieldOutputFlushInterval   = "outputFlushInterval"
 	ProjectLoggingSpecFieldOutputTags            = "outputTags"
 	ProjectLoggingSpecFieldProjectID             = "projectId"
+	ProjectLoggingSpecFieldRateLimit             = "rateLimit"
 	ProjectLoggingSpecFieldSplunkConfig          = "splunkConfig"
 	ProjectLoggingSpecFieldSyslogConfig          = "syslogConfig"
 )
@@ -22,9 +25,12 @@ type ProjectLoggingSpec struct {
 	EnableJSONParsing     bool                   `json:"enableJSONParsing,omitempty" yaml:"enableJSONParsing,omitempty"`
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
+	LokiConfig            *LokiConfig            `json:"lokiConfig,omitempty" yaml:"lokiConfig,omitempty"`
+	OTLPConfig            *OTLPConfig            `json:"otlpConfig,omitempty" yaml:"otlpConfig,omitempty"`
 	OutputFlushInterval   int64                  `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
 	OutputTags            map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
 	ProjectID             string                 `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	RateLimit             *LoggingRateLimit      `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
 	SplunkConfig          *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
 	SyslogConfig          *SyslogConfig          `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
 }
@@ -1,12 +1,14 @@
 package client
 
 const (
-	ProjectLoggingStatusType             = "projectLoggingStatus"
-	ProjectLoggingStatusFieldAppliedSpec = "appliedSpec"
-	ProjectLoggingStatusFieldConditions  = "conditions"
+	ProjectLoggingStatusType                = "projectLoggingStatus"
+	ProjectLoggingStatusFieldAppliedSpec    = "appliedSpec"
+	ProjectLoggingStatusFieldConditions     = "conditions"
+	ProjectLoggingStatusFieldDroppedRecords = "droppedRecords"
 )
 
 type ProjectLoggingStatus struct {
-	AppliedSpec *ProjectLoggingSpec `json:"appliedSpec,omitempty" yaml:"appliedSpec,omitempty"`
-	Conditions  []LoggingCondition  `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	AppliedSpec    *ProjectLoggingSpec    `json:"appliedSpec,omitempty" yaml:"appliedSpec,omitempty"`
+	Conditions     []LoggingCondition     `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	DroppedRecords *LoggingDroppedRecords `json:"droppedRecords,omitempty" yaml:"droppedRecords,omitempty"`
 }
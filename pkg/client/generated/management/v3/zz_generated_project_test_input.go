@@ -6,6 +6,8 @@
 	ProjectTestInputFieldElasticsearchConfig   = "elasticsearchConfig"
 	ProjectTestInputFieldFluentForwarderConfig = "fluentForwarderConfig"
 	ProjectTestInputFieldKafkaConfig           = "kafkaConfig"
+	ProjectTestInputFieldLokiConfig            = "lokiConfig"
+	ProjectTestInputFieldOTLPConfig            = "otlpConfig"
 	ProjectTestInputFieldOutputTags            = "outputTags"
 	ProjectTestInputFieldProjectName           = "projectId"
 	ProjectTestInputFieldSplunkConfig          = "splunkConfig"
@@ -17,6 +19,8 @@ type ProjectTestInput struct {
 	ElasticsearchConfig   *ElasticsearchConfig   `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
+	LokiConfig            *LokiConfig            `json:"lokiConfig,omitempty" yaml:"lokiConfig,omitempty"`
+	OTLPConfig            *OTLPConfig            `json:"otlpConfig,omitempty" yaml:"otlpConfig,omitempty"`
 	OutputTags            map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
 	ProjectName           string                 `json:"projectId,omitempty" yaml:"projectId,omitempty"`
 	SplunkConfig          *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
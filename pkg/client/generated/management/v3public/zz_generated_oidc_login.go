@@ -4,6 +4,8 @@
 	OIDCLoginType              = "oidcLogin"
 	OIDCLoginFieldCode         = "code"
 	OIDCLoginFieldDescription  = "description"
+	OIDCLoginFieldPublicKey    = "publicKey"
+	OIDCLoginFieldRequestID    = "requestId"
 	OIDCLoginFieldResponseType = "responseType"
 	OIDCLoginFieldTTLMillis    = "ttl"
 )
@@ -11,6 +13,8 @@
 type OIDCLogin struct {
 	Code         string `json:"code,omitempty" yaml:"code,omitempty"`
 	Description  string `json:"description,omitempty" yaml:"description,omitempty"`
+	PublicKey    string `json:"publicKey,omitempty" yaml:"publicKey,omitempty"`
+	RequestID    string `json:"requestId,omitempty" yaml:"requestId,omitempty"`
 	ResponseType string `json:"responseType,omitempty" yaml:"responseType,omitempty"`
 	TTLMillis    int64  `json:"ttl,omitempty" yaml:"ttl,omitempty"`
 }
@@ -29,6 +29,7 @@
 	CertificateFieldSubjectAlternativeNames = "subjectAlternativeNames"
 	CertificateFieldUUID                    = "uuid"
 	CertificateFieldVersion                 = "version"
+	CertificateFieldWarnings                = "warnings"
 )
 
 type Certificate struct {
@@ -56,6 +57,7 @@ type Certificate struct {
 	SubjectAlternativeNames []string          `json:"subjectAlternativeNames,omitempty" yaml:"subjectAlternativeNames,omitempty"`
 	UUID                    string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 	Version                 string            `json:"version,omitempty" yaml:"version,omitempty"`
+	Warnings                []string          `json:"warnings,omitempty" yaml:"warnings,omitempty"`
 }
 
 type CertificateCollection struct {
@@ -0,0 +1,20 @@
+package client
+
+const (
+	GitApplyInputType                = "gitApplyInput"
+	GitApplyInputFieldCACerts        = "caCerts"
+	GitApplyInputFieldRepositoryURLs = "repositoryUrls"
+	GitApplyInputFieldSSHKnownHosts  = "sshKnownHosts"
+	GitApplyInputFieldSSHPrivateKey  = "sshPrivateKey"
+	GitApplyInputFieldToken          = "token"
+	GitApplyInputFieldUsername       = "username"
+)
+
+type GitApplyInput struct {
+	CACerts        string   `json:"caCerts,omitempty" yaml:"caCerts,omitempty"`
+	RepositoryURLs []string `json:"repositoryUrls,omitempty" yaml:"repositoryUrls,omitempty"`
+	SSHKnownHosts  string   `json:"sshKnownHosts,omitempty" yaml:"sshKnownHosts,omitempty"`
+	SSHPrivateKey  string   `json:"sshPrivateKey,omitempty" yaml:"sshPrivateKey,omitempty"`
+	Token          string   `json:"token,omitempty" yaml:"token,omitempty"`
+	Username       string   `json:"username,omitempty" yaml:"username,omitempty"`
+}
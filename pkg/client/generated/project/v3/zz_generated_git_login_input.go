@@ -0,0 +1,18 @@
+package client
+
+const (
+	GitLoginInputType                = "gitLoginInput"
+	GitLoginInputFieldRepositoryURLs = "repositoryUrls"
+	GitLoginInputFieldSSHKnownHosts  = "sshKnownHosts"
+	GitLoginInputFieldSSHPrivateKey  = "sshPrivateKey"
+	GitLoginInputFieldToken          = "token"
+	GitLoginInputFieldUsername       = "username"
+)
+
+type GitLoginInput struct {
+	RepositoryURLs []string `json:"repositoryUrls,omitempty" yaml:"repositoryUrls,omitempty"`
+	SSHKnownHosts  string   `json:"sshKnownHosts,omitempty" yaml:"sshKnownHosts,omitempty"`
+	SSHPrivateKey  string   `json:"sshPrivateKey,omitempty" yaml:"sshPrivateKey,omitempty"`
+	Token          string   `json:"token,omitempty" yaml:"token,omitempty"`
+	Username       string   `json:"username,omitempty" yaml:"username,omitempty"`
+}
@@ -0,0 +1,34 @@
+package client
+
+const (
+	GitPipelineConfigType                 = "gitPipelineConfig"
+	GitPipelineConfigFieldAnnotations     = "annotations"
+	GitPipelineConfigFieldCACerts         = "caCerts"
+	GitPipelineConfigFieldCreated         = "created"
+	GitPipelineConfigFieldCreatorID       = "creatorId"
+	GitPipelineConfigFieldEnabled         = "enabled"
+	GitPipelineConfigFieldLabels          = "labels"
+	GitPipelineConfigFieldName            = "name"
+	GitPipelineConfigFieldNamespaceId     = "namespaceId"
+	GitPipelineConfigFieldOwnerReferences = "ownerReferences"
+	GitPipelineConfigFieldProjectID       = "projectId"
+	GitPipelineConfigFieldRemoved         = "removed"
+	GitPipelineConfigFieldType            = "type"
+	GitPipelineConfigFieldUUID            = "uuid"
+)
+
+type GitPipelineConfig struct {
+	Annotations     map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	CACerts         string            `json:"caCerts,omitempty" yaml:"caCerts,omitempty"`
+	Created         string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID       string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Enabled         bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name            string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId     string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ProjectID       string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Removed         string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Type            string            `json:"type,omitempty" yaml:"type,omitempty"`
+	UUID            string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
@@ -0,0 +1,28 @@
+package client
+
+const (
+	GitProviderType                 = "gitProvider"
+	GitProviderFieldAnnotations     = "annotations"
+	GitProviderFieldCreated         = "created"
+	GitProviderFieldCreatorID       = "creatorId"
+	GitProviderFieldLabels          = "labels"
+	GitProviderFieldName            = "name"
+	GitProviderFieldOwnerReferences = "ownerReferences"
+	GitProviderFieldProjectID       = "projectId"
+	GitProviderFieldRemoved         = "removed"
+	GitProviderFieldType            = "type"
+	GitProviderFieldUUID            = "uuid"
+)
+
+type GitProvider struct {
+	Annotations     map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created         string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID       string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name            string            `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ProjectID       string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Removed         string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Type            string            `json:"type,omitempty" yaml:"type,omitempty"`
+	UUID            string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
@@ -29,6 +29,7 @@
 	NamespacedCertificateFieldSubjectAlternativeNames = "subjectAlternativeNames"
 	NamespacedCertificateFieldUUID                    = "uuid"
 	NamespacedCertificateFieldVersion                 = "version"
+	NamespacedCertificateFieldWarnings                = "warnings"
 )
 
 type NamespacedCertificate struct {
@@ -56,6 +57,7 @@ type NamespacedCertificate struct {
 	SubjectAlternativeNames []string          `json:"subjectAlternativeNames,omitempty" yaml:"subjectAlternativeNames,omitempty"`
 	UUID                    string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 	Version                 string            `json:"version,omitempty" yaml:"version,omitempty"`
+	Warnings                []string          `json:"warnings,omitempty" yaml:"warnings,omitempty"`
 }
 
 type NamespacedCertificateCollection struct {
@@ -6,6 +6,7 @@
 	PipelineSpecFieldProjectID              = "projectId"
 	PipelineSpecFieldRepositoryURL          = "repositoryUrl"
 	PipelineSpecFieldSourceCodeCredentialID = "sourceCodeCredentialId"
+	PipelineSpecFieldTriggerPollInterval    = "triggerPollInterval"
 	PipelineSpecFieldTriggerWebhookPr       = "triggerWebhookPr"
 	PipelineSpecFieldTriggerWebhookPush     = "triggerWebhookPush"
 	PipelineSpecFieldTriggerWebhookTag      = "triggerWebhookTag"
@@ -16,6 +17,7 @@ type PipelineSpec struct {
 	ProjectID              string `json:"projectId,omitempty" yaml:"projectId,omitempty"`
 	RepositoryURL          string `json:"repositoryUrl,omitempty" yaml:"repositoryUrl,omitempty"`
 	SourceCodeCredentialID string `json:"sourceCodeCredentialId,omitempty" yaml:"sourceCodeCredentialId,omitempty"`
+	TriggerPollInterval    int64  `json:"triggerPollInterval,omitempty" yaml:"triggerPollInterval,omitempty"`
 	TriggerWebhookPr       bool   `json:"triggerWebhookPr,omitempty" yaml:"triggerWebhookPr,omitempty"`
 	TriggerWebhookPush     bool   `json:"triggerWebhookPush,omitempty" yaml:"triggerWebhookPush,omitempty"`
 	TriggerWebhookTag      bool   `json:"triggerWebhookTag,omitempty" yaml:"triggerWebhookTag,omitempty"`
@@ -24,6 +24,9 @@
 	SourceCodeCredentialFieldProjectID            = "projectId"
 	SourceCodeCredentialFieldRefreshToken         = "refreshToken"
 	SourceCodeCredentialFieldRemoved              = "removed"
+	SourceCodeCredentialFieldRepositoryURLs       = "repositoryUrls"
+	SourceCodeCredentialFieldSSHKnownHosts        = "sshKnownHosts"
+	SourceCodeCredentialFieldSSHPrivateKey        = "sshPrivateKey"
 	SourceCodeCredentialFieldSourceCodeType       = "sourceCodeType"
 	SourceCodeCredentialFieldState                = "state"
 	SourceCodeCredentialFieldTransitioning        = "transitioning"
@@ -52,6 +55,9 @@ type SourceCodeCredential struct {
 	ProjectID            string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
 	RefreshToken         string            `json:"refreshToken,omitempty" yaml:"refreshToken,omitempty"`
 	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RepositoryURLs       []string          `json:"repositoryUrls,omitempty" yaml:"repositoryUrls,omitempty"`
+	SSHKnownHosts        string            `json:"sshKnownHosts,omitempty" yaml:"sshKnownHosts,omitempty"`
+	SSHPrivateKey        string            `json:"sshPrivateKey,omitempty" yaml:"sshPrivateKey,omitempty"`
 	SourceCodeType       string            `json:"sourceCodeType,omitempty" yaml:"sourceCodeType,omitempty"`
 	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
 	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
@@ -0,0 +1,289 @@
+// Package ratelimit throttles the requests proxied to downstream clusters, so a single user can't saturate the
+// tunnel to the agent of a cluster. Requests are limited by token buckets and by the number of requests in flight,
+// both per user of a cluster and per cluster, and long-running requests such as watches have their own limits.
+// All the limits are set by settings, a limit of 0 disables it.
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rancher/rancher/pkg/settings"
+	"golang.org/x/time/rate"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+const (
+	scopeUser    = "user"
+	scopeCluster = "cluster"
+
+	reasonRate     = "rate"
+	reasonInFlight = "inflight"
+	reasonWatch    = "watch"
+
+	// idleTimeout is how long the state of a user or a cluster is kept once it has no request in flight
+	idleTimeout = 10 * time.Minute
+)
+
+var (
+	prometheusMetrics = false
+
+	rejectedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "cluster_proxy",
+			Name:      "rejected_requests_total",
+			Help:      "Number of requests to downstream clusters rejected by the rate limits and concurrency quotas",
+		},
+		[]string{"cluster", "scope", "reason"},
+	)
+
+	// Default is the limiter shared by all the proxies to downstream clusters.
+	Default = New()
+)
+
+// RegisterMetrics exports the rejection metrics to prometheus.
+func RegisterMetrics() {
+	prometheusMetrics = true
+	prometheus.MustRegister(rejectedRequests)
+}
+
+type limits struct {
+	qps         int
+	burst       int
+	maxInFlight int
+	maxWatches  int
+}
+
+func userLimits() limits {
+	return limits{
+		qps:         settings.ClusterProxyUserQPS.GetInt(),
+		burst:       settings.ClusterProxyUserBurst.GetInt(),
+		maxInFlight: settings.ClusterProxyUserMaxInFlight.GetInt(),
+		maxWatches:  settings.ClusterProxyUserMaxWatches.GetInt(),
+	}
+}
+
+func clusterLimits() limits {
+	return limits{
+		qps:         settings.ClusterProxyClusterQPS.GetInt(),
+		burst:       settings.ClusterProxyClusterBurst.GetInt(),
+		maxInFlight: settings.ClusterProxyClusterMaxInFlight.GetInt(),
+		maxWatches:  settings.ClusterProxyClusterMaxWatches.GetInt(),
+	}
+}
+
+type key struct {
+	scope   string
+	cluster string
+	user    string
+}
+
+type state struct {
+	bucket   *rate.Limiter
+	inFlight int
+	watches  int
+	lastUsed time.Time
+}
+
+// Limiter keeps the token buckets and the requests in flight of the users and the clusters.
+type Limiter struct {
+	lock          sync.Mutex
+	states        map[key]*state
+	lastPurge     time.Time
+	now           func() time.Time
+	userLimits    func() limits
+	clusterLimits func() limits
+}
+
+func New() *Limiter {
+	return &Limiter{
+		states:        map[key]*state{},
+		now:           time.Now,
+		userLimits:    userLimits,
+		clusterLimits: clusterLimits,
+	}
+}
+
+// Handler serves a request to a cluster with next, or rejects it with a 429 and a Retry-After header if the user
+// of the request or the cluster is over its limits.
+func (l *Limiter) Handler(clusterID string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var userName string
+		if user, ok := request.UserFrom(req.Context()); ok {
+			userName = user.GetName()
+		}
+
+		watch := isLongRunning(req)
+		release, retryAfter, err := l.Acquire(clusterID, userName, watch)
+		if err != nil {
+			tooManyRequests(rw, err, retryAfter)
+			return
+		}
+		defer release()
+		next.ServeHTTP(rw, req)
+	})
+}
+
+// Acquire takes a token from the buckets of the user and the cluster and counts the request in flight, the returned
+// func ends the request.
+func (l *Limiter) Acquire(clusterID, userName string, watch bool) (func(), time.Duration, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.purge(now)
+
+	scopes := []struct {
+		key    key
+		limits limits
+	}{
+		{key{scope: scopeUser, cluster: clusterID, user: userName}, l.userLimits()},
+		{key{scope: scopeCluster, cluster: clusterID}, l.clusterLimits()},
+	}
+
+	var reservations []*rate.Reservation
+	cancel := func() {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+	for _, scope := range scopes {
+		s := l.state(scope.key, now)
+
+		if watch && scope.limits.maxWatches > 0 && s.watches >= scope.limits.maxWatches {
+			cancel()
+			return nil, time.Second, l.reject(scope.key, reasonWatch, fmt.Sprintf("too many watches on cluster %s", clusterID))
+		}
+		if !watch && scope.limits.maxInFlight > 0 && s.inFlight >= scope.limits.maxInFlight {
+			cancel()
+			return nil, time.Second, l.reject(scope.key, reasonInFlight, fmt.Sprintf("too many requests in flight to cluster %s", clusterID))
+		}
+
+		if scope.limits.qps <= 0 {
+			continue
+		}
+		s.setLimits(scope.limits, now)
+		r := s.bucket.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); !r.OK() || delay > 0 {
+			r.CancelAt(now)
+			cancel()
+			if delay <= 0 {
+				delay = time.Second
+			}
+			return nil, delay, l.reject(scope.key, reasonRate, fmt.Sprintf("rate limit of requests to cluster %s exceeded", clusterID))
+		}
+		reservations = append(reservations, r)
+	}
+
+	var states []*state
+	for _, scope := range scopes {
+		s := l.states[scope.key]
+		if watch {
+			s.watches++
+		} else {
+			s.inFlight++
+		}
+		states = append(states, s)
+	}
+
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		now := l.now()
+		for _, s := range states {
+			if watch {
+				s.watches--
+			} else {
+				s.inFlight--
+			}
+			s.lastUsed = now
+		}
+	}, 0, nil
+}
+
+func (l *Limiter) state(k key, now time.Time) *state {
+	s, ok := l.states[k]
+	if !ok {
+		s = &state{}
+		l.states[k] = s
+	}
+	s.lastUsed = now
+	return s
+}
+
+func (s *state) setLimits(limits limits, now time.Time) {
+	burst := limits.burst
+	if burst <= 0 {
+		burst = limits.qps
+	}
+	if s.bucket == nil {
+		s.bucket = rate.NewLimiter(rate.Limit(limits.qps), burst)
+		return
+	}
+	if s.bucket.Limit() != rate.Limit(limits.qps) {
+		s.bucket.SetLimitAt(now, rate.Limit(limits.qps))
+	}
+	if s.bucket.Burst() != burst {
+		s.bucket.SetBurstAt(now, burst)
+	}
+}
+
+// purge forgets the users and the clusters without requests in flight for a while.
+func (l *Limiter) purge(now time.Time) {
+	if now.Sub(l.lastPurge) < time.Minute {
+		return
+	}
+	l.lastPurge = now
+	for k, s := range l.states {
+		if s.inFlight == 0 && s.watches == 0 && now.Sub(s.lastUsed) > idleTimeout {
+			delete(l.states, k)
+		}
+	}
+}
+
+func (l *Limiter) reject(k key, reason, message string) error {
+	if prometheusMetrics {
+		rejectedRequests.With(prometheus.Labels{
+			"cluster": k.cluster,
+			"scope":   k.scope,
+			"reason":  reason,
+		}).Inc()
+	}
+	if k.scope == scopeUser {
+		message = fmt.Sprintf("%s by user %s", message, k.user)
+	}
+	return fmt.Errorf("%s, retry later", message)
+}
+
+func tooManyRequests(rw http.ResponseWriter, err error, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	status := apierrors.NewTooManyRequests(err.Error(), seconds).ErrStatus
+	status.Kind, status.APIVersion = "Status", "v1"
+
+	rw.Header().Set("Retry-After", strconv.Itoa(seconds))
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(rw).Encode(status)
+}
+
+// isLongRunning returns whether a request stays open, watches, websockets and followed logs.
+func isLongRunning(req *http.Request) bool {
+	if strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+		return true
+	}
+	query := req.URL.Query()
+	for _, param := range []string{"watch", "follow"} {
+		if value := query.Get(param); value == "true" || value == "1" {
+			return true
+		}
+	}
+	return strings.Contains(req.URL.Path, "/watch/")
+}
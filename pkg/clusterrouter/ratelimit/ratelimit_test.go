@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+func testLimiter(user, cluster limits) (*Limiter, *time.Time) {
+	now := time.Unix(1600000000, 0)
+	l := New()
+	l.now = func() time.Time { return now }
+	l.userLimits = func() limits { return user }
+	l.clusterLimits = func() limits { return cluster }
+	return l, &now
+}
+
+func TestRateLimit(t *testing.T) {
+	l, now := testLimiter(limits{qps: 1, burst: 2}, limits{qps: 10})
+
+	for i := 0; i < 2; i++ {
+		release, _, err := l.Acquire("c-1", "u-1", false)
+		assert.NoError(t, err)
+		release()
+	}
+	_, retryAfter, err := l.Acquire("c-1", "u-1", false)
+	assert.EqualError(t, err, "rate limit of requests to cluster c-1 exceeded by user u-1, retry later")
+	assert.Equal(t, time.Second, retryAfter)
+
+	// other users and clusters have their own buckets
+	_, _, err = l.Acquire("c-1", "u-2", false)
+	assert.NoError(t, err)
+	_, _, err = l.Acquire("c-2", "u-1", false)
+	assert.NoError(t, err)
+
+	*now = now.Add(time.Second)
+	_, _, err = l.Acquire("c-1", "u-1", false)
+	assert.NoError(t, err)
+}
+
+func TestClusterRateLimitKeepsUserTokens(t *testing.T) {
+	l, _ := testLimiter(limits{qps: 1}, limits{qps: 1})
+
+	_, _, err := l.Acquire("c-1", "u-1", false)
+	assert.NoError(t, err)
+	_, _, err = l.Acquire("c-1", "u-2", false)
+	assert.EqualError(t, err, "rate limit of requests to cluster c-1 exceeded, retry later")
+
+	// the token taken from the bucket of u-2 was given back when the cluster rejected the request
+	l.clusterLimits = func() limits { return limits{} }
+	_, _, err = l.Acquire("c-1", "u-2", false)
+	assert.NoError(t, err)
+}
+
+func TestConcurrencyLimits(t *testing.T) {
+	l, _ := testLimiter(limits{maxInFlight: 1, maxWatches: 1}, limits{maxWatches: 2})
+
+	release, _, err := l.Acquire("c-1", "u-1", false)
+	assert.NoError(t, err)
+	_, _, err = l.Acquire("c-1", "u-1", false)
+	assert.EqualError(t, err, "too many requests in flight to cluster c-1 by user u-1, retry later")
+
+	// watches are counted apart from the other requests
+	_, _, err = l.Acquire("c-1", "u-1", true)
+	assert.NoError(t, err)
+	_, _, err = l.Acquire("c-1", "u-2", true)
+	assert.NoError(t, err)
+	_, _, err = l.Acquire("c-1", "u-3", true)
+	assert.EqualError(t, err, "too many watches on cluster c-1, retry later")
+
+	release()
+	_, _, err = l.Acquire("c-1", "u-1", false)
+	assert.NoError(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	l, _ := testLimiter(limits{qps: 1}, limits{})
+	handler := l.Handler("c-1", http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/k8s/clusters/c-1/api/v1/pods", nil)
+		req = req.WithContext(request.WithUser(req.Context(), &user.DefaultInfo{Name: "u-1"}))
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, req)
+		return rw
+	}
+
+	assert.Equal(t, http.StatusOK, serve().Code)
+	rw := serve()
+	assert.Equal(t, http.StatusTooManyRequests, rw.Code)
+	assert.Equal(t, "1", rw.Header().Get("Retry-After"))
+	assert.Contains(t, rw.Body.String(), `"reason":"TooManyRequests"`)
+}
+
+func TestIsLongRunning(t *testing.T) {
+	for path, expected := range map[string]bool{
+		"/api/v1/pods":                                      false,
+		"/api/v1/pods?watch=true":                           true,
+		"/api/v1/namespaces/default/pods?watch=1":           true,
+		"/api/v1/watch/pods":                                true,
+		"/api/v1/namespaces/default/pods/p/log?follow=true": true,
+	} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		assert.Equal(t, expected, isLongRunning(req), path)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/subscribe", nil)
+	req.Header.Set("Upgrade", "websocket")
+	assert.True(t, isLongRunning(req))
+}
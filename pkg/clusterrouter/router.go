@@ -6,6 +6,7 @@
 
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/rancher/pkg/clusterrouter/proxy"
+	"github.com/rancher/rancher/pkg/clusterrouter/ratelimit"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/types/config/dialer"
 	"k8s.io/client-go/rest"
@@ -39,7 +40,7 @@ func (r *Router) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	handler.ServeHTTP(rw, req)
+	ratelimit.Default.Handler(c.Name, handler).ServeHTTP(rw, req)
 }
 
 func response(rw http.ResponseWriter, code httperror.ErrorCode, message string) {
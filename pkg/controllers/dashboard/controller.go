@@ -15,6 +15,7 @@
 	"github.com/rancher/rancher/pkg/controllers/dashboard/systemcharts"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2"
 	"github.com/rancher/rancher/pkg/features"
+	"github.com/rancher/rancher/pkg/sessionrecording"
 	"github.com/rancher/rancher/pkg/wrangler"
 	"github.com/rancher/wrangler/pkg/needacert"
 )
@@ -33,6 +34,7 @@ func Register(ctx context.Context, wrangler *wrangler.Context, embedded bool) er
 		wrangler.Admission.ValidatingWebhookConfiguration(),
 		wrangler.CRD.CustomResourceDefinition())
 	scaleavailable.Register(ctx, wrangler)
+	sessionrecording.NewManager(wrangler.Core.Secret()).StartCleanup(ctx)
 	if err := systemcharts.Register(ctx, wrangler); err != nil {
 		return err
 	}
@@ -19,6 +19,11 @@ func Register(ctx context.Context, wrangler *wrangler.Context) {
 		wrangler.Core.ConfigMap(),
 		wrangler.Core.Secret(),
 		wrangler.Catalog.App())
+	RegisterUpgrades(ctx,
+		wrangler.Catalog.App(),
+		wrangler.Catalog.ClusterRepo(),
+		wrangler.CatalogContentManager,
+		wrangler.HelmOperations)
 	RegisterOperations(ctx,
 		wrangler.K8s,
 		wrangler.Core.Pod(),
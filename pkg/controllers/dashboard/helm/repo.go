@@ -4,14 +4,19 @@
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"strings"
 	"time"
 
 	catalog "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
 	"github.com/rancher/rancher/pkg/catalogv2"
 	"github.com/rancher/rancher/pkg/catalogv2/git"
 	helmhttp "github.com/rancher/rancher/pkg/catalogv2/http"
+	"github.com/rancher/rancher/pkg/catalogv2/oci"
 	catalogcontrollers "github.com/rancher/rancher/pkg/generated/controllers/catalog.cattle.io/v1"
 	namespaces "github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/wrangler/pkg/apply"
@@ -31,6 +36,8 @@
 
 var (
 	interval = 5 * time.Minute
+	// minInterval keeps a low refresh interval from hammering the repo and the apiserver
+	minInterval = time.Minute
 )
 
 type repoHandler struct {
@@ -74,7 +81,7 @@ func RegisterReposForFollowers(ctx context.Context,
 }
 
 func (r *repoHandler) ClusterRepoDownloadEnsureStatusHandler(repo *catalog.ClusterRepo, status catalog.RepoStatus) (catalog.RepoStatus, error) {
-	r.clusterRepos.EnqueueAfter(repo.Name, interval)
+	r.clusterRepos.EnqueueAfter(repo.Name, refreshInterval(&repo.Spec))
 	return r.ensure(&repo.Spec, status, &repo.ObjectMeta)
 }
 
@@ -84,7 +91,7 @@ func (r *repoHandler) ClusterRepoDownloadStatusHandler(repo *catalog.ClusterRepo
 		return status, err
 	}
 	if !shouldRefresh(&repo.Spec, &status) {
-		r.clusterRepos.EnqueueAfter(repo.Name, interval)
+		r.clusterRepos.EnqueueAfter(repo.Name, refreshInterval(&repo.Spec))
 		return status, nil
 	}
 
@@ -114,6 +121,86 @@ func (r *repoHandler) createOrUpdateMap(namespace, name string, index *repo.Inde
 	// do this before we normalize the namespace
 	ownerObject := toOwnerObject(namespace, owner)
 
+	if namespace == "" {
+		namespace = namespaces.System
+	}
+
+	content, err := encodeIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	var objs []runtime.Object
+	if len(content) > maxSize {
+		objs, err = shardIndex(namespace, index, owner, len(content))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		objs = chunks(namespace, nil, content, owner, map[string]string{
+			// Size ensure the resource version should update even if this is the head of a multipart chunk
+			"catalog.cattle.io/size": fmt.Sprint(len(content)),
+		})
+	}
+
+	return objs[0].(*corev1.ConfigMap), r.apply.WithOwner(ownerObject).ApplyObjects(objs...)
+}
+
+// shardIndex splits a huge index into shards holding the entries of a subset of the charts, so a refresh only
+// updates the shards of the charts which changed. The head configmap holds the rest of the index and lists the
+// shards in the catalog.cattle.io/shards annotation.
+func shardIndex(namespace string, index *repo.IndexFile, owner metav1.OwnerReference, size int) ([]runtime.Object, error) {
+	count := 1
+	for count <= size/maxSize {
+		count *= 2
+	}
+
+	shards := make([]*repo.IndexFile, count)
+	for i := range shards {
+		shards[i] = &repo.IndexFile{
+			APIVersion: index.APIVersion,
+			Entries:    map[string]repo.ChartVersions{},
+		}
+	}
+	for chartName, versions := range index.Entries {
+		h := fnv.New32a()
+		h.Write([]byte(chartName))
+		shards[h.Sum32()%uint32(count)].Entries[chartName] = versions
+	}
+
+	var (
+		objs   []runtime.Object
+		names  []string
+		digest = sha256.New()
+	)
+	for i, shard := range shards {
+		content, err := encodeIndex(shard)
+		if err != nil {
+			return nil, err
+		}
+		digest.Write(content)
+		shardObjs := chunks(namespace, []string{"shard", fmt.Sprint(i)}, content, owner, nil)
+		names = append(names, shardObjs[0].(*corev1.ConfigMap).Name)
+		objs = append(objs, shardObjs...)
+	}
+
+	content, err := encodeIndex(&repo.IndexFile{
+		APIVersion: index.APIVersion,
+		Generated:  index.Generated,
+		PublicKeys: index.PublicKeys,
+	})
+	if err != nil {
+		return nil, err
+	}
+	head := chunks(namespace, nil, content, owner, map[string]string{
+		"catalog.cattle.io/shards": strings.Join(names, ","),
+		// Digest ensure the resource version of the head updates when a shard changes
+		"catalog.cattle.io/digest": hex.EncodeToString(digest.Sum(nil)),
+	})
+	return append(head, objs...), nil
+}
+
+func encodeIndex(index *repo.IndexFile) ([]byte, error) {
 	buf := &bytes.Buffer{}
 	gz := gzip.NewWriter(buf)
 	if err := json.NewEncoder(gz).Encode(index); err != nil {
@@ -122,45 +209,52 @@ func (r *repoHandler) createOrUpdateMap(namespace, name string, index *repo.Inde
 	if err := gz.Close(); err != nil {
 		return nil, err
 	}
+	return buf.Bytes(), nil
+}
 
-	if namespace == "" {
-		namespace = namespaces.System
-	}
-
+// chunks splits content in configmaps of at most maxSize bytes, each chunk points to the next one in the
+// catalog.cattle.io/next annotation.
+func chunks(namespace string, prefix []string, content []byte, owner metav1.OwnerReference, annotations map[string]string) []runtime.Object {
 	var (
-		objs  []runtime.Object
-		bytes = buf.Bytes()
-		left  []byte
-		i     = 0
-		size  = len(bytes)
+		objs []runtime.Object
+		left []byte
+		i    = 0
 	)
 
+	chunkName := func(i int) string {
+		parts := append([]string{owner.Name}, prefix...)
+		return name2.SafeConcatName(append(parts, fmt.Sprint(i), string(owner.UID))...)
+	}
+
 	for {
-		if len(bytes) > maxSize {
-			left = bytes[maxSize:]
-			bytes = bytes[:maxSize]
+		if len(content) > maxSize {
+			left = content[maxSize:]
+			content = content[:maxSize]
 		}
 
 		next := ""
 		if len(left) > 0 {
-			next = name2.SafeConcatName(owner.Name, fmt.Sprint(i+1), string(owner.UID))
+			next = chunkName(i + 1)
 		}
 
 		cm := &corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:            name2.SafeConcatName(owner.Name, fmt.Sprint(i), string(owner.UID)),
+				Name:            chunkName(i),
 				Namespace:       namespace,
 				OwnerReferences: []metav1.OwnerReference{owner},
 				Annotations: map[string]string{
 					"catalog.cattle.io/next": next,
-					// Size ensure the resource version should update even if this is the head of a multipart chunk
-					"catalog.cattle.io/size": fmt.Sprint(size),
 				},
 			},
 			BinaryData: map[string][]byte{
-				"content": bytes,
+				"content": content,
 			},
 		}
+		if i == 0 {
+			for k, v := range annotations {
+				cm.Annotations[k] = v
+			}
+		}
 
 		objs = append(objs, cm)
 		if len(left) == 0 {
@@ -168,11 +262,11 @@ func (r *repoHandler) createOrUpdateMap(namespace, name string, index *repo.Inde
 		}
 
 		i++
-		bytes = left
+		content = left
 		left = nil
 	}
 
-	return objs[0].(*corev1.ConfigMap), r.apply.WithOwner(ownerObject).ApplyObjects(objs...)
+	return objs
 }
 
 func (r *repoHandler) ensure(repoSpec *catalog.RepoSpec, status catalog.RepoStatus, metadata *metav1.ObjectMeta) (catalog.RepoStatus, error) {
@@ -186,14 +280,39 @@ func (r *repoHandler) ensure(repoSpec *catalog.RepoSpec, status catalog.RepoStat
 		return status, err
 	}
 
-	return status, git.Ensure(secret, metadata.Namespace, metadata.Name, status.URL, status.Commit, repoSpec.InsecureSkipTLSverify)
+	return status, git.Ensure(secret, metadata.Namespace, metadata.Name, status.URL, status.Commit, git.Options{
+		Branch:          status.Branch,
+		Tag:             status.Tag,
+		SubPath:         status.SubPath,
+		Submodules:      status.Submodules,
+		InsecureSkipTLS: repoSpec.InsecureSkipTLSverify,
+	})
+}
+
+// gitOptions returns the checkout options of a git repo, resolving the tag to check out if it follows tags.
+func gitOptions(secret *corev1.Secret, repoSpec *catalog.RepoSpec) (git.Options, error) {
+	opts := git.Options{
+		Branch:          repoSpec.GitBranch,
+		SubPath:         repoSpec.GitSubPath,
+		Submodules:      repoSpec.GitSubmodules,
+		InsecureSkipTLS: repoSpec.InsecureSkipTLSverify,
+	}
+	if repoSpec.GitTagConstraint != "" {
+		tag, err := git.LatestTag(secret, repoSpec.GitRepo, repoSpec.GitTagConstraint, repoSpec.InsecureSkipTLSverify)
+		if err != nil {
+			return opts, err
+		}
+		opts.Tag = tag
+	}
+	return opts, nil
 }
 
 func (r *repoHandler) download(repoSpec *catalog.RepoSpec, status catalog.RepoStatus, metadata *metav1.ObjectMeta, owner metav1.OwnerReference) (catalog.RepoStatus, error) {
 	var (
-		index  *repo.IndexFile
-		commit string
-		err    error
+		index   *repo.IndexFile
+		commit  string
+		gitOpts git.Options
+		err     error
 	)
 
 	status.ObservedGeneration = metadata.Generation
@@ -204,30 +323,48 @@ func (r *repoHandler) download(repoSpec *catalog.RepoSpec, status catalog.RepoSt
 	}
 
 	downloadTime := metav1.Now()
+	if repoSpec.GitRepo != "" {
+		gitOpts, err = gitOptions(secret, repoSpec)
+		if err != nil {
+			return status, err
+		}
+	}
+
 	if repoSpec.GitRepo != "" && status.IndexConfigMapName == "" {
-		commit, err = git.Head(secret, metadata.Namespace, metadata.Name, repoSpec.GitRepo, repoSpec.GitBranch, repoSpec.InsecureSkipTLSverify)
+		commit, err = git.Head(secret, metadata.Namespace, metadata.Name, repoSpec.GitRepo, gitOpts)
 		if err != nil {
 			return status, err
 		}
-		status.URL = repoSpec.GitRepo
-		status.Branch = repoSpec.GitBranch
-		index, err = git.BuildOrGetIndex(metadata.Namespace, metadata.Name, repoSpec.GitRepo)
+		setGitStatus(&status, repoSpec, gitOpts)
+		index, err = git.BuildOrGetIndex(metadata.Namespace, metadata.Name, repoSpec.GitRepo, gitOpts.SubPath)
 	} else if repoSpec.GitRepo != "" {
-		commit, err = git.Update(secret, metadata.Namespace, metadata.Name, repoSpec.GitRepo, repoSpec.GitBranch, repoSpec.InsecureSkipTLSverify)
+		commit, err = git.Update(secret, metadata.Namespace, metadata.Name, repoSpec.GitRepo, gitOpts)
 		if err != nil {
 			return status, err
 		}
-		status.URL = repoSpec.GitRepo
-		status.Branch = repoSpec.GitBranch
-		if status.Commit == commit {
+		unchanged := status.Commit == commit && status.SubPath == gitOpts.SubPath && status.Submodules == gitOpts.Submodules
+		setGitStatus(&status, repoSpec, gitOpts)
+		if unchanged {
 			status.DownloadTime = downloadTime
 			return status, nil
 		}
-		index, err = git.BuildOrGetIndex(metadata.Namespace, metadata.Name, repoSpec.GitRepo)
+		index, err = git.BuildOrGetIndex(metadata.Namespace, metadata.Name, repoSpec.GitRepo, gitOpts.SubPath)
+	} else if oci.IsOCI(repoSpec.URL) {
+		status.URL = repoSpec.URL
+		status.Branch = ""
+		index, err = oci.DownloadIndex(secret, repoSpec.URL, repoSpec.CABundle, repoSpec.InsecureSkipTLSverify)
 	} else if repoSpec.URL != "" {
+		version := indexVersion(repoSpec, &status)
 		status.URL = repoSpec.URL
 		status.Branch = ""
-		index, err = helmhttp.DownloadIndex(secret, repoSpec.URL, repoSpec.CABundle, repoSpec.InsecureSkipTLSverify, repoSpec.DisableSameOriginCheck)
+		index, version, err = helmhttp.DownloadIndex(secret, repoSpec.URL, repoSpec.CABundle, repoSpec.InsecureSkipTLSverify, repoSpec.DisableSameOriginCheck, version)
+		if err == nil && index == nil {
+			// the index didn't change, the stored one is still current
+			status.DownloadTime = downloadTime
+			return status, nil
+		}
+		status.IndexETag = version.ETag
+		status.IndexLastModified = version.LastModified
 	} else {
 		return status, nil
 	}
@@ -255,11 +392,20 @@ func (r *repoHandler) download(repoSpec *catalog.RepoSpec, status catalog.RepoSt
 	return status, nil
 }
 
+func setGitStatus(status *catalog.RepoStatus, repoSpec *catalog.RepoSpec, opts git.Options) {
+	status.URL = repoSpec.GitRepo
+	status.Branch = repoSpec.GitBranch
+	status.Tag = opts.Tag
+	status.SubPath = opts.SubPath
+	status.Submodules = opts.Submodules
+}
+
 func (r *repoHandler) ensureIndexConfigMap(repo *catalog.ClusterRepo, status *catalog.RepoStatus) error {
 	// Charts from the clusterRepo will be unavailable if the IndexConfigMap recorded in the status does not exist.
 	// By resetting the value of IndexConfigMapName, IndexConfigMapNamespace, IndexConfigMapResourceVersion to "",
 	// the method shouldRefresh will return true and trigger the rebuild of the IndexConfigMap and accordingly update the status.
-	if repo.Spec.GitRepo != "" && status.IndexConfigMapName != "" {
+	// This also applies to http repos which would otherwise keep reporting the missing index as not modified.
+	if status.IndexConfigMapName != "" {
 		_, err := r.configMapCache.Get(status.IndexConfigMapNamespace, status.IndexConfigMapName)
 		if err != nil {
 			if apierrors.IsNotFound(err) {
@@ -278,6 +424,12 @@ func shouldRefresh(spec *catalog.RepoSpec, status *catalog.RepoStatus) bool {
 	if spec.GitRepo != "" && status.Branch != spec.GitBranch {
 		return true
 	}
+	if spec.GitRepo != "" && (status.SubPath != spec.GitSubPath || status.Submodules != spec.GitSubmodules) {
+		return true
+	}
+	if spec.GitRepo != "" && (spec.GitTagConstraint == "") != (status.Tag == "") {
+		return true
+	}
 	if spec.URL != "" && spec.URL != status.URL {
 		return true
 	}
@@ -290,6 +442,31 @@ func shouldRefresh(spec *catalog.RepoSpec, status *catalog.RepoStatus) bool {
 	if spec.ForceUpdate != nil && spec.ForceUpdate.After(status.DownloadTime.Time) && spec.ForceUpdate.Time.Before(time.Now()) {
 		return true
 	}
-	refreshTime := time.Now().Add(-interval)
+	refreshTime := time.Now().Add(-refreshInterval(spec))
 	return refreshTime.After(status.DownloadTime.Time)
 }
+
+func refreshInterval(spec *catalog.RepoSpec) time.Duration {
+	if spec.RefreshInterval <= 0 {
+		return interval
+	}
+	if d := time.Duration(spec.RefreshInterval) * time.Second; d > minInterval {
+		return d
+	}
+	return minInterval
+}
+
+// indexVersion returns the validators of the stored index, none are sent if the index has to be downloaded again
+// because it is missing, the URL changed or an update is forced.
+func indexVersion(spec *catalog.RepoSpec, status *catalog.RepoStatus) helmhttp.IndexVersion {
+	if status.IndexConfigMapName == "" || spec.URL != status.URL {
+		return helmhttp.IndexVersion{}
+	}
+	if spec.ForceUpdate != nil && spec.ForceUpdate.After(status.DownloadTime.Time) && spec.ForceUpdate.Time.Before(time.Now()) {
+		return helmhttp.IndexVersion{}
+	}
+	return helmhttp.IndexVersion{
+		ETag:         status.IndexETag,
+		LastModified: status.IndexLastModified,
+	}
+}
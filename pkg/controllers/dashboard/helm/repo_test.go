@@ -1,11 +1,21 @@
 package helm
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	catalog "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
+	helmhttp "github.com/rancher/rancher/pkg/catalogv2/http"
 	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/repo"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -86,6 +96,63 @@ func TestShouldRefresh(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"git repo - sub-path changed",
+			&catalog.RepoSpec{
+				GitRepo:    "https://example.com/charts.git",
+				GitSubPath: "charts",
+			},
+			&catalog.RepoStatus{
+				URL:                "https://example.com/charts.git",
+				IndexConfigMapName: "configmap",
+				DownloadTime:       metav1.Now(),
+			},
+			true,
+		},
+		{
+			"git repo - following tags",
+			&catalog.RepoSpec{
+				GitRepo:          "https://example.com/charts.git",
+				GitTagConstraint: "*",
+			},
+			&catalog.RepoStatus{
+				URL:                "https://example.com/charts.git",
+				Tag:                "v1.0.0",
+				IndexConfigMapName: "configmap",
+				DownloadTime:       metav1.Now(),
+			},
+			false,
+		},
+		{
+			"http repo - refresh interval not elapsed",
+			&catalog.RepoSpec{
+				URL:             "https://example.com",
+				RefreshInterval: 3600,
+			},
+			&catalog.RepoStatus{
+				URL:                "https://example.com",
+				IndexConfigMapName: "configmap",
+				DownloadTime: metav1.Time{
+					Time: time.Now().Add(-30 * time.Minute),
+				},
+			},
+			false,
+		},
+		{
+			"http repo - refresh interval elapsed",
+			&catalog.RepoSpec{
+				URL:             "https://example.com",
+				RefreshInterval: 60,
+			},
+			&catalog.RepoStatus{
+				URL:                "https://example.com",
+				IndexConfigMapName: "configmap",
+				DownloadTime: metav1.Time{
+					Time: time.Now().Add(-2 * time.Minute),
+				},
+			},
+			true,
+		},
 		{
 			"http repo - force update",
 			&catalog.RepoSpec{
@@ -209,3 +276,97 @@ func TestShouldRefresh(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshInterval(t *testing.T) {
+	assert.Equal(t, interval, refreshInterval(&catalog.RepoSpec{}))
+	assert.Equal(t, minInterval, refreshInterval(&catalog.RepoSpec{RefreshInterval: 1}))
+	assert.Equal(t, minInterval, refreshInterval(&catalog.RepoSpec{RefreshInterval: 60}))
+	assert.Equal(t, time.Hour, refreshInterval(&catalog.RepoSpec{RefreshInterval: 3600}))
+}
+
+func TestIndexVersion(t *testing.T) {
+	spec := &catalog.RepoSpec{
+		URL: "https://example.com",
+	}
+	status := &catalog.RepoStatus{
+		URL:                "https://example.com",
+		IndexConfigMapName: "configmap",
+		IndexETag:          `"etag"`,
+		IndexLastModified:  "Mon, 02 Jan 2006 15:04:05 GMT",
+		DownloadTime:       metav1.Now(),
+	}
+	assert.Equal(t, helmhttp.IndexVersion{ETag: `"etag"`, LastModified: "Mon, 02 Jan 2006 15:04:05 GMT"}, indexVersion(spec, status))
+
+	spec.ForceUpdate = &metav1.Time{Time: time.Now()}
+	assert.Equal(t, helmhttp.IndexVersion{}, indexVersion(spec, status))
+
+	spec.ForceUpdate = nil
+	spec.URL = "https://other.example.com"
+	assert.Equal(t, helmhttp.IndexVersion{}, indexVersion(spec, status))
+}
+
+func TestShardIndex(t *testing.T) {
+	owner := metav1.OwnerReference{
+		Name: "repo",
+		UID:  "uid",
+	}
+
+	index := repo.NewIndexFile()
+	index.Add(&chart.Metadata{Name: "small", Version: "1.0.0"}, "small-1.0.0.tgz", "https://example.com", "digest")
+	content, err := encodeIndex(index)
+	if !assert.NoError(t, err) {
+		return
+	}
+	objs := chunks("ns", nil, content, owner, nil)
+	if assert.Len(t, objs, 1) {
+		assert.Equal(t, "repo-0-uid", objs[0].(*corev1.ConfigMap).Name)
+	}
+
+	for i := 0; i < 5000; i++ {
+		index.Add(&chart.Metadata{Name: fmt.Sprintf("chart-%d", i), Version: "1.0.0"},
+			fmt.Sprintf("chart-%d-1.0.0.tgz", i), "https://example.com", fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprint(i)))))
+	}
+	content, err = encodeIndex(index)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Greater(t, len(content), maxSize)
+
+	objs, err = shardIndex("ns", index, owner, len(content))
+	if !assert.NoError(t, err) {
+		return
+	}
+	head := objs[0].(*corev1.ConfigMap)
+	assert.Equal(t, "repo-0-uid", head.Name)
+	shards := strings.Split(head.Annotations["catalog.cattle.io/shards"], ",")
+	assert.Greater(t, len(shards), 1)
+
+	configMaps := map[string]*corev1.ConfigMap{}
+	for _, obj := range objs {
+		cm := obj.(*corev1.ConfigMap)
+		assert.LessOrEqual(t, len(cm.BinaryData["content"]), maxSize)
+		configMaps[cm.Name] = cm
+	}
+	entries := map[string]repo.ChartVersions{}
+	for _, name := range shards {
+		var data []byte
+		for cm := configMaps[name]; cm != nil; cm = configMaps[cm.Annotations["catalog.cattle.io/next"]] {
+			data = append(data, cm.BinaryData["content"]...)
+		}
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if !assert.NoError(t, err) {
+			return
+		}
+		shard := &repo.IndexFile{}
+		assert.NoError(t, json.NewDecoder(gz).Decode(shard))
+		for chartName, versions := range shard.Entries {
+			entries[chartName] = versions
+		}
+	}
+	assert.Len(t, entries, len(index.Entries))
+
+	// the shards are stable, an unchanged index produces the same configmaps
+	again, err := shardIndex("ns", index, owner, len(content))
+	assert.NoError(t, err)
+	assert.Equal(t, objs, again)
+}
@@ -0,0 +1,330 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/rancher/rancher/pkg/api/steve/catalog/types"
+	v1 "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/catalogv2/content"
+	catalogcontrollers "github.com/rancher/rancher/pkg/generated/controllers/catalog.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/maintenance"
+	"github.com/rancher/wrangler/pkg/relatedresource"
+	"github.com/sirupsen/logrus"
+	"helm.sh/helm/v3/pkg/repo"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+const (
+	sourceRepoAnnotation     = "catalog.cattle.io/ui-source-repo"
+	sourceRepoTypeAnnotation = "catalog.cattle.io/ui-source-repo-type"
+
+	defaultUpgradeWindowMinutes = 60
+	// upgradeRetryInterval is how long to wait before creating another operation for the same upgrade.
+	upgradeRetryInterval = time.Hour
+)
+
+// upgrader creates helm upgrade operations, it is implemented by helmop.Operations.
+type upgrader interface {
+	Upgrade(ctx context.Context, user user.Info, namespace, name string, options io.Reader, imageOverride string) (*v1.Operation, error)
+}
+
+type upgradeHandler struct {
+	ctx            context.Context
+	apps           catalogcontrollers.AppController
+	appCache       catalogcontrollers.AppCache
+	repoCache      catalogcontrollers.ClusterRepoCache
+	contentManager *content.Manager
+	operations     upgrader
+}
+
+func RegisterUpgrades(ctx context.Context,
+	apps catalogcontrollers.AppController,
+	repos catalogcontrollers.ClusterRepoController,
+	contentManager *content.Manager,
+	operations upgrader,
+) {
+	h := &upgradeHandler{
+		ctx:            ctx,
+		apps:           apps,
+		appCache:       apps.Cache(),
+		repoCache:      repos.Cache(),
+		contentManager: contentManager,
+		operations:     operations,
+	}
+	apps.OnChange(ctx, "helm-app-upgrade", h.OnAppChange)
+	relatedresource.Watch(ctx, "helm-app-upgrade", h.resolveRepoApps, apps, repos)
+}
+
+// resolveRepoApps enqueues the apps with an upgrade policy installed from a ClusterRepo when it changes, which
+// includes every refresh of its index.
+func (h *upgradeHandler) resolveRepoApps(namespace, name string, obj runtime.Object) ([]relatedresource.Key, error) {
+	if _, ok := obj.(*v1.ClusterRepo); !ok {
+		return nil, nil
+	}
+
+	apps, err := h.appCache.List("", labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []relatedresource.Key
+	for _, app := range apps {
+		if repoName, ok := sourceClusterRepo(app); ok && repoName == name && app.Annotations[v1.UpgradePolicyAnnotation] != "" {
+			keys = append(keys, relatedresource.Key{
+				Namespace: app.Namespace,
+				Name:      app.Name,
+			})
+		}
+	}
+	return keys, nil
+}
+
+func sourceClusterRepo(app *v1.App) (string, bool) {
+	if app.Spec.Chart == nil || app.Spec.Chart.Metadata == nil {
+		return "", false
+	}
+	annotations := app.Spec.Chart.Metadata.Annotations
+	if annotations[sourceRepoTypeAnnotation] != "cluster" || annotations[sourceRepoAnnotation] == "" {
+		return "", false
+	}
+	return annotations[sourceRepoAnnotation], true
+}
+
+func (h *upgradeHandler) OnAppChange(key string, app *v1.App) (*v1.App, error) {
+	if app == nil || app.DeletionTimestamp != nil {
+		return app, nil
+	}
+
+	policy := app.Annotations[v1.UpgradePolicyAnnotation]
+	if policy != v1.UpgradePolicyPatch && policy != v1.UpgradePolicyMinor {
+		return app, nil
+	}
+	repoName, ok := sourceClusterRepo(app)
+	if !ok || app.Spec.Info == nil {
+		return app, nil
+	}
+
+	current, err := semver.NewVersion(app.Spec.Chart.Metadata.Version)
+	if err != nil {
+		return app, nil
+	}
+
+	upgrade := app.Status.Upgrade
+	failed := false
+	switch app.Spec.Info.Status {
+	case v1.StatusDeployed:
+	case v1.StatusFailed:
+		// only failed automatic upgrades are retried, from the version they upgraded from
+		if upgrade == nil || upgrade.ToVersion != app.Spec.Chart.Metadata.Version {
+			return app, nil
+		}
+		if upgrade.Error == "" {
+			return h.setUpgradeStatus(app, func(status *v1.UpgradeStatus) {
+				status.Error = fmt.Sprintf("upgrade to %s failed: %s", status.ToVersion, app.Spec.Info.Description)
+			})
+		}
+		if current, err = semver.NewVersion(upgrade.FromVersion); err != nil {
+			return app, nil
+		}
+		failed = true
+	default:
+		return app, nil
+	}
+
+	index, err := h.contentManager.Index("", repoName, false)
+	if err != nil {
+		return app, err
+	}
+	target := latestVersion(index, app.Spec.Chart.Metadata.Name, current, policy)
+	if target == nil {
+		return app, nil
+	}
+
+	clusterRepo, err := h.repoCache.Get(repoName)
+	if err != nil {
+		return app, err
+	}
+	upgradeUser, err := serviceAccountUser(clusterRepo)
+	if err != nil {
+		return h.setUpgradeStatus(app, func(status *v1.UpgradeStatus) {
+			status.Error = err.Error()
+		})
+	}
+
+	if upgrade != nil && upgrade.ToVersion == target.Original() {
+		if retry := upgrade.LastAttempt.Add(upgradeRetryInterval); time.Now().Before(retry) {
+			h.apps.EnqueueAfter(app.Namespace, app.Name, time.Until(retry))
+			return app, nil
+		}
+	}
+
+	if window, err := upgradeWindow(app); err != nil {
+		return h.setUpgradeStatus(app, func(status *v1.UpgradeStatus) {
+			status.Error = err.Error()
+		})
+	} else if window != nil {
+		if failed {
+			wait, err := nextWindowWait(window, upgrade.LastAttempt.Time, time.Now())
+			if err != nil {
+				return app, err
+			}
+			if wait > 0 {
+				h.apps.EnqueueAfter(app.Namespace, app.Name, wait)
+				return app, nil
+			}
+		}
+		open, next, err := maintenance.Open(window, time.Now())
+		if err != nil {
+			return app, err
+		}
+		if !open {
+			logrus.Debugf("[helm-app-upgrade] app %s/%s can be upgraded to %s, waiting %v for the maintenance window", app.Namespace, app.Name, target.Original(), next)
+			h.apps.EnqueueAfter(app.Namespace, app.Name, next)
+			return app, nil
+		}
+	}
+
+	logrus.Infof("[helm-app-upgrade] upgrading app %s/%s from %s to %s following the %s policy", app.Namespace, app.Name, current.Original(), target.Original(), policy)
+	op, opErr := h.upgrade(app, upgradeUser, repoName, target.Original())
+	return h.setUpgradeStatus(app, func(status *v1.UpgradeStatus) {
+		*status = v1.UpgradeStatus{
+			Policy:      policy,
+			FromVersion: current.Original(),
+			ToVersion:   target.Original(),
+			LastAttempt: metav1.Now(),
+		}
+		if opErr != nil {
+			status.Error = opErr.Error()
+		} else {
+			status.Operation = op.Namespace + "/" + op.Name
+		}
+	})
+}
+
+// serviceAccountUser returns the service account of a repo, which automatic upgrades of its apps run as. Nobody is
+// around to authorize an automatic upgrade, so the apps of repos without a service account aren't upgraded.
+func serviceAccountUser(clusterRepo *v1.ClusterRepo) (user.Info, error) {
+	serviceAccount, namespace := clusterRepo.Spec.ServiceAccount, clusterRepo.Spec.ServiceAccountNamespace
+	if serviceAccount == "" || namespace == "" || strings.Contains(namespace, ":") {
+		return nil, fmt.Errorf("repo %s has no service account to run automatic upgrades as, set its serviceAccount and serviceAccountNamespace", clusterRepo.Name)
+	}
+	return &user.DefaultInfo{
+		Name: fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount),
+		Groups: []string{
+			"system:serviceaccounts",
+			"system:serviceaccounts:" + namespace,
+		},
+	}, nil
+}
+
+// upgrade creates the upgrade operation of an app as upgradeUser, keeping the values it was installed with.
+func (h *upgradeHandler) upgrade(app *v1.App, upgradeUser user.Info, repoName, version string) (*v1.Operation, error) {
+	upgrade, err := json.Marshal(types.ChartUpgradeAction{
+		Timeout:    &metav1.Duration{Duration: 10 * time.Minute},
+		Wait:       true,
+		MaxHistory: 5,
+		Namespace:  app.Spec.Namespace,
+		Charts: []types.ChartUpgrade{
+			{
+				ChartName:   app.Spec.Chart.Metadata.Name,
+				Version:     version,
+				ReleaseName: app.Spec.Name,
+				ResetValues: true,
+				Values:      app.Spec.Values,
+				Description: fmt.Sprintf("automatic upgrade following the %s policy", app.Annotations[v1.UpgradePolicyAnnotation]),
+				Annotations: map[string]string{
+					sourceRepoTypeAnnotation: "cluster",
+					sourceRepoAnnotation:     repoName,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h.operations.Upgrade(h.ctx, upgradeUser, "", repoName, bytes.NewBuffer(upgrade), "")
+}
+
+func (h *upgradeHandler) setUpgradeStatus(app *v1.App, update func(status *v1.UpgradeStatus)) (*v1.App, error) {
+	toUpdate := app.DeepCopy()
+	if toUpdate.Status.Upgrade == nil {
+		toUpdate.Status.Upgrade = &v1.UpgradeStatus{}
+	}
+	update(toUpdate.Status.Upgrade)
+	if equality.Semantic.DeepEqual(app.Status, toUpdate.Status) {
+		return app, nil
+	}
+	return h.apps.UpdateStatus(toUpdate)
+}
+
+// latestVersion returns the latest version of a chart the policy allows to upgrade to, or nil if the current
+// version is the latest. Pre-releases are only followed from a pre-release.
+func latestVersion(index *repo.IndexFile, chartName string, current *semver.Version, policy string) *semver.Version {
+	var latest *semver.Version
+	for _, chartVersion := range index.Entries[chartName] {
+		version, err := semver.NewVersion(chartVersion.Version)
+		if err != nil || !version.GreaterThan(current) {
+			continue
+		}
+		if version.Prerelease() != "" && current.Prerelease() == "" {
+			continue
+		}
+		if version.Major() != current.Major() {
+			continue
+		}
+		if policy == v1.UpgradePolicyPatch && version.Minor() != current.Minor() {
+			continue
+		}
+		if latest == nil || version.GreaterThan(latest) {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// nextWindowWait returns how long to wait for the occurrence of the window following the one of the last attempt, failed
+// upgrades are retried at the next window rather than again in the window they failed in.
+func nextWindowWait(window *v32.MaintenanceWindow, lastAttempt, now time.Time) (time.Duration, error) {
+	_, end, err := maintenance.Occurrence(window, lastAttempt)
+	if err != nil {
+		return 0, err
+	}
+	if now.Before(end) {
+		return end.Sub(now), nil
+	}
+	return 0, nil
+}
+
+func upgradeWindow(app *v1.App) (*v32.MaintenanceWindow, error) {
+	schedule := app.Annotations[v1.UpgradeWindowScheduleAnnotation]
+	if schedule == "" {
+		return nil, nil
+	}
+
+	window := &v32.MaintenanceWindow{
+		CronSchedule:    schedule,
+		DurationMinutes: defaultUpgradeWindowMinutes,
+	}
+	if minutes := app.Annotations[v1.UpgradeWindowMinutesAnnotation]; minutes != "" {
+		d, err := strconv.Atoi(minutes)
+		if err != nil || d < 1 {
+			return nil, fmt.Errorf("invalid %s annotation %q: must be a positive number of minutes", v1.UpgradeWindowMinutesAnnotation, minutes)
+		}
+		window.DurationMinutes = d
+	}
+	return window, maintenance.Validate(window)
+}
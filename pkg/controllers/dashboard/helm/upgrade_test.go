@@ -0,0 +1,149 @@
+package helm
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	catalog "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/repo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+func TestLatestVersion(t *testing.T) {
+	index := repo.NewIndexFile()
+	for _, version := range []string{"1.2.3", "1.2.5", "1.2.6-rc1", "1.3.0", "1.4.1", "2.0.0", "invalid"} {
+		index.Entries["mychart"] = append(index.Entries["mychart"], &repo.ChartVersion{
+			Metadata: &chart.Metadata{
+				Name:    "mychart",
+				Version: version,
+			},
+		})
+	}
+
+	tests := []struct {
+		name     string
+		current  string
+		policy   string
+		expected string
+	}{
+		{"patch", "1.2.3", catalog.UpgradePolicyPatch, "1.2.5"},
+		{"minor", "1.2.3", catalog.UpgradePolicyMinor, "1.4.1"},
+		{"latest patch", "1.2.5", catalog.UpgradePolicyPatch, ""},
+		{"latest minor", "1.4.1", catalog.UpgradePolicyMinor, ""},
+		{"pre-release", "1.2.6-rc0", catalog.UpgradePolicyPatch, "1.2.6-rc1"},
+		{"major", "2.0.0", catalog.UpgradePolicyMinor, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			latest := latestVersion(index, "mychart", semver.MustParse(test.current), test.policy)
+			if test.expected == "" {
+				assert.Nil(t, latest)
+			} else if assert.NotNil(t, latest) {
+				assert.Equal(t, test.expected, latest.Original())
+			}
+		})
+	}
+}
+
+func TestUpgradeWindow(t *testing.T) {
+	app := &catalog.App{}
+	window, err := upgradeWindow(app)
+	assert.NoError(t, err)
+	assert.Nil(t, window)
+
+	app.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{
+			catalog.UpgradeWindowScheduleAnnotation: "0 2 * * 6",
+		},
+	}
+	window, err = upgradeWindow(app)
+	assert.NoError(t, err)
+	if assert.NotNil(t, window) {
+		assert.Equal(t, "0 2 * * 6", window.CronSchedule)
+		assert.Equal(t, defaultUpgradeWindowMinutes, window.DurationMinutes)
+	}
+
+	app.Annotations[catalog.UpgradeWindowMinutesAnnotation] = "120"
+	window, err = upgradeWindow(app)
+	assert.NoError(t, err)
+	if assert.NotNil(t, window) {
+		assert.Equal(t, 120, window.DurationMinutes)
+	}
+
+	app.Annotations[catalog.UpgradeWindowMinutesAnnotation] = "0"
+	_, err = upgradeWindow(app)
+	assert.Error(t, err)
+
+	app.Annotations[catalog.UpgradeWindowMinutesAnnotation] = "60"
+	app.Annotations[catalog.UpgradeWindowScheduleAnnotation] = "not a schedule"
+	_, err = upgradeWindow(app)
+	assert.Error(t, err)
+}
+
+func TestNextWindowWait(t *testing.T) {
+	// Saturdays from 2:00 to 3:00, the last attempt failed on Saturday 2021-06-05 at 2:10
+	window := &v32.MaintenanceWindow{CronSchedule: "0 2 * * 6", DurationMinutes: 60}
+	lastAttempt := time.Date(2021, 6, 5, 2, 10, 0, 0, time.Local)
+
+	wait, err := nextWindowWait(window, lastAttempt, lastAttempt.Add(20*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, wait)
+
+	wait, err = nextWindowWait(window, lastAttempt, time.Date(2021, 6, 12, 2, 5, 0, 0, time.Local))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+type fakeUpgrader struct {
+	users []user.Info
+}
+
+func (f *fakeUpgrader) Upgrade(ctx context.Context, user user.Info, namespace, name string, options io.Reader, imageOverride string) (*catalog.Operation, error) {
+	f.users = append(f.users, user)
+	return &catalog.Operation{}, nil
+}
+
+func TestUpgradeUser(t *testing.T) {
+	clusterRepo := &catalog.ClusterRepo{}
+	clusterRepo.Name = "partner"
+	_, err := serviceAccountUser(clusterRepo)
+	assert.Error(t, err)
+
+	clusterRepo.Spec.ServiceAccount = "upgrader"
+	_, err = serviceAccountUser(clusterRepo)
+	assert.Error(t, err)
+
+	clusterRepo.Spec.ServiceAccountNamespace = "apps"
+	upgradeUser, err := serviceAccountUser(clusterRepo)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	upgrader := &fakeUpgrader{}
+	h := &upgradeHandler{
+		ctx:        context.Background(),
+		operations: upgrader,
+	}
+	app := &catalog.App{
+		Spec: catalog.ReleaseSpec{
+			Name:      "app",
+			Namespace: "apps",
+			Chart: &catalog.Chart{
+				Metadata: &catalog.Metadata{Name: "mychart", Version: "1.2.3"},
+			},
+		},
+	}
+	_, err = h.upgrade(app, upgradeUser, clusterRepo.Name, "1.2.5")
+	assert.NoError(t, err)
+	if assert.Len(t, upgrader.users, 1) {
+		assert.Equal(t, "system:serviceaccount:apps:upgrader", upgrader.users[0].GetName())
+		assert.NotContains(t, upgrader.users[0].GetGroups(), "system:masters")
+	}
+}
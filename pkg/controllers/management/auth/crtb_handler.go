@@ -37,6 +37,8 @@
 )
 
 var clusterManagmentPlaneResources = map[string]string{
+	"alerthistories":              "management.cattle.io",
+	"alertmaintenancewindows":     "management.cattle.io",
 	"clusterscans":                "management.cattle.io",
 	"catalogtemplates":            "management.cattle.io",
 	"catalogtemplateversions":     "management.cattle.io",
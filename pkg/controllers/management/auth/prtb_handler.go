@@ -33,6 +33,7 @@
 	"sourcecodeproviderconfigs":   "project.cattle.io",
 	"projectloggings":             "management.cattle.io",
 	"projectalertrules":           "management.cattle.io",
+	"alerthistories":              "management.cattle.io",
 	"projectalertgroups":          "management.cattle.io",
 	"projectcatalogs":             "management.cattle.io",
 	"projectmonitorgraphs":        "management.cattle.io",
@@ -13,8 +13,12 @@
 	dataKey = "cluster"
 )
 
+func NewStore(namespaces v1.NamespaceInterface, secretsGetter v1.SecretsGetter) (*encryptedstore.GenericEncryptedStore, error) {
+	return encryptedstore.NewGenericEncryptedStore("c-", "", namespaces, secretsGetter)
+}
+
 func NewPersistentStore(namespaces v1.NamespaceInterface, secretsGetter v1.SecretsGetter) cluster.PersistentStore {
-	store, err := encryptedstore.NewGenericEncryptedStore("c-", "", namespaces, secretsGetter)
+	store, err := NewStore(namespaces, secretsGetter)
 	if err != nil {
 		logrus.Fatal(err)
 	}
@@ -10,12 +10,14 @@
 
 	"github.com/pkg/errors"
 	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/rancher/pkg/channelserver"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -84,6 +86,26 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 
 	mgmt.Settings("").AddHandler(ctx, "rke-metadata-handler", m.sync)
 	mgmt.Settings("").Controller().Enqueue("", rkeMetadataConfig)
+	management.Core.ConfigMaps(namespace.System).AddHandler(ctx, "rke-metadata-bundle-handler", m.syncBundle)
+}
+
+// syncBundle installs the release metadata bundle imported through the API on this replica, and refreshes
+// the RKE metadata from it when running offline. The channel server picks up the new bundle by itself.
+func (m *MetadataController) syncBundle(key string, configMap *corev1.ConfigMap) (runtime.Object, error) {
+	if configMap == nil || configMap.DeletionTimestamp != nil ||
+		configMap.Namespace != namespace.System || configMap.Name != channelserver.BundleConfigMapName {
+		return configMap, nil
+	}
+
+	installed := channelserver.InstalledBundle()
+	bundle, err := channelserver.InstallBundle(configMap.BinaryData[channelserver.BundleConfigMapKey])
+	if err != nil {
+		return configMap, fmt.Errorf("failed to install release metadata bundle: %v", err)
+	}
+	if bundle != installed && channelserver.Offline() {
+		m.Settings.Controller().Enqueue("", rkeMetadataConfig)
+	}
+	return configMap, nil
 }
 
 func (m *MetadataController) sync(key string, setting *v3.Setting) (runtime.Object, error) {
@@ -8,6 +8,7 @@
 	"strings"
 
 	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/rancher/pkg/channelserver"
 	"github.com/rancher/rancher/pkg/git"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rke/types/kdm"
@@ -39,6 +40,9 @@ func parseURL(rkeData map[string]interface{}) (*MetadataURL, error) {
 }
 
 func loadData(url *MetadataURL) (kdm.Data, error) {
+	if channelserver.Offline() {
+		return getDataBundle()
+	}
 	if url.isGit {
 		return getDataGit(url.path, url.branch)
 	}
@@ -67,6 +71,17 @@ func getDataHTTP(url string) (kdm.Data, error) {
 	return data, nil
 }
 
+func getDataBundle() (kdm.Data, error) {
+	if channelserver.InstalledBundle() == nil {
+		return kdm.Data{}, fmt.Errorf("driverMetadata running offline and no release metadata bundle has been imported")
+	}
+	content, err := ioutil.ReadFile(channelserver.BundleDataPath())
+	if err != nil {
+		return kdm.Data{}, fmt.Errorf("driverMetadata err reading bundle %v", err)
+	}
+	return kdm.FromData(content)
+}
+
 func getDataGit(urlPath, branch string) (kdm.Data, error) {
 	var data kdm.Data
 
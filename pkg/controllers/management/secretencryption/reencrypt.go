@@ -0,0 +1,104 @@
+package secretencryption
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
+	"github.com/rancher/rancher/pkg/encryptedstore"
+	"github.com/rancher/rancher/pkg/nodeconfig"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const retryInterval = time.Minute
+
+// target is a store to re-encrypt along with the names of the objects it keeps secrets for.
+type target struct {
+	store *encryptedstore.GenericEncryptedStore
+	names func() ([]string, error)
+}
+
+// Register starts the re-encryption job when encryption keys are configured. Keys are loaded once at
+// startup, so after a rotation (adding a new key to the keys file, making it primary and restarting) the
+// job moves every stored secret onto the new primary key, after which the old key can be removed.
+//
+// Only the stores built on GenericEncryptedStore are covered: cluster secrets and node driver configs.
+// Cloud credentials stay plaintext secrets in the global data namespace because the hosted cluster
+// operators and the machine provisioning pods read them directly.
+func Register(ctx context.Context, management *config.ManagementContext) {
+	keyring, err := encryptedstore.DefaultKeyring()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	if keyring == nil {
+		return
+	}
+
+	clusterStore, err := clusterprovisioner.NewStore(management.Core.Namespaces(""), management.Core)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	nodeConfigStore, err := nodeconfig.NewStore(management.Core.Namespaces(""), management.Core)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	clusters := management.Management.Clusters("")
+	nodes := management.Management.Nodes("")
+	go reencrypt(ctx,
+		target{
+			store: clusterStore,
+			names: func() ([]string, error) {
+				list, err := clusters.List(metav1.ListOptions{})
+				if err != nil {
+					return nil, err
+				}
+				var names []string
+				for _, cluster := range list.Items {
+					names = append(names, cluster.Name)
+				}
+				return names, nil
+			},
+		},
+		target{
+			store: nodeConfigStore,
+			names: func() ([]string, error) {
+				list, err := nodes.List(metav1.ListOptions{})
+				if err != nil {
+					return nil, err
+				}
+				var names []string
+				for _, node := range list.Items {
+					names = append(names, node.Name)
+				}
+				return names, nil
+			},
+		})
+}
+
+func reencrypt(ctx context.Context, targets ...target) {
+	for _, target := range targets {
+		err := wait.PollImmediateUntil(retryInterval, func() (bool, error) {
+			names, err := target.names()
+			if err != nil {
+				logrus.Errorf("[secretencryption] failed to list the owners of secrets, retrying in %v: %v", retryInterval, err)
+				return false, nil
+			}
+			count, err := target.store.Reencrypt(names)
+			if count > 0 {
+				logrus.Infof("[secretencryption] re-encrypted %d secrets", count)
+			}
+			if err != nil {
+				logrus.Errorf("[secretencryption] failed to re-encrypt secrets, retrying in %v: %v", retryInterval, err)
+				return false, nil
+			}
+			return true, nil
+		}, ctx.Done())
+		if err != nil {
+			return
+		}
+	}
+}
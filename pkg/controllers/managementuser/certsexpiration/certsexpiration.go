@@ -27,11 +27,13 @@
 )
 
 type Controller struct {
-	ClusterName   string
-	ClusterLister v3.ClusterLister
-	ClusterClient v3.ClusterInterface
-	ClusterStore  cluster.PersistentStore
-	SecretLister  v1.SecretLister
+	ClusterName       string
+	ClusterLister     v3.ClusterLister
+	ClusterClient     v3.ClusterInterface
+	ClusterController v3.ClusterController
+	ClusterStore      cluster.PersistentStore
+	SecretLister      v1.SecretLister
+	Events            v1.EventInterface
 }
 
 func Register(ctx context.Context, userContext *config.UserContext) {
@@ -54,11 +56,13 @@ func Register(ctx context.Context, userContext *config.UserContext) {
 
 func registerDeferred(ctx context.Context, userContext *config.UserContext) {
 	c := &Controller{
-		ClusterName:   userContext.ClusterName,
-		ClusterLister: userContext.Management.Management.Clusters("").Controller().Lister(),
-		ClusterClient: userContext.Management.Management.Clusters(""),
-		ClusterStore:  clusterprovisioner.NewPersistentStore(userContext.Management.Core.Namespaces(""), userContext.Management.Core),
-		SecretLister:  userContext.Core.Secrets("").Controller().Lister(),
+		ClusterName:       userContext.ClusterName,
+		ClusterLister:     userContext.Management.Management.Clusters("").Controller().Lister(),
+		ClusterClient:     userContext.Management.Management.Clusters(""),
+		ClusterController: userContext.Management.Management.Clusters("").Controller(),
+		ClusterStore:      clusterprovisioner.NewPersistentStore(userContext.Management.Core.Namespaces(""), userContext.Management.Core),
+		SecretLister:      userContext.Core.Secrets("").Controller().Lister(),
+		Events:            userContext.Management.Core.Events(""),
 	}
 
 	userContext.Management.Management.Clusters("").AddHandler(ctx, "certificate-expiration", c.sync)
@@ -98,10 +102,13 @@ func (c Controller) sync(key string, cluster *v3.Cluster) (runtime.Object, error
 	if !reflect.DeepEqual(cluster.Status.CertificatesExpiration, certsExpInfo) {
 		toUpdate := cluster.DeepCopy()
 		toUpdate.Status.CertificatesExpiration = certsExpInfo
-		return c.ClusterClient.Update(toUpdate)
+		updated, err := c.ClusterClient.Update(toUpdate)
+		if err != nil {
+			return cluster, err
+		}
+		cluster = updated
 	}
-	return cluster, nil
-
+	return c.rotateExpiringCertificates(cluster, certsExpInfo)
 }
 
 func (c Controller) getClusterCertificateBundle(clusterName string) (map[string]pki.CertificatePKI, error) {
@@ -0,0 +1,169 @@
+package certsexpiration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/maintenance"
+	"github.com/rancher/rke/pki"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	rotationReason        = "CertificatesRotated"
+	rotationSkippedReason = "CACertificateExpiring"
+)
+
+// rotateExpiringCertificates triggers the existing certificate rotation path when the cluster's
+// CertificateRotationPolicy is enabled and a certificate is about to expire.
+func (c Controller) rotateExpiringCertificates(cluster *v3.Cluster, certsExpInfo map[string]v32.CertExpiration) (*v3.Cluster, error) {
+	policy := cluster.Spec.CertificateRotationPolicy
+	if policy == nil || !policy.Enabled {
+		return cluster, nil
+	}
+	if cluster.Spec.RancherKubernetesEngineConfig.RotateCertificates != nil {
+		// a rotation is already in progress
+		return cluster, nil
+	}
+
+	now := time.Now().UTC()
+	expiring := expiringCertificates(certsExpInfo, policy.DaysBeforeExpiry, now)
+	if len(expiring) == 0 {
+		return cluster, nil
+	}
+
+	if !policy.CACertificates {
+		if expiring[0] == pki.CACertName && len(expiring) == 1 {
+			// rotating the component certificates will not help, they are still bound to the CA
+			message := fmt.Sprintf("certificate [%s] expires within %d days and the rotation policy does not rotate CA certificates", pki.CACertName, policy.DaysBeforeExpiry)
+			if v32.ClusterConditionCertificatesRotated.GetReason(cluster) == rotationSkippedReason &&
+				v32.ClusterConditionCertificatesRotated.GetMessage(cluster) == message {
+				return cluster, nil
+			}
+			return c.setRotationCondition(cluster, false, rotationSkippedReason, message)
+		}
+		expiring = removeString(expiring, pki.CACertName)
+	}
+
+	if policy.MaintenanceWindow != nil {
+		open, next, err := maintenance.Open(policy.MaintenanceWindow, now)
+		if err != nil {
+			return cluster, err
+		}
+		if !open {
+			logrus.Debugf("[certificate-expiration] cluster [%s] has expiring certificates, waiting %v for the maintenance window", cluster.Name, next)
+			c.ClusterController.EnqueueAfter("", cluster.Name, next)
+			return cluster, nil
+		}
+	}
+
+	toUpdate := cluster.DeepCopy()
+	toUpdate.Spec.RancherKubernetesEngineConfig.RotateCertificates = &rketypes.RotateCertificates{
+		CACertificates: policy.CACertificates,
+	}
+	message := fmt.Sprintf("rotating certificates for all components, expiring within %d days: %s", policy.DaysBeforeExpiry, strings.Join(expiring, ", "))
+	if policy.CACertificates {
+		message = fmt.Sprintf("rotating CA certificates and all components, expiring within %d days: %s", policy.DaysBeforeExpiry, strings.Join(expiring, ", "))
+	}
+	logrus.Infof("[certificate-expiration] cluster [%s]: %s", cluster.Name, message)
+	updated, err := c.setRotationCondition(toUpdate, true, rotationReason, message)
+	if err != nil {
+		return cluster, err
+	}
+	return updated, nil
+}
+
+// setRotationCondition updates the cluster with the CertificatesRotated condition and records a matching event.
+func (c Controller) setRotationCondition(cluster *v3.Cluster, rotated bool, reason, message string) (*v3.Cluster, error) {
+	toUpdate := cluster.DeepCopy()
+	eventType := corev1.EventTypeNormal
+	if rotated {
+		v32.ClusterConditionCertificatesRotated.True(toUpdate)
+	} else {
+		v32.ClusterConditionCertificatesRotated.False(toUpdate)
+		eventType = corev1.EventTypeWarning
+	}
+	v32.ClusterConditionCertificatesRotated.Reason(toUpdate, reason)
+	v32.ClusterConditionCertificatesRotated.Message(toUpdate, message)
+
+	updated, err := c.ClusterClient.Update(toUpdate)
+	if err != nil {
+		return cluster, err
+	}
+	c.recordEvent(updated, eventType, reason, message)
+	return updated, nil
+}
+
+func (c Controller) recordEvent(cluster *v3.Cluster, eventType, reason, message string) {
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: cluster.Name + ".",
+			Namespace:    metav1.NamespaceDefault,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      v3.ClusterGroupVersionKind.GroupVersion().String(),
+			Kind:            v3.ClusterGroupVersionKind.Kind,
+			Name:            cluster.Name,
+			UID:             cluster.UID,
+			ResourceVersion: cluster.ResourceVersion,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Count:          1,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Source: corev1.EventSource{
+			Component: "rancher",
+		},
+	}
+	if _, err := c.Events.Create(event); err != nil {
+		logrus.Warnf("[certificate-expiration] failed to record event for cluster [%s]: %v", cluster.Name, err)
+	}
+}
+
+// expiringCertificates returns the sorted names of the certificates that expire within days of now.
+func expiringCertificates(certsExpInfo map[string]v32.CertExpiration, days int, now time.Time) []string {
+	if days <= 0 {
+		days = 30
+	}
+	deadline := now.AddDate(0, 0, days)
+
+	var expiring []string
+	for name, info := range certsExpInfo {
+		date, err := time.Parse(time.RFC3339, info.ExpirationDate)
+		if err != nil {
+			logrus.Debugf("[certificate-expiration] failed to parse expiration date for certificate [%s]: %v", name, err)
+			continue
+		}
+		if date.Before(deadline) {
+			expiring = append(expiring, name)
+		}
+	}
+	sort.Slice(expiring, func(i, j int) bool {
+		// keep the CA first so it is easy to tell whether it is the only certificate expiring
+		if expiring[i] == pki.CACertName || expiring[j] == pki.CACertName {
+			return expiring[i] == pki.CACertName
+		}
+		return expiring[i] < expiring[j]
+	})
+	return expiring
+}
+
+func removeString(list []string, s string) []string {
+	var result []string
+	for _, item := range list {
+		if item != s {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package certsexpiration
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiringCertificates(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	expires := func(days int) v32.CertExpiration {
+		return v32.CertExpiration{ExpirationDate: now.AddDate(0, 0, days).Format(time.RFC3339)}
+	}
+
+	tests := []struct {
+		name     string
+		certs    map[string]v32.CertExpiration
+		days     int
+		expected []string
+	}{
+		{
+			name: "nothing expiring",
+			certs: map[string]v32.CertExpiration{
+				"kube-ca":        expires(3650),
+				"kube-apiserver": expires(365),
+			},
+			days: 30,
+		},
+		{
+			name: "components expiring",
+			certs: map[string]v32.CertExpiration{
+				"kube-ca":        expires(3650),
+				"kube-proxy":     expires(10),
+				"kube-apiserver": expires(20),
+				"kube-node":      expires(40),
+			},
+			days:     30,
+			expected: []string{"kube-apiserver", "kube-proxy"},
+		},
+		{
+			name: "ca sorted first",
+			certs: map[string]v32.CertExpiration{
+				"kube-apiserver": expires(5),
+				"kube-ca":        expires(5),
+			},
+			days:     30,
+			expected: []string{"kube-ca", "kube-apiserver"},
+		},
+		{
+			name: "default days and invalid dates",
+			certs: map[string]v32.CertExpiration{
+				"kube-apiserver": expires(29),
+				"kube-proxy":     {ExpirationDate: "junk"},
+			},
+			expected: []string{"kube-apiserver"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, expiringCertificates(tt.certs, tt.days, now))
+		})
+	}
+}
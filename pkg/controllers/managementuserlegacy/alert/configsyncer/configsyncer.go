@@ -72,6 +72,9 @@ func NewConfigSyncer(ctx context.Context, cluster *config.UserContext, alertMana
 		notifierLister:          cluster.Management.Management.Notifiers(cluster.ClusterName).Controller().Lister(),
 		clusterLister:           cluster.Management.Management.Clusters(metav1.NamespaceAll).Controller().Lister(),
 		projectLister:           cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
+		machineLister:           cluster.Management.Management.Nodes(cluster.ClusterName).Controller().Lister(),
+		maintenanceWindows:      cluster.Management.Management.AlertMaintenanceWindows(cluster.ClusterName),
+		maintenanceWindowLister: cluster.Management.Management.AlertMaintenanceWindows(cluster.ClusterName).Controller().Lister(),
 		clusterName:             cluster.ClusterName,
 		alertManager:            alertManager,
 		operatorCRDManager:      operatorCRDManager,
@@ -89,6 +92,9 @@ type ConfigSyncer struct {
 	notifierLister          v3.NotifierLister
 	clusterLister           v3.ClusterLister
 	projectLister           v3.ProjectLister
+	machineLister           v3.NodeLister
+	maintenanceWindows      v3.AlertMaintenanceWindowInterface
+	maintenanceWindowLister v3.AlertMaintenanceWindowLister
 	clusterName             string
 	alertManager            *manager.AlertManager
 	operatorCRDManager      *manager.PromOperatorCRDManager
@@ -261,6 +267,11 @@ func (d *ConfigSyncer) sync() error {
 		}
 	}
 
+	// silences go through the Alertmanager API, which being unavailable mustn't hold back the config
+	if err := d.syncMaintenanceSilences(); err != nil {
+		return errors.Wrapf(err, "Update maintenance window silences")
+	}
+
 	return nil
 }
 
@@ -373,7 +384,7 @@ func (d *ConfigSyncer) addProjectAlert2Config(config *alertconfig.Config, projec
 
 					groupBy := getProjectAlertGroupBy(alert.Spec)
 
-					if alert.Spec.PodRule != nil || alert.Spec.WorkloadRule != nil || alert.Spec.MetricRule != nil {
+					if alert.Spec.PodRule != nil || alert.Spec.WorkloadRule != nil || alert.Spec.MetricRule != nil || alert.Spec.PersistentVolumeClaimRule != nil {
 						ruleID := common.GetRuleID(groupID, alert.Name)
 						d.addRule(ruleID, r1, alert.Spec.CommonRuleField, groupBy)
 					}
@@ -420,7 +431,7 @@ func (d *ConfigSyncer) addClusterAlert2Config(config *alertconfig.Config, alerts
 					d.appendRoute(r1, r2)
 				}
 
-				if alert.Spec.MetricRule != nil || alert.Spec.SystemServiceRule != nil || alert.Spec.NodeRule != nil {
+				if alert.Spec.MetricRule != nil || alert.Spec.SystemServiceRule != nil || alert.Spec.NodeRule != nil || alert.Spec.CertificateRule != nil {
 					d.addRule(ruleID, r1, alert.Spec.CommonRuleField, groupBy)
 				}
 
@@ -686,6 +697,8 @@ func getClusterAlertGroupBy(spec v32.ClusterAlertRuleSpec) []model.LabelName {
 		return []model.LabelName{"rule_id", "node_name", "alert_type"}
 	} else if spec.MetricRule != nil {
 		return []model.LabelName{"rule_id"}
+	} else if spec.CertificateRule != nil {
+		return []model.LabelName{"rule_id", "secret_namespace", "secret_name"}
 	}
 
 	return nil
@@ -698,6 +711,8 @@ func getProjectAlertGroupBy(spec v32.ProjectAlertRuleSpec) []model.LabelName {
 		return []model.LabelName{"rule_id", "workload_namespace", "workload_name", "workload_kind"}
 	} else if spec.MetricRule != nil {
 		return []model.LabelName{"rule_id"}
+	} else if spec.PersistentVolumeClaimRule != nil {
+		return []model.LabelName{"rule_id", "namespace", "pvc_name", "alert_type"}
 	}
 
 	return nil
@@ -24,6 +24,7 @@
 		{"node alert", nodeRulesMap, nodeGroupBy, defaultTimingField},
 		{"system service alert", systemServiceRulesMap, systemServiceGroupBy, defaultTimingField},
 		{"metric alert", metricRulesMap, metricGroupBy, defaultTimingField},
+		{"certificate alert", certificateRulesMap, certificateGroupBy, defaultTimingField},
 	}
 )
 
@@ -80,6 +81,7 @@ func TestAddClusterAlert2Config(t *testing.T) {
 		{"pod alert", podRulesMap, podGroupBy, defaultTimingField},
 		{"workload alert", workloadRulesMap, workloadGroupBy, defaultTimingField},
 		{"metric alert", projectMetricRulesMap, projectMetricGroupBy, defaultTimingField},
+		{"persistent volume claim alert", pvcRulesMap, pvcGroupBy, defaultTimingField},
 	}
 )
 
@@ -350,6 +352,35 @@ func TestAddProjectAlert2Config(t *testing.T) {
 	metricGroupBy = getClusterAlertGroupBy(metricAlert.Spec)
 )
 
+//certificate
+var (
+	certificateRule = v32.CertificateRule{
+		ExpiryDays: 14,
+	}
+
+	certificateAlert = v3.ClusterAlertRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "certificateRule",
+			Namespace: namespace,
+		},
+		Spec: v32.ClusterAlertRuleSpec{
+			ClusterName:     clusterName,
+			GroupName:       groupID,
+			CommonRuleField: commonRuleField,
+			CertificateRule: &certificateRule,
+		},
+		Status: alertStatus,
+	}
+
+	certificateRulesMap = map[string][]*v3.ClusterAlertRule{
+		groupID: {
+			&certificateAlert,
+		},
+	}
+
+	certificateGroupBy = getClusterAlertGroupBy(certificateAlert.Spec)
+)
+
 //pod
 var (
 	podRule = v32.PodRule{
@@ -448,3 +479,36 @@ func TestAddProjectAlert2Config(t *testing.T) {
 
 	projectMetricGroupBy = getProjectAlertGroupBy(projectMetricAlert.Spec)
 )
+
+//persistent volume claim
+var (
+	pvcRule = v32.PersistentVolumeClaimRule{
+		PersistentVolumeClaimID: "default:data",
+		Condition:               "usage",
+		UsageThreshold:          80,
+	}
+
+	pvcAlert = v3.ProjectAlertRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pvcRule",
+			Namespace: projectName,
+		},
+		Spec: v32.ProjectAlertRuleSpec{
+			ProjectName:               projectID,
+			GroupName:                 groupID,
+			CommonRuleField:           commonRuleField,
+			PersistentVolumeClaimRule: &pvcRule,
+		},
+		Status: alertStatus,
+	}
+
+	pvcRulesMap = map[string]map[string][]*v3.ProjectAlertRule{
+		projectID: {
+			groupID: {
+				&pvcAlert,
+			},
+		},
+	}
+
+	pvcGroupBy = getProjectAlertGroupBy(pvcAlert.Spec)
+)
@@ -0,0 +1,155 @@
+package configsyncer
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/manager"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/maintenance"
+	nodeHelper "github.com/rancher/rancher/pkg/node"
+	"github.com/rancher/rancher/pkg/ref"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MaintenanceWindowSync refreshes the status of the window, requeues it for its next boundary and syncs
+// the silences of all windows of the cluster.
+func (d *ConfigSyncer) MaintenanceWindowSync(key string, window *v3.AlertMaintenanceWindow) (runtime.Object, error) {
+	if window == nil || window.DeletionTimestamp != nil {
+		return nil, d.sync()
+	}
+
+	now := time.Now()
+	start, end, err := windowOccurrence(window, now)
+	if err != nil {
+		return window, err
+	}
+
+	status := v32.AlertMaintenanceWindowStatus{}
+	if !end.IsZero() {
+		status.Active = !now.Before(start) && now.Before(end)
+		status.CurrentStartsAt = start.UTC().Format(time.RFC3339)
+		status.CurrentEndsAt = end.UTC().Format(time.RFC3339)
+		next := end
+		if !status.Active {
+			next = start
+		}
+		d.maintenanceWindows.Controller().EnqueueAfter(window.Namespace, window.Name, next.Sub(now)+time.Second)
+	}
+
+	if !reflect.DeepEqual(window.Status, status) {
+		window = window.DeepCopy()
+		window.Status = status
+		if window, err = d.maintenanceWindows.Update(window); err != nil {
+			return nil, err
+		}
+	}
+
+	return window, d.sync()
+}
+
+func (d *ConfigSyncer) syncMaintenanceSilences() error {
+	windows, err := d.maintenanceWindowLister.List(d.clusterName, labels.NewSelector())
+	if err != nil {
+		return errors.Wrapf(err, "List alert maintenance windows")
+	}
+	machines, err := d.machineLister.List(d.clusterName, labels.NewSelector())
+	if err != nil {
+		return errors.Wrapf(err, "List nodes")
+	}
+
+	silences, err := maintenanceSilences(windows, machines, time.Now())
+	if err != nil {
+		return err
+	}
+	return d.alertManager.SyncMaintenanceSilences(silences)
+}
+
+// maintenanceSilences returns the silences for the ongoing or next occurrence of every window. Windows that
+// are over don't silence anything anymore.
+func maintenanceSilences(windows []*v3.AlertMaintenanceWindow, machines []*v3.Node, now time.Time) ([]manager.MaintenanceSilence, error) {
+	var silences []manager.MaintenanceSilence
+	for _, window := range windows {
+		if window.DeletionTimestamp != nil {
+			continue
+		}
+		start, end, err := windowOccurrence(window, now)
+		if err != nil {
+			return nil, err
+		}
+		if end.IsZero() {
+			continue
+		}
+		matchers, ok := windowMatchers(window, machines)
+		if !ok {
+			continue
+		}
+		silences = append(silences, manager.MaintenanceSilence{
+			WindowID: fmt.Sprintf("%s:%s", window.Namespace, window.Name),
+			Matchers: matchers,
+			StartsAt: start,
+			EndsAt:   end,
+		})
+	}
+	return silences, nil
+}
+
+// windowOccurrence returns the bounds of the ongoing or next occurrence of the window, or zero times if the
+// window is over.
+func windowOccurrence(window *v3.AlertMaintenanceWindow, now time.Time) (time.Time, time.Time, error) {
+	if window.Spec.Recurrence != nil {
+		return maintenance.Occurrence(window.Spec.Recurrence, now)
+	}
+
+	start, err := time.Parse(time.RFC3339, window.Spec.StartsAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid startsAt of maintenance window %s: %v", window.Name, err)
+	}
+	end, err := time.Parse(time.RFC3339, window.Spec.EndsAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid endsAt of maintenance window %s: %v", window.Name, err)
+	}
+	if !now.Before(end) {
+		return time.Time{}, time.Time{}, nil
+	}
+	return start, end, nil
+}
+
+// windowMatchers translates the scope of the window into alertmanager matchers on the labels set by the
+// alert rules. It returns false if the node selector of the window matches no node.
+func windowMatchers(window *v3.AlertMaintenanceWindow, machines []*v3.Node) ([]*model.Matcher, bool) {
+	var matchers []*model.Matcher
+	if window.Spec.GroupName != "" {
+		matchers = append(matchers, &model.Matcher{Name: "group_id", Value: window.Spec.GroupName})
+	}
+	if window.Spec.ProjectName != "" {
+		_, projectName := ref.Parse(window.Spec.ProjectName)
+		matchers = append(matchers, &model.Matcher{Name: "group_id", Value: regexp.QuoteMeta(projectName+":") + ".*", IsRegex: true})
+	}
+	if len(window.Spec.NodeSelector) > 0 {
+		selector := labels.SelectorFromSet(window.Spec.NodeSelector)
+		var names []string
+		for _, machine := range machines {
+			if selector.Matches(labels.Set(machine.Status.NodeLabels)) {
+				names = append(names, regexp.QuoteMeta(nodeHelper.GetNodeName(machine)))
+			}
+		}
+		if len(names) == 0 {
+			return nil, false
+		}
+		sort.Strings(names)
+		matchers = append(matchers, &model.Matcher{Name: "node_name", Value: strings.Join(names, "|"), IsRegex: true})
+	}
+	if len(matchers) == 0 {
+		matchers = append(matchers, &model.Matcher{Name: "group_id", Value: ".+", IsRegex: true})
+	}
+	return matchers, true
+}
@@ -0,0 +1,73 @@
+package configsyncer
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newWindow(name string, spec v32.AlertMaintenanceWindowSpec) *v3.AlertMaintenanceWindow {
+	return &v3.AlertMaintenanceWindow{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c-test"},
+		Spec:       spec,
+	}
+}
+
+func TestMaintenanceSilences(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	machines := []*v3.Node{
+		{
+			Spec:   v32.NodeSpec{RequestedHostname: "worker-1"},
+			Status: v32.NodeStatus{NodeName: "worker-1", NodeLabels: map[string]string{"role": "worker"}},
+		},
+		{
+			Spec:   v32.NodeSpec{RequestedHostname: "etcd-1"},
+			Status: v32.NodeStatus{NodeName: "etcd-1", NodeLabels: map[string]string{"role": "etcd"}},
+		},
+	}
+	windows := []*v3.AlertMaintenanceWindow{
+		newWindow("cluster", v32.AlertMaintenanceWindowSpec{
+			StartsAt: "2021-06-01T11:00:00Z",
+			EndsAt:   "2021-06-01T13:00:00Z",
+		}),
+		newWindow("over", v32.AlertMaintenanceWindowSpec{
+			StartsAt: "2021-05-01T11:00:00Z",
+			EndsAt:   "2021-05-01T13:00:00Z",
+		}),
+		newWindow("project", v32.AlertMaintenanceWindowSpec{
+			ProjectName: "c-test:p-test",
+			Recurrence:  &v32.MaintenanceWindow{CronSchedule: "0 2 * * *", DurationMinutes: 60},
+		}),
+		newWindow("workers", v32.AlertMaintenanceWindowSpec{
+			StartsAt:     "2021-06-01T11:00:00Z",
+			EndsAt:       "2021-06-01T13:00:00Z",
+			NodeSelector: map[string]string{"role": "worker"},
+		}),
+		newWindow("nothing", v32.AlertMaintenanceWindowSpec{
+			StartsAt:     "2021-06-01T11:00:00Z",
+			EndsAt:       "2021-06-01T13:00:00Z",
+			NodeSelector: map[string]string{"role": "control-plane"},
+		}),
+	}
+
+	silences, err := maintenanceSilences(windows, machines, now)
+	require.NoError(t, err)
+	require.Len(t, silences, 3)
+
+	cluster, project, workers := silences[0], silences[1], silences[2]
+	assert.Equal(t, "c-test:cluster", cluster.WindowID)
+	assert.True(t, cluster.Matches(map[string]string{"group_id": "c-test:node-alert"}, now))
+	assert.False(t, cluster.Matches(map[string]string{"group_id": "c-test:node-alert"}, now.Add(2*time.Hour)))
+
+	assert.Equal(t, time.Date(2021, 6, 2, 2, 0, 0, 0, time.Local), project.StartsAt.In(time.Local))
+	assert.True(t, project.Matches(map[string]string{"group_id": "p-test:pod-alert"}, project.StartsAt))
+	assert.False(t, project.Matches(map[string]string{"group_id": "p-other:pod-alert"}, project.StartsAt))
+
+	assert.True(t, workers.Matches(map[string]string{"group_id": "c-test:node-alert", "node_name": "worker-1"}, now))
+	assert.False(t, workers.Matches(map[string]string{"group_id": "c-test:node-alert", "node_name": "etcd-1"}, now))
+}
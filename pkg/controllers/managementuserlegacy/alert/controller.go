@@ -11,6 +11,7 @@
 	"github.com/rancher/norman/controller"
 	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/configsyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/deployer"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/history"
 	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/manager"
 	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/statesyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/watcher"
@@ -89,6 +90,8 @@ func registerDeferred(ctx context.Context, cluster *config.UserContext) {
 	clusterAlertRules.AddClusterScopedHandler(ctx, "cluster-alert-rule-controller", cluster.ClusterName, configSyncer.ClusterRuleSync)
 	projectAlertRules.AddClusterScopedHandler(ctx, "project-alert-rule-controller", cluster.ClusterName, configSyncer.ProjectRuleSync)
 	notifiers.AddClusterScopedHandler(ctx, "notifier-config-syncer", cluster.ClusterName, configSyncer.NotifierSync)
+	maintenanceWindows := cluster.Management.Management.AlertMaintenanceWindows(cluster.ClusterName)
+	maintenanceWindows.AddClusterScopedHandler(ctx, "alert-maintenance-window-controller", cluster.ClusterName, configSyncer.MaintenanceWindowSync)
 
 	cleaner := &alertGroupCleaner{
 		clusterName:        cluster.ClusterName,
@@ -113,6 +116,7 @@ func registerDeferred(ctx context.Context, cluster *config.UserContext) {
 	projects.AddClusterScopedLifecycle(ctx, "project-precan-alert-controller", cluster.ClusterName, projectLifecycle)
 
 	statesyncer.StartStateSyncer(ctx, cluster, alertmanager)
+	history.Register(ctx, cluster)
 
 	i := &initClusterAlerts{
 		clusterAlertGroups:      clusterAlertGroups,
@@ -136,6 +140,8 @@ func registerDeferred(ctx context.Context, cluster *config.UserContext) {
 	watcher.StartWorkloadWatcher(ctx, cluster, alertmanager)
 	watcher.StartNodeWatcher(ctx, cluster, alertmanager)
 	watcher.StartClusterScanWatcher(ctx, cluster, alertmanager)
+	watcher.StartPersistentVolumeClaimWatcher(ctx, cluster, alertmanager)
+	watcher.StartCertificateWatcher(ctx, cluster, alertmanager)
 
 }
 
@@ -33,6 +33,15 @@
 {{- else if eq .CommonLabels.alert_type "workload" -}}
 The workload {{ if .GroupLabels.workload_namespace}}{{.GroupLabels.workload_namespace}}:{{end}}{{.GroupLabels.workload_name}} has available replicas less than {{ .CommonLabels.available_percentage}}%
 
+{{- else if eq .CommonLabels.alert_type "pvcUsage" -}}
+The persistent volume claim {{ if .GroupLabels.namespace}}{{.GroupLabels.namespace}}:{{end}}{{.GroupLabels.pvc_name}} is over {{ .CommonLabels.usage_threshold}}% full
+
+{{- else if eq .CommonLabels.alert_type "pvcPending" -}}
+The persistent volume claim {{ if .GroupLabels.namespace}}{{.GroupLabels.namespace}}:{{end}}{{.GroupLabels.pvc_name}} is not bound after {{ .CommonLabels.pending_seconds}} sec
+
+{{- else if eq .CommonLabels.alert_type "certificateExpiry" -}}
+The certificate in secret {{ if .GroupLabels.secret_namespace}}{{.GroupLabels.secret_namespace}}:{{end}}{{.GroupLabels.secret_name}} expires in less than {{ .CommonLabels.expiry_days}} days
+
 {{- else if eq .CommonLabels.alert_type "metric" -}}
 The metric {{ .CommonLabels.alert_name}} crossed the threshold 
 {{ end -}}
@@ -106,6 +115,19 @@
 Project Name: {{ .Labels.project_name}}
 Available Replicas: {{ .Labels.available_replicas}}
 Desired Replicas: {{ .Labels.desired_replicas}}
+{{- else if eq .Labels.alert_type "pvcUsage" }}
+Project Name: {{ .Labels.project_name}}
+Namespace: {{ .Labels.namespace}}
+Used Bytes: {{ .Labels.used_bytes}}
+Capacity Bytes: {{ .Labels.capacity_bytes}}
+{{- else if eq .Labels.alert_type "pvcPending" }}
+Project Name: {{ .Labels.project_name}}
+Namespace: {{ .Labels.namespace}}
+{{- if .Labels.storage_class }}
+Storage Class: {{ .Labels.storage_class}}{{ end }}
+{{- else if eq .Labels.alert_type "certificateExpiry" }}
+Common Name: {{ .Labels.common_name}}
+Expires At: {{ .Labels.expires_at}}
 {{- else if eq .Labels.alert_type "metric" }}
 {{- if .Labels.namespace }}
 Namespace: {{ .Labels.namespace}}{{ end }}
@@ -185,6 +207,20 @@
 Project Name: {{.Labels.project_name}}<br>
 Available Replicas: {{ .Labels.available_replicas}}<br>
 Desired Replicas: {{ .Labels.desired_replicas}}<br>
+{{- else if eq .Labels.alert_type "pvcUsage" }}
+Project Name: {{.Labels.project_name}}<br>
+Namespace: {{ .Labels.namespace}}<br>
+Used Bytes: {{ .Labels.used_bytes}}<br>
+Capacity Bytes: {{ .Labels.capacity_bytes}}<br>
+{{- else if eq .Labels.alert_type "pvcPending" }}
+Project Name: {{.Labels.project_name}}<br>
+Namespace: {{ .Labels.namespace}}<br>
+{{- if .Labels.storage_class }}
+Storage Class: {{ .Labels.storage_class}}<br>
+{{ end -}}
+{{- else if eq .Labels.alert_type "certificateExpiry" }}
+Common Name: {{ .Labels.common_name}}<br>
+Expires At: {{ .Labels.expires_at}}<br>
 {{- else if eq .Labels.alert_type "metric" }}
 {{- if .Labels.project_name }}
 Project Name: {{.Labels.project_name}}<br>
@@ -0,0 +1,190 @@
+package history
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/types/config"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// ChangedByAnnotation and ChangedToAnnotation are set on an alert rule by the API when a user changes
+	// its state, so that the history entry of the change can be attributed to them.
+	ChangedByAnnotation = "alerting.cattle.io/state-changed-by"
+	ChangedToAnnotation = "alerting.cattle.io/state-changed-to"
+)
+
+type recorder struct {
+	clusterName   string
+	histories     v3.AlertHistoryInterface
+	historyLister v3.AlertHistoryLister
+}
+
+// rule is what the history needs to know about a cluster or project alert rule.
+type rule struct {
+	metav1.ObjectMeta
+	kind        string
+	projectName string
+	groupName   string
+	displayName string
+	severity    string
+	state       string
+}
+
+func Register(ctx context.Context, cluster *config.UserContext) {
+	histories := cluster.Management.Management.AlertHistories("")
+	r := &recorder{
+		clusterName:   cluster.ClusterName,
+		histories:     histories,
+		historyLister: histories.Controller().Lister(),
+	}
+
+	clusterAlertRules := cluster.Management.Management.ClusterAlertRules(cluster.ClusterName)
+	clusterAlertRules.AddClusterScopedHandler(ctx, "cluster-alert-rule-history", cluster.ClusterName, r.clusterRuleSync)
+	projectAlertRules := cluster.Management.Management.ProjectAlertRules("")
+	projectAlertRules.AddClusterScopedHandler(ctx, "project-alert-rule-history", cluster.ClusterName, r.projectRuleSync)
+}
+
+func (r *recorder) clusterRuleSync(key string, alert *v3.ClusterAlertRule) (runtime.Object, error) {
+	if alert == nil || alert.DeletionTimestamp != nil {
+		return alert, nil
+	}
+	return alert, r.record(rule{
+		ObjectMeta:  alert.ObjectMeta,
+		kind:        v3.ClusterAlertRuleGroupVersionKind.Kind,
+		groupName:   alert.Spec.GroupName,
+		displayName: alert.Spec.DisplayName,
+		severity:    alert.Spec.Severity,
+		state:       alert.Status.AlertState,
+	})
+}
+
+func (r *recorder) projectRuleSync(key string, alert *v3.ProjectAlertRule) (runtime.Object, error) {
+	if alert == nil || alert.DeletionTimestamp != nil {
+		return alert, nil
+	}
+	return alert, r.record(rule{
+		ObjectMeta:  alert.ObjectMeta,
+		kind:        v3.ProjectAlertRuleGroupVersionKind.Kind,
+		projectName: alert.Spec.ProjectName,
+		groupName:   alert.Spec.GroupName,
+		displayName: alert.Spec.DisplayName,
+		severity:    alert.Spec.Severity,
+		state:       alert.Status.AlertState,
+	})
+}
+
+func (r *recorder) record(rule rule) error {
+	if rule.state == "" {
+		return nil
+	}
+
+	history, err := r.historyLister.Get(rule.Namespace, rule.Name)
+	if apierrors.IsNotFound(err) {
+		history = &v3.AlertHistory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      rule.Name,
+				Namespace: rule.Namespace,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: v3.ClusterAlertRuleGroupVersionKind.GroupVersion().String(),
+					Kind:       rule.kind,
+					Name:       rule.Name,
+					UID:        rule.UID,
+				}},
+			},
+		}
+	} else if err != nil {
+		return err
+	} else {
+		history = history.DeepCopy()
+	}
+
+	spec := v32.AlertHistorySpec{
+		ClusterName: r.clusterName,
+		ProjectName: rule.projectName,
+		RuleName:    rule.Namespace + ":" + rule.Name,
+		GroupName:   rule.groupName,
+		DisplayName: rule.displayName,
+		Severity:    rule.severity,
+	}
+
+	previousState := ""
+	if n := len(history.Spec.Entries); n > 0 {
+		previousState = history.Spec.Entries[n-1].State
+	}
+	now := time.Now()
+	spec.Entries = history.Spec.Entries
+	if previousState != rule.state {
+		entry := v32.AlertHistoryEntry{
+			Time:          now.UTC().Format(time.RFC3339),
+			State:         rule.state,
+			PreviousState: previousState,
+		}
+		if rule.Annotations[ChangedToAnnotation] == rule.state {
+			entry.ChangedBy = rule.Annotations[ChangedByAnnotation]
+		}
+		spec.Entries = append(spec.Entries, entry)
+	}
+	spec.Entries = prune(spec.Entries, maxEntries(), retention(), now)
+
+	if history.ResourceVersion == "" {
+		history.Spec = spec
+		_, err = r.histories.Create(history)
+		return err
+	}
+	if reflect.DeepEqual(history.Spec, spec) {
+		return nil
+	}
+	history.Spec = spec
+	_, err = r.histories.Update(history)
+	return err
+}
+
+// prune drops the entries older than retention and then the oldest entries beyond maxEntries. The latest
+// entry is always kept since it holds the current state of the rule.
+func prune(entries []v32.AlertHistoryEntry, maxEntries int, retention time.Duration, now time.Time) []v32.AlertHistoryEntry {
+	if len(entries) == 0 {
+		return entries
+	}
+	start := 0
+	if retention > 0 {
+		for start < len(entries)-1 {
+			t, err := time.Parse(time.RFC3339, entries[start].Time)
+			if err == nil && now.Sub(t) <= retention {
+				break
+			}
+			start++
+		}
+	}
+	if maxEntries > 0 && len(entries)-start > maxEntries {
+		start = len(entries) - maxEntries
+	}
+	if start == 0 {
+		return entries
+	}
+	return append([]v32.AlertHistoryEntry(nil), entries[start:]...)
+}
+
+func maxEntries() int {
+	n, err := strconv.Atoi(settings.AlertHistoryMaxEntries.Get())
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func retention() time.Duration {
+	days, err := strconv.Atoi(settings.AlertHistoryRetentionDays.Get())
+	if err != nil {
+		return 0
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
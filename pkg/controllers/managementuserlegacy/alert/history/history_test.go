@@ -0,0 +1,68 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrune(t *testing.T) {
+	now := time.Date(2021, 6, 30, 12, 0, 0, 0, time.UTC)
+	entry := func(daysAgo int, state string) v32.AlertHistoryEntry {
+		return v32.AlertHistoryEntry{Time: now.AddDate(0, 0, -daysAgo).Format(time.RFC3339), State: state}
+	}
+	entries := []v32.AlertHistoryEntry{
+		entry(40, "active"),
+		entry(20, "alerting"),
+		entry(10, "muted"),
+		entry(5, "alerting"),
+		entry(1, "active"),
+	}
+
+	tests := []struct {
+		name       string
+		entries    []v32.AlertHistoryEntry
+		maxEntries int
+		retention  time.Duration
+		want       []v32.AlertHistoryEntry
+	}{
+		{
+			name:    "no limits",
+			entries: entries,
+			want:    entries,
+		},
+		{
+			name:      "retention",
+			entries:   entries,
+			retention: 30 * 24 * time.Hour,
+			want:      entries[1:],
+		},
+		{
+			name:       "max entries",
+			entries:    entries,
+			maxEntries: 2,
+			want:       entries[3:],
+		},
+		{
+			name:       "retention and max entries",
+			entries:    entries,
+			maxEntries: 3,
+			retention:  7 * 24 * time.Hour,
+			want:       entries[3:],
+		},
+		{
+			name:      "latest entry is kept",
+			entries:   entries[:1],
+			retention: 24 * time.Hour,
+			want:      entries[:1],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, prune(tt.entries, tt.maxEntries, tt.retention, now))
+		})
+	}
+}
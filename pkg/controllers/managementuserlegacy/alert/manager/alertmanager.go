@@ -86,6 +86,7 @@ type AlertManager struct {
 	clusterName string
 	client      *http.Client
 	IsDeploy    bool
+	maintenance maintenanceSilences
 }
 
 func NewAlertManager(cluster *config.UserContext) *AlertManager {
@@ -320,6 +321,11 @@ func (m *AlertManager) RemoveSilenceRule(matcherName, matcherValue string) error
 }
 
 func (m *AlertManager) SendAlert(labels map[string]string) error {
+	if m.InMaintenance(labels, time.Now()) {
+		logrus.Debugf("Skip sending alert %s, it is muted by a maintenance window", labels["rule_id"])
+		return nil
+	}
+
 	url, err := m.GetAlertManagerEndpoint()
 	if err != nil {
 		return err
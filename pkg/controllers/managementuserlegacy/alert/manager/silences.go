@@ -0,0 +1,206 @@
+package manager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+// maintenanceSilenceCreator marks the silences managed for maintenance windows, so that they can be told
+// apart from the silences of muted alert rules.
+const maintenanceSilenceCreator = "rancher-maintenance-window"
+
+// MaintenanceSilence is the silence of one occurrence of an alert maintenance window.
+type MaintenanceSilence struct {
+	WindowID string
+	Matchers []*model.Matcher
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// Matches reports whether the silence mutes an alert with the given labels at now.
+func (s *MaintenanceSilence) Matches(labels map[string]string, now time.Time) bool {
+	if now.Before(s.StartsAt) || !now.Before(s.EndsAt) {
+		return false
+	}
+	for _, matcher := range s.Matchers {
+		value := labels[string(matcher.Name)]
+		if !matcher.IsRegex {
+			if value != matcher.Value {
+				return false
+			}
+			continue
+		}
+		re, err := regexp.Compile("^(?:" + matcher.Value + ")$")
+		if err != nil || !re.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
+
+type maintenanceSilences struct {
+	sync.Mutex
+	silences []MaintenanceSilence
+}
+
+// InMaintenance reports whether an alert with the given labels is muted by a maintenance window at now.
+func (m *AlertManager) InMaintenance(labels map[string]string, now time.Time) bool {
+	m.maintenance.Lock()
+	defer m.maintenance.Unlock()
+	for i := range m.maintenance.silences {
+		if m.maintenance.silences[i].Matches(labels, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncMaintenanceSilences makes the silences of alertmanager created for maintenance windows match
+// desired: stale ones are expired and missing ones are created.
+func (m *AlertManager) SyncMaintenanceSilences(desired []MaintenanceSilence) error {
+	m.maintenance.Lock()
+	m.maintenance.silences = desired
+	m.maintenance.Unlock()
+
+	url, err := m.GetAlertManagerEndpoint()
+	if err != nil {
+		return err
+	}
+	existing, err := m.listSilences(url)
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]MaintenanceSilence{}
+	for _, s := range desired {
+		wanted[maintenanceSilenceKey(s.WindowID, s.Matchers, s.EndsAt)] = s
+	}
+
+	for _, s := range existing {
+		if s.CreatedBy != maintenanceSilenceCreator || s.Status.State == SilenceStateExpired {
+			continue
+		}
+		key := maintenanceSilenceKey(s.Comment, toModelMatchers(s.Matchers), s.EndsAt)
+		if _, ok := wanted[key]; ok {
+			delete(wanted, key)
+			continue
+		}
+		if err := m.expireSilence(url, s.ID); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range wanted {
+		if err := m.createSilence(url, &model.Silence{
+			Matchers:  s.Matchers,
+			StartsAt:  s.StartsAt,
+			EndsAt:    s.EndsAt,
+			CreatedAt: time.Now(),
+			CreatedBy: maintenanceSilenceCreator,
+			Comment:   s.WindowID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func maintenanceSilenceKey(windowID string, matchers []*model.Matcher, endsAt time.Time) string {
+	parts := make([]string, 0, len(matchers))
+	for _, matcher := range matchers {
+		op := "="
+		if matcher.IsRegex {
+			op = "=~"
+		}
+		parts = append(parts, fmt.Sprintf("%s%s%q", matcher.Name, op, matcher.Value))
+	}
+	sort.Strings(parts)
+	return fmt.Sprintf("%s/%v/%s", windowID, parts, endsAt.UTC().Format(time.RFC3339))
+}
+
+func toModelMatchers(matchers Matchers) []*model.Matcher {
+	result := make([]*model.Matcher, 0, len(matchers))
+	for _, matcher := range matchers {
+		result = append(result, &model.Matcher{
+			Name:    model.LabelName(matcher.Name),
+			Value:   matcher.Value,
+			IsRegex: matcher.IsRegex,
+		})
+	}
+	return result
+}
+
+func (m *AlertManager) listSilences(url string) ([]*Silence, error) {
+	res := struct {
+		Data   []*Silence `json:"data"`
+		Status string     `json:"status"`
+	}{}
+
+	req, err := http.NewRequest(http.MethodGet, url+"/api/v1/silences", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	if res.Status != "success" {
+		return nil, fmt.Errorf("Failed to list silences")
+	}
+	return res.Data, nil
+}
+
+func (m *AlertManager) createSilence(url string, silence *model.Silence) error {
+	data, err := json.Marshal(silence)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, url+"/api/v1/silences", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	return m.do(req)
+}
+
+func (m *AlertManager) expireSilence(url, id string) error {
+	req, err := http.NewRequest(http.MethodDelete, url+"/api/v1/silence/"+id, nil)
+	if err != nil {
+		return err
+	}
+	return m.do(req)
+}
+
+func (m *AlertManager) do(req *http.Request) error {
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("alertmanager response is %d, body: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
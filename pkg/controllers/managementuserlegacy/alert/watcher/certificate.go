@@ -0,0 +1,128 @@
+package watcher
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/manager"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type CertificateWatcher struct {
+	secretLister           v1.SecretLister
+	alertManager           *manager.AlertManager
+	clusterAlertRuleLister v3.ClusterAlertRuleLister
+	clusterName            string
+	clusterLister          v3.ClusterLister
+}
+
+func StartCertificateWatcher(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
+	w := &CertificateWatcher{
+		secretLister:           cluster.Core.Secrets(metav1.NamespaceAll).Controller().Lister(),
+		alertManager:           manager,
+		clusterAlertRuleLister: cluster.Management.Management.ClusterAlertRules(cluster.ClusterName).Controller().Lister(),
+		clusterName:            cluster.ClusterName,
+		clusterLister:          cluster.Management.Management.Clusters("").Controller().Lister(),
+	}
+
+	go w.watch(ctx, syncInterval)
+}
+
+func (w *CertificateWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		err := w.watchRule()
+		if err != nil {
+			logrus.Infof("Failed to watch certificates, error: %v", err)
+		}
+	}
+}
+
+func (w *CertificateWatcher) watchRule() error {
+	if w.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	clusterAlerts, err := w.clusterAlertRuleLister.List("", labels.NewSelector())
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range clusterAlerts {
+		if alert.Status.AlertState == "inactive" || alert.Spec.CertificateRule == nil {
+			continue
+		}
+
+		selector := labels.SelectorFromSet(alert.Spec.CertificateRule.Selector)
+		secrets, err := w.secretLister.List(alert.Spec.CertificateRule.Namespace, selector)
+		if err != nil {
+			return err
+		}
+
+		for _, secret := range secrets {
+			if secret.Type != corev1.SecretTypeTLS {
+				continue
+			}
+			w.checkExpiry(secret, alert, time.Now())
+		}
+	}
+
+	return nil
+}
+
+func (w *CertificateWatcher) checkExpiry(secret *corev1.Secret, alert *v3.ClusterAlertRule, now time.Time) {
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		logrus.Debugf("Failed to parse certificate of secret %s:%s: %v", secret.Namespace, secret.Name, err)
+		return
+	}
+
+	expiryDays := alert.Spec.CertificateRule.ExpiryDays
+	if !certificateExpiring(cert, expiryDays, now) {
+		return
+	}
+
+	ruleID := common.GetRuleID(alert.Spec.GroupName, alert.Name)
+	clusterDisplayName := common.GetClusterDisplayName(w.clusterName, w.clusterLister)
+
+	data := map[string]string{}
+	data["rule_id"] = ruleID
+	data["group_id"] = alert.Spec.GroupName
+	data["alert_type"] = "certificateExpiry"
+	data["alert_name"] = alert.Spec.DisplayName
+	data["severity"] = alert.Spec.Severity
+	data["cluster_name"] = clusterDisplayName
+	data["secret_namespace"] = secret.Namespace
+	data["secret_name"] = secret.Name
+	data["expiry_days"] = strconv.Itoa(expiryDays)
+	data["expires_at"] = cert.NotAfter.UTC().Format(time.RFC3339)
+	data["common_name"] = cert.Subject.CommonName
+
+	if err := w.alertManager.SendAlert(data); err != nil {
+		logrus.Errorf("Failed to send alert: %v", err)
+	}
+}
+
+// parseCertificate returns the leaf certificate of a PEM encoded chain.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func certificateExpiring(cert *x509.Certificate, expiryDays int, now time.Time) bool {
+	return now.Add(time.Duration(expiryDays) * 24 * time.Hour).After(cert.NotAfter)
+}
@@ -0,0 +1,81 @@
+package watcher
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificateExpiring(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		notAfter   time.Time
+		expiryDays int
+		expected   bool
+	}{
+		{name: "far from expiry", notAfter: now.AddDate(0, 0, 90), expiryDays: 30},
+		{name: "just outside the window", notAfter: now.AddDate(0, 0, 30).Add(time.Second), expiryDays: 30},
+		{name: "inside the window", notAfter: now.AddDate(0, 0, 29), expiryDays: 30, expected: true},
+		{name: "already expired", notAfter: now.AddDate(0, 0, -1), expiryDays: 30, expected: true},
+		{name: "no window and valid", notAfter: now.Add(time.Hour), expiryDays: 0},
+		{name: "no window and expired", notAfter: now.Add(-time.Hour), expiryDays: 0, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert := &x509.Certificate{NotAfter: tt.notAfter}
+			assert.Equal(t, tt.expected, certificateExpiring(cert, tt.expiryDays, now))
+		})
+	}
+}
+
+func TestParseCertificate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	notAfter := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	leaf := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "single certificate", data: leaf},
+		{name: "chain returns the leaf", data: append(append([]byte{}, leaf...), leaf...)},
+		{name: "private key", data: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("key")}), wantErr: true},
+		{name: "not pem", data: []byte("junk"), wantErr: true},
+		{name: "empty", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert, err := parseCertificate(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "example.com", cert.Subject.CommonName)
+			assert.Equal(t, notAfter, cert.NotAfter)
+		})
+	}
+}
@@ -9,6 +9,8 @@
 	"github.com/hashicorp/go-multierror"
 	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/common"
 	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/manager"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/cis"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/sirupsen/logrus"
@@ -27,6 +29,7 @@ type ClusterScanWatcher struct {
 	clusterScanLister      v3.ClusterScanLister
 	clusterAlertRuleLister v3.ClusterAlertRuleLister
 	alertManager           *manager.AlertManager
+	configMaps             v1.ConfigMapInterface
 }
 
 func StartClusterScanWatcher(ctx context.Context, cluster *config.UserContext, alertManager *manager.AlertManager) {
@@ -42,6 +45,7 @@ func StartClusterScanWatcher(ctx context.Context, cluster *config.UserContext, a
 		clusterScanLister:      clusterScanLister,
 		clusterAlertRuleLister: clusterAlertRuleLister,
 		alertManager:           alertManager,
+		configMaps:             cluster.Core.ConfigMaps(v32.DefaultNamespaceForCis),
 	}
 	clusterScans.AddClusterScopedHandler(ctx, "cluster-scan-watcher", cluster.ClusterName, clusterScanWatcher.Sync)
 }
@@ -78,11 +82,17 @@ func (csw *ClusterScanWatcher) Sync(_ string, cs *v3.ClusterScan) (runtime.Objec
 
 	match := false
 	var matchingAlertRules []*v3.ClusterAlertRule
+	var regressions *cis.ReportDiff
 	for _, alertRule := range clusterAlertRules {
 		if alertRule.Status.AlertState == "inactive" || alertRule.Spec.ClusterScanRule == nil {
 			continue
 		}
-		if csw.isAlertRuleMatching(cs, alertRule) {
+		if alertRule.Spec.ClusterScanRule.RegressionsOnly && regressions == nil {
+			if regressions, err = csw.getRegressions(cs); err != nil {
+				return cs, fmt.Errorf("ClusterScanWatcher: Sync: error comparing with previous scan: %v", err)
+			}
+		}
+		if csw.isAlertRuleMatching(cs, alertRule, regressions) {
 			match = true
 			matchingAlertRules = append(matchingAlertRules, alertRule)
 		}
@@ -96,7 +106,7 @@ func (csw *ClusterScanWatcher) Sync(_ string, cs *v3.ClusterScan) (runtime.Objec
 
 	alertSuccessful := true
 	for _, alertRule := range matchingAlertRules {
-		if e := csw.sendAlert(cs, alertRule); e != nil {
+		if e := csw.sendAlert(cs, alertRule, regressions); e != nil {
 			alertSuccessful = false
 			logrus.Errorf("ClusterScanWatcher: Sync: error sending alert: %v", e)
 			err = multierror.Append(err, e)
@@ -109,17 +119,46 @@ func (csw *ClusterScanWatcher) Sync(_ string, cs *v3.ClusterScan) (runtime.Objec
 	return cs, nil
 }
 
-func (csw *ClusterScanWatcher) isAlertRuleMatching(cs *v3.ClusterScan, alertRule *v3.ClusterAlertRule) bool {
+func (csw *ClusterScanWatcher) isAlertRuleMatching(cs *v3.ClusterScan, alertRule *v3.ClusterAlertRule, regressions *cis.ReportDiff) bool {
 	if alertRule.Spec.ClusterScanRule.ScanRunType != cs.Spec.RunType {
 		return false
 	}
 	if alertRule.Spec.ClusterScanRule.FailuresOnly && !(cs.Status.CisScanStatus.Fail > 0) {
 		return false
 	}
+	if alertRule.Spec.ClusterScanRule.RegressionsOnly && (regressions == nil || len(regressions.NewlyFailing) == 0) {
+		return false
+	}
 	return true
 }
 
-func (csw *ClusterScanWatcher) sendAlert(cs *v3.ClusterScan, alertRule *v3.ClusterAlertRule) error {
+// getRegressions compares the report of the scan with the one of the previous scan of the same profile. A
+// first scan has nothing to regress from, it returns an empty diff.
+func (csw *ClusterScanWatcher) getRegressions(cs *v3.ClusterScan) (*cis.ReportDiff, error) {
+	scans, err := csw.clusterScanLister.List(csw.clusterName, labels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+	previous := cis.PreviousScan(scans, cs)
+	if previous == nil {
+		return &cis.ReportDiff{Target: cs.Name}, nil
+	}
+
+	base, err := cis.GetReport(csw.configMaps, previous)
+	if err != nil {
+		return nil, err
+	}
+	target, err := cis.GetReport(csw.configMaps, cs)
+	if err != nil {
+		return nil, err
+	}
+	diff := cis.DiffReports(base, target)
+	diff.Base = previous.Name
+	diff.Target = cs.Name
+	return &diff, nil
+}
+
+func (csw *ClusterScanWatcher) sendAlert(cs *v3.ClusterScan, alertRule *v3.ClusterAlertRule, regressions *cis.ReportDiff) error {
 	ruleID := common.GetRuleID(alertRule.Spec.GroupName, alertRule.Name)
 	clusterDisplayName := common.GetClusterDisplayName(csw.clusterName, csw.clusterLister)
 
@@ -132,6 +171,9 @@ func (csw *ClusterScanWatcher) sendAlert(cs *v3.ClusterScan, alertRule *v3.Clust
 	data["cluster_name"] = clusterDisplayName
 	data["component_name"] = cs.Name
 	data["logs"] = csw.getAlertMessage(cs, alertRule)
+	if alertRule.Spec.ClusterScanRule.RegressionsOnly {
+		data["logs"] = cis.RegressionMessage(*regressions)
+	}
 
 	return csw.alertManager.SendAlert(data)
 }
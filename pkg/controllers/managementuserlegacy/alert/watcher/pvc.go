@@ -0,0 +1,280 @@
+package watcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuserlegacy/alert/manager"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+type PersistentVolumeClaimWatcher struct {
+	pvcLister              v1.PersistentVolumeClaimLister
+	nodeLister             v1.NodeLister
+	k8sClient              kubernetes.Interface
+	alertManager           *manager.AlertManager
+	projectAlertPolicies   v3.ProjectAlertRuleInterface
+	projectAlertRuleLister v3.ProjectAlertRuleLister
+	clusterName            string
+	clusterLister          v3.ClusterLister
+	projectLister          v3.ProjectLister
+	namespaceIndexer       cache.Indexer
+}
+
+// volumeUsage is the usage of a persistent volume claim as reported by the kubelet.
+type volumeUsage struct {
+	UsedBytes     uint64
+	CapacityBytes uint64
+}
+
+// statsSummary is the subset of the kubelet stats summary holding the usage of the mounted claims.
+type statsSummary struct {
+	Pods []struct {
+		Volumes []struct {
+			PVCRef *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef,omitempty"`
+			UsedBytes     *uint64 `json:"usedBytes,omitempty"`
+			CapacityBytes *uint64 `json:"capacityBytes,omitempty"`
+		} `json:"volume,omitempty"`
+	} `json:"pods"`
+}
+
+func StartPersistentVolumeClaimWatcher(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
+	projectAlerts := cluster.Management.Management.ProjectAlertRules("")
+	w := &PersistentVolumeClaimWatcher{
+		pvcLister:              cluster.Core.PersistentVolumeClaims(metav1.NamespaceAll).Controller().Lister(),
+		nodeLister:             cluster.Core.Nodes(metav1.NamespaceAll).Controller().Lister(),
+		k8sClient:              cluster.K8sClient,
+		alertManager:           manager,
+		projectAlertPolicies:   projectAlerts,
+		projectAlertRuleLister: projectAlerts.Controller().Lister(),
+		clusterName:            cluster.ClusterName,
+		clusterLister:          cluster.Management.Management.Clusters("").Controller().Lister(),
+		projectLister:          cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
+		namespaceIndexer:       namespaceIndexer(cluster),
+	}
+
+	go w.watch(ctx, syncInterval)
+}
+
+func (w *PersistentVolumeClaimWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		err := w.watchRule(ctx)
+		if err != nil {
+			logrus.Infof("Failed to watch persistent volume claim, error: %v", err)
+		}
+	}
+}
+
+func (w *PersistentVolumeClaimWatcher) watchRule(ctx context.Context) error {
+	if w.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	projectAlerts, err := w.projectAlertRuleLister.List("", labels.NewSelector())
+	if err != nil {
+		return err
+	}
+
+	var pAlerts []*v3.ProjectAlertRule
+	needUsage := false
+	for _, alert := range projectAlerts {
+		if !controller.ObjectInCluster(w.clusterName, alert) || alert.Status.AlertState == "inactive" || alert.Spec.PersistentVolumeClaimRule == nil {
+			continue
+		}
+		pAlerts = append(pAlerts, alert)
+		if alert.Spec.PersistentVolumeClaimRule.Condition == "usage" {
+			needUsage = true
+		}
+	}
+	if len(pAlerts) == 0 {
+		return nil
+	}
+
+	var usages map[string]volumeUsage
+	if needUsage {
+		usages = w.getVolumeUsages(ctx)
+	}
+
+	for _, alert := range pAlerts {
+		claims, err := w.getClaims(alert)
+		if err != nil {
+			logrus.Warnf("Failed to get persistent volume claims of alert %s: %v", alert.Name, err)
+			continue
+		}
+
+		for _, pvc := range claims {
+			switch alert.Spec.PersistentVolumeClaimRule.Condition {
+			case "usage":
+				if usage, ok := usages[pvc.Namespace+":"+pvc.Name]; ok {
+					w.checkUsage(pvc, usage, alert)
+				}
+			case "pending":
+				w.checkPending(pvc, alert)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *PersistentVolumeClaimWatcher) getClaims(alert *v3.ProjectAlertRule) ([]*corev1.PersistentVolumeClaim, error) {
+	rule := alert.Spec.PersistentVolumeClaimRule
+	if rule.PersistentVolumeClaimID != "" {
+		parts := strings.SplitN(rule.PersistentVolumeClaimID, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid persistent volume claim id %s", rule.PersistentVolumeClaimID)
+		}
+		pvc, err := w.pvcLister.Get(parts[0], parts[1])
+		if kerrors.IsNotFound(err) {
+			return nil, w.projectAlertPolicies.DeleteNamespaced(alert.Namespace, alert.Name, &metav1.DeleteOptions{})
+		} else if err != nil {
+			return nil, err
+		}
+		return []*corev1.PersistentVolumeClaim{pvc}, nil
+	}
+
+	namespaces, err := w.namespaceIndexer.ByIndex(nsByProjectIndex, alert.Spec.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+	selector := labels.SelectorFromSet(rule.Selector)
+	var claims []*corev1.PersistentVolumeClaim
+	for _, n := range namespaces {
+		namespace, _ := n.(*corev1.Namespace)
+		pvcs, err := w.pvcLister.List(namespace.Name, selector)
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, pvcs...)
+	}
+	return claims, nil
+}
+
+// getVolumeUsages collects the usage of the mounted claims from the kubelet of every node. Nodes whose stats
+// can't be read are skipped, their claims are checked again on the next sync.
+func (w *PersistentVolumeClaimWatcher) getVolumeUsages(ctx context.Context) map[string]volumeUsage {
+	usages := map[string]volumeUsage{}
+	nodes, err := w.nodeLister.List("", labels.NewSelector())
+	if err != nil {
+		logrus.Warnf("Failed to list nodes: %v", err)
+		return usages
+	}
+
+	for _, node := range nodes {
+		data, err := w.k8sClient.CoreV1().RESTClient().Get().
+			Resource("nodes").Name(node.Name).SubResource("proxy").Suffix("stats/summary").
+			DoRaw(ctx)
+		if err != nil {
+			logrus.Debugf("Failed to get stats summary of node %s: %v", node.Name, err)
+			continue
+		}
+		if err := parseVolumeUsages(data, usages); err != nil {
+			logrus.Debugf("Failed to parse stats summary of node %s: %v", node.Name, err)
+		}
+	}
+	return usages
+}
+
+func parseVolumeUsages(data []byte, usages map[string]volumeUsage) error {
+	summary := statsSummary{}
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return err
+	}
+	for _, pod := range summary.Pods {
+		for _, volume := range pod.Volumes {
+			if volume.PVCRef == nil || volume.UsedBytes == nil || volume.CapacityBytes == nil || *volume.CapacityBytes == 0 {
+				continue
+			}
+			usages[volume.PVCRef.Namespace+":"+volume.PVCRef.Name] = volumeUsage{
+				UsedBytes:     *volume.UsedBytes,
+				CapacityBytes: *volume.CapacityBytes,
+			}
+		}
+	}
+	return nil
+}
+
+func (w *PersistentVolumeClaimWatcher) checkUsage(pvc *corev1.PersistentVolumeClaim, usage volumeUsage, alert *v3.ProjectAlertRule) {
+	if !usageExceeded(usage, alert.Spec.PersistentVolumeClaimRule.UsageThreshold) {
+		return
+	}
+
+	data := w.alertData(pvc, alert, "pvcUsage")
+	data["usage_threshold"] = strconv.Itoa(alert.Spec.PersistentVolumeClaimRule.UsageThreshold)
+	data["used_bytes"] = strconv.FormatUint(usage.UsedBytes, 10)
+	data["capacity_bytes"] = strconv.FormatUint(usage.CapacityBytes, 10)
+
+	if err := w.alertManager.SendAlert(data); err != nil {
+		logrus.Errorf("Failed to send alert: %v", err)
+	}
+}
+
+func (w *PersistentVolumeClaimWatcher) checkPending(pvc *corev1.PersistentVolumeClaim, alert *v3.ProjectAlertRule) {
+	pendingSeconds := alert.Spec.PersistentVolumeClaimRule.PendingSeconds
+	if !pendingTooLong(pvc, pendingSeconds, time.Now()) {
+		return
+	}
+
+	data := w.alertData(pvc, alert, "pvcPending")
+	data["pending_seconds"] = strconv.Itoa(pendingSeconds)
+
+	if err := w.alertManager.SendAlert(data); err != nil {
+		logrus.Errorf("Failed to send alert: %v", err)
+	}
+}
+
+// usageExceeded returns whether the used share of a claim, in percent, reached the threshold.
+func usageExceeded(usage volumeUsage, threshold int) bool {
+	if usage.CapacityBytes == 0 {
+		return false
+	}
+	return int(usage.UsedBytes*100/usage.CapacityBytes) >= threshold
+}
+
+// pendingTooLong returns whether a claim has been pending for at least pendingSeconds.
+func pendingTooLong(pvc *corev1.PersistentVolumeClaim, pendingSeconds int, now time.Time) bool {
+	if pvc.Status.Phase != corev1.ClaimPending {
+		return false
+	}
+	return now.Sub(pvc.CreationTimestamp.Time) >= time.Duration(pendingSeconds)*time.Second
+}
+
+func (w *PersistentVolumeClaimWatcher) alertData(pvc *corev1.PersistentVolumeClaim, alert *v3.ProjectAlertRule, alertType string) map[string]string {
+	ruleID := common.GetRuleID(alert.Spec.GroupName, alert.Name)
+	clusterDisplayName := common.GetClusterDisplayName(w.clusterName, w.clusterLister)
+	projectDisplayName := common.GetProjectDisplayName(alert.Spec.ProjectName, w.projectLister)
+
+	data := map[string]string{}
+	data["rule_id"] = ruleID
+	data["group_id"] = alert.Spec.GroupName
+	data["alert_type"] = alertType
+	data["alert_name"] = alert.Spec.DisplayName
+	data["severity"] = alert.Spec.Severity
+	data["cluster_name"] = clusterDisplayName
+	data["project_name"] = projectDisplayName
+	data["namespace"] = pvc.Namespace
+	data["pvc_name"] = pvc.Name
+	if pvc.Spec.StorageClassName != nil {
+		data["storage_class"] = *pvc.Spec.StorageClassName
+	}
+	return data
+}
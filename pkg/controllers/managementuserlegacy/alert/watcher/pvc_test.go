@@ -0,0 +1,83 @@
+package watcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUsageExceeded(t *testing.T) {
+	tests := []struct {
+		name      string
+		usage     volumeUsage
+		threshold int
+		expected  bool
+	}{
+		{name: "below threshold", usage: volumeUsage{UsedBytes: 79, CapacityBytes: 100}, threshold: 80},
+		{name: "at threshold", usage: volumeUsage{UsedBytes: 80, CapacityBytes: 100}, threshold: 80, expected: true},
+		{name: "above threshold", usage: volumeUsage{UsedBytes: 95, CapacityBytes: 100}, threshold: 80, expected: true},
+		{name: "rounded down", usage: volumeUsage{UsedBytes: 7999, CapacityBytes: 10000}, threshold: 80},
+		{name: "large volume", usage: volumeUsage{UsedBytes: 9 << 40, CapacityBytes: 10 << 40}, threshold: 90, expected: true},
+		{name: "no capacity", usage: volumeUsage{UsedBytes: 10}, threshold: 80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, usageExceeded(tt.usage, tt.threshold))
+		})
+	}
+}
+
+func TestPendingTooLong(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	claim := func(phase corev1.PersistentVolumeClaimPhase, age time.Duration) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-age))},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		pvc            *corev1.PersistentVolumeClaim
+		pendingSeconds int
+		expected       bool
+	}{
+		{name: "pending for less", pvc: claim(corev1.ClaimPending, 4*time.Minute), pendingSeconds: 300},
+		{name: "pending for exactly", pvc: claim(corev1.ClaimPending, 5*time.Minute), pendingSeconds: 300, expected: true},
+		{name: "pending for longer", pvc: claim(corev1.ClaimPending, time.Hour), pendingSeconds: 300, expected: true},
+		{name: "bound", pvc: claim(corev1.ClaimBound, time.Hour), pendingSeconds: 300},
+		{name: "lost", pvc: claim(corev1.ClaimLost, time.Hour), pendingSeconds: 300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, pendingTooLong(tt.pvc, tt.pendingSeconds, now))
+		})
+	}
+}
+
+func TestParseVolumeUsages(t *testing.T) {
+	data := []byte(`{"pods":[
+		{"volume":[
+			{"name":"data","pvcRef":{"name":"data","namespace":"db"},"usedBytes":80,"capacityBytes":100},
+			{"name":"token","usedBytes":1,"capacityBytes":10},
+			{"name":"empty","pvcRef":{"name":"empty","namespace":"db"},"usedBytes":0,"capacityBytes":0}
+		]},
+		{"volume":[
+			{"name":"logs","pvcRef":{"name":"logs","namespace":"web"},"usedBytes":5}
+		]},
+		{}
+	]}`)
+
+	usages := map[string]volumeUsage{}
+	assert.NoError(t, parseVolumeUsages(data, usages))
+	assert.Equal(t, map[string]volumeUsage{
+		"db:data": {UsedBytes: 80, CapacityBytes: 100},
+	}, usages)
+
+	assert.Error(t, parseVolumeUsages([]byte("not json"), usages))
+}
@@ -67,6 +67,7 @@ type cisScanHandler struct {
 	cisConfigLister              v3.CisConfigLister
 	cisBenchmarkVersionClient    v3.CisBenchmarkVersionInterface
 	cisBenchmarkVersionLister    v3.CisBenchmarkVersionLister
+	cisBenchmarkProfileLister    v3.CisBenchmarkProfileLister
 	podClient                    rcorev1.PodInterface
 	podLister                    rcorev1.PodLister
 	dsClient                     appsv1.DaemonSetInterface
@@ -131,12 +132,18 @@ func (csh *cisScanHandler) Create(cs *v3.ClusterScan) (runtime.Object, error) {
 
 	if !v32.ClusterScanConditionCreated.IsTrue(cs) {
 		logrus.Infof("cisScanHandler: Create: deploying helm chart")
-		currentK8sVersion := cluster.Spec.RancherKubernetesEngineConfig.Version
-		overrideBenchmarkVersion := ""
-		if cs.Spec.ScanConfig.CisScanConfig != nil {
-			overrideBenchmarkVersion = cs.Spec.ScanConfig.CisScanConfig.OverrideBenchmarkVersion
+		benchmarkProfile, err := csh.getBenchmarkProfile(cs)
+		if kerrors.IsNotFound(err) {
+			v32.ClusterScanConditionFailed.True(cs)
+			v32.ClusterScanConditionFailed.Message(cs, fmt.Sprintf("benchmark profile %v not found", cs.Spec.ScanConfig.CisScanConfig.BenchmarkProfile))
+			return cs, nil
+		} else if err != nil {
+			return cs, fmt.Errorf("cisScanHandler: Create: error fetching benchmark profile: %v", err)
 		}
-		bv, bvManaged, err := GetBenchmarkVersionToUse(overrideBenchmarkVersion, currentK8sVersion,
+		scanConfig := EffectiveScanConfig(cs.Spec.ScanConfig.CisScanConfig, benchmarkProfile)
+
+		currentK8sVersion := cluster.Spec.RancherKubernetesEngineConfig.Version
+		bv, bvManaged, err := GetBenchmarkVersionToUse(scanConfig.OverrideBenchmarkVersion, currentK8sVersion,
 			csh.cisConfigLister, csh.cisConfigClient,
 			csh.cisBenchmarkVersionLister, csh.cisBenchmarkVersionClient,
 		)
@@ -151,21 +158,19 @@ func (csh *cisScanHandler) Create(cs *v3.ClusterScan) (runtime.Object, error) {
 			clusterName:              cs.Spec.ClusterID,
 			overrideBenchmarkVersion: bv,
 		}
-		if cs.Spec.ScanConfig.CisScanConfig != nil {
-			if cs.Spec.ScanConfig.CisScanConfig.DebugMaster {
-				appInfo.debugMaster = "true"
-			}
-			if cs.Spec.ScanConfig.CisScanConfig.DebugWorker {
-				appInfo.debugWorker = "true"
-			}
-			if cs.Spec.ScanConfig.CisScanConfig.OverrideSkip != nil {
-				skipOverride = true
-			}
+		if scanConfig.DebugMaster {
+			appInfo.debugMaster = "true"
+		}
+		if scanConfig.DebugWorker {
+			appInfo.debugWorker = "true"
+		}
+		if scanConfig.OverrideSkip != nil {
+			skipOverride = true
 		}
 		if bvManaged {
 			appInfo.notApplicableSkipConfigMapName = getNotApplicableConfigMapName(bv)
-			if cs.Spec.ScanConfig.CisScanConfig.Profile == "" ||
-				cs.Spec.ScanConfig.CisScanConfig.Profile == v32.CisScanProfileTypePermissive {
+			if scanConfig.Profile == "" ||
+				scanConfig.Profile == v32.CisScanProfileTypePermissive {
 				appInfo.defaultSkipConfigMapName = getDefaultSkipConfigMapName(bv)
 			}
 		}
@@ -173,7 +178,7 @@ func (csh *cisScanHandler) Create(cs *v3.ClusterScan) (runtime.Object, error) {
 		var cm *v1.ConfigMap
 		if skipOverride {
 			// create the cm
-			skipDataBytes, err := getOverrideSkipInfoData(cs.Spec.ScanConfig.CisScanConfig.OverrideSkip)
+			skipDataBytes, err := getOverrideSkipInfoData(scanConfig.OverrideSkip)
 			if err != nil {
 				v32.ClusterScanConditionFailed.True(cs)
 				v32.ClusterScanConditionFailed.Message(cs, fmt.Sprintf("error getting overrideSkip: %v", err))
@@ -226,7 +231,7 @@ func (csh *cisScanHandler) Remove(cs *v3.ClusterScan) (runtime.Object, error) {
 	}
 
 	if cs.Spec.ScanConfig.CisScanConfig != nil {
-		if cs.Spec.ScanConfig.CisScanConfig.OverrideSkip != nil {
+		if cs.Spec.ScanConfig.CisScanConfig.OverrideSkip != nil || cs.Spec.ScanConfig.CisScanConfig.BenchmarkProfile != "" {
 			// Delete the configmap
 			err := csh.cmClient.Delete(getOverrideConfigMapName(cs), nil)
 			if err != nil && !kerrors.IsNotFound(err) {
@@ -269,7 +274,7 @@ func (csh *cisScanHandler) Updated(cs *v3.ClusterScan) (runtime.Object, error) {
 		}
 
 		if cs.Spec.ScanConfig.CisScanConfig != nil {
-			if cs.Spec.ScanConfig.CisScanConfig.OverrideSkip != nil {
+			if cs.Spec.ScanConfig.CisScanConfig.OverrideSkip != nil || cs.Spec.ScanConfig.CisScanConfig.BenchmarkProfile != "" {
 				// Delete the configmap
 				err := csh.cmClient.Delete(getOverrideConfigMapName(cs), nil)
 				if err != nil && !kerrors.IsNotFound(err) {
@@ -305,6 +310,14 @@ func (csh *cisScanHandler) Updated(cs *v3.ClusterScan) (runtime.Object, error) {
 			if r == nil {
 				return nil, fmt.Errorf("cisScanHandler: Updated: error: got empty report from configmap %v", cs.Name)
 			}
+			benchmarkProfile, err := csh.getBenchmarkProfile(cs)
+			if err != nil && !kerrors.IsNotFound(err) {
+				return nil, fmt.Errorf("cisScanHandler: Updated: error fetching benchmark profile: %v", err)
+			}
+			var acceptedRisks []v32.CisCheckException
+			if benchmarkProfile != nil {
+				acceptedRisks = AcceptRisks(r, benchmarkProfile.Spec.AcceptedRisks)
+			}
 
 			cisScanStatus := &v32.CisScanStatus{
 				Total:         r.Total,
@@ -312,10 +325,12 @@ func (csh *cisScanHandler) Updated(cs *v3.ClusterScan) (runtime.Object, error) {
 				Fail:          r.Fail,
 				Skip:          r.Skip,
 				NotApplicable: r.NotApplicable,
+				AcceptedRisk:  len(acceptedRisks),
 			}
 
 			cs = cs.DeepCopy()
 			cs.Status.CisScanStatus = cisScanStatus
+			cs.Status.AcceptedRisks = acceptedRisks
 		}
 		v32.ClusterScanConditionCompleted.True(cs)
 		v32.ClusterScanConditionAlerted.Unknown(cs)
@@ -333,6 +348,13 @@ func (csh *cisScanHandler) Updated(cs *v3.ClusterScan) (runtime.Object, error) {
 	return cs, nil
 }
 
+func (csh *cisScanHandler) getBenchmarkProfile(cs *v3.ClusterScan) (*v3.CisBenchmarkProfile, error) {
+	if cs.Spec.ScanConfig.CisScanConfig == nil || cs.Spec.ScanConfig.CisScanConfig.BenchmarkProfile == "" {
+		return nil, nil
+	}
+	return GetBenchmarkProfile(csh.cisBenchmarkProfileLister, cs.Spec.ScanConfig.CisScanConfig.BenchmarkProfile)
+}
+
 func (csh *cisScanHandler) deployApp(appInfo *appInfo) error {
 	appCatalogID, err := csh.getCISBenchmarkCatalogID(appInfo.clusterName)
 	if err != nil {
@@ -0,0 +1,240 @@
+package cis
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rcorev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/security-scan/pkg/kb-summarizer/report"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CheckChange is a check whose state differs between two scans. Before is empty for checks that are not
+// part of the base report.
+type CheckChange struct {
+	ID          string       `json:"id"`
+	Description string       `json:"description"`
+	Before      report.State `json:"before"`
+	After       report.State `json:"after"`
+}
+
+// ReportDiff holds the checks whose outcome changed between a base scan and a later one.
+type ReportDiff struct {
+	Base         string        `json:"base"`
+	Target       string        `json:"target"`
+	NewlyFailing []CheckChange `json:"newlyFailing"`
+	NewlyPassing []CheckChange `json:"newlyPassing"`
+	SkipChanged  []CheckChange `json:"skipChanged"`
+}
+
+// TrendPoint is the summary of one scan in the trend of a cluster.
+type TrendPoint struct {
+	Scan          string `json:"scan"`
+	Time          string `json:"time"`
+	Profile       string `json:"profile"`
+	Total         int    `json:"total"`
+	Pass          int    `json:"pass"`
+	Fail          int    `json:"fail"`
+	Skip          int    `json:"skip"`
+	NotApplicable int    `json:"notApplicable"`
+	FailDelta     int    `json:"failDelta"`
+}
+
+// GetReport reads the report of a completed scan from the cluster the scan ran in. The failed checks accepted
+// as risk by the benchmark profile of the scan are reported as such.
+func GetReport(configMaps rcorev1.ConfigMapInterface, scan *v3.ClusterScan) (*report.Report, error) {
+	cm, err := configMaps.Get(scan.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	r, err := report.Get([]byte(cm.Data[v32.DefaultScanOutputFileName]))
+	if err != nil {
+		return nil, err
+	}
+	AcceptRisks(r, scan.Status.AcceptedRisks)
+	return r, nil
+}
+
+// DiffReports compares the checks of two reports. Checks in a mixed state fail on some of the nodes and
+// are counted as failing.
+func DiffReports(base, target *report.Report) ReportDiff {
+	diff := ReportDiff{}
+	before := checkStates(base)
+	for _, group := range target.Results {
+		for _, check := range group.Checks {
+			change := CheckChange{
+				ID:          check.ID,
+				Description: check.Text,
+				Before:      before[check.ID],
+				After:       check.State,
+			}
+			if change.Before == change.After {
+				continue
+			}
+			switch {
+			case failing(change.After) && !failing(change.Before):
+				diff.NewlyFailing = append(diff.NewlyFailing, change)
+			case change.After == report.Pass && failing(change.Before):
+				diff.NewlyPassing = append(diff.NewlyPassing, change)
+			}
+			if change.Before != "" && (change.Before == report.Skip) != (change.After == report.Skip) {
+				diff.SkipChanged = append(diff.SkipChanged, change)
+			}
+		}
+	}
+	return diff
+}
+
+func checkStates(r *report.Report) map[string]report.State {
+	states := map[string]report.State{}
+	for _, group := range r.Results {
+		for _, check := range group.Checks {
+			states[check.ID] = check.State
+		}
+	}
+	return states
+}
+
+func failing(state report.State) bool {
+	return state == report.Fail || state == report.Mixed
+}
+
+// CompletedScans returns the scans with results, oldest first.
+func CompletedScans(scans []*v3.ClusterScan) []*v3.ClusterScan {
+	var completed []*v3.ClusterScan
+	for _, scan := range scans {
+		if scan.Status.CisScanStatus != nil && v32.ClusterScanConditionCompleted.IsTrue(scan) {
+			completed = append(completed, scan)
+		}
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		if completed[i].CreationTimestamp.Equal(&completed[j].CreationTimestamp) {
+			return completed[i].Name < completed[j].Name
+		}
+		return completed[i].CreationTimestamp.Before(&completed[j].CreationTimestamp)
+	})
+	return completed
+}
+
+// PreviousScan returns the latest completed scan that ran before scan with the same profile, or nil.
+func PreviousScan(scans []*v3.ClusterScan, scan *v3.ClusterScan) *v3.ClusterScan {
+	var previous *v3.ClusterScan
+	for _, s := range CompletedScans(scans) {
+		if s.Name == scan.Name || !s.CreationTimestamp.Before(&scan.CreationTimestamp) {
+			break
+		}
+		if Profile(s) == Profile(scan) {
+			previous = s
+		}
+	}
+	return previous
+}
+
+// FindCompletedScan returns the completed scan named name, or nil.
+func FindCompletedScan(scans []*v3.ClusterScan, name string) *v3.ClusterScan {
+	for _, scan := range CompletedScans(scans) {
+		if scan.Name == name {
+			return scan
+		}
+	}
+	return nil
+}
+
+// Trend summarizes the last limit completed scans, oldest first. The fail delta of a scan is relative to the
+// previous scan of the same profile, scans of different profiles run different checks and aren't comparable.
+func Trend(scans []*v3.ClusterScan, limit int) []TrendPoint {
+	completed := CompletedScans(scans)
+	previousFail := map[string]int{}
+	var points []TrendPoint
+	for _, scan := range completed {
+		status := scan.Status.CisScanStatus
+		point := TrendPoint{
+			Scan:          scan.Name,
+			Time:          scan.CreationTimestamp.UTC().Format(time.RFC3339),
+			Profile:       Profile(scan),
+			Total:         status.Total,
+			Pass:          status.Pass,
+			Fail:          status.Fail,
+			Skip:          status.Skip,
+			NotApplicable: status.NotApplicable,
+		}
+		if fail, ok := previousFail[point.Profile]; ok {
+			point.FailDelta = status.Fail - fail
+		}
+		previousFail[point.Profile] = status.Fail
+		points = append(points, point)
+	}
+	if limit > 0 && len(points) > limit {
+		points = points[len(points)-limit:]
+	}
+	return points
+}
+
+// Profile returns the profile a scan ran with, including the benchmark profile it references so scans
+// with different skipped checks are not compared with each other.
+func Profile(scan *v3.ClusterScan) string {
+	config := scan.Spec.ScanConfig.CisScanConfig
+	if config == nil {
+		return ""
+	}
+	if config.BenchmarkProfile == "" {
+		return string(config.Profile)
+	}
+	return string(config.Profile) + "/" + config.BenchmarkProfile
+}
+
+func WriteDiffCSV(w io.Writer, diff ReportDiff) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"change", "id", "description", "before", "after"}); err != nil {
+		return err
+	}
+	for _, section := range []struct {
+		name    string
+		changes []CheckChange
+	}{
+		{"newlyFailing", diff.NewlyFailing},
+		{"newlyPassing", diff.NewlyPassing},
+		{"skipChanged", diff.SkipChanged},
+	} {
+		for _, c := range section.changes {
+			if err := writer.Write([]string{section.name, c.ID, c.Description, string(c.Before), string(c.After)}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func WriteTrendCSV(w io.Writer, points []TrendPoint) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"scan", "time", "profile", "total", "pass", "fail", "skip", "notApplicable", "failDelta"}); err != nil {
+		return err
+	}
+	for _, p := range points {
+		record := []string{p.Scan, p.Time, p.Profile}
+		for _, n := range []int{p.Total, p.Pass, p.Fail, p.Skip, p.NotApplicable, p.FailDelta} {
+			record = append(record, strconv.Itoa(n))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// RegressionMessage describes the newly failing checks of a diff for alert notifications.
+func RegressionMessage(diff ReportDiff) string {
+	ids := make([]string, 0, len(diff.NewlyFailing))
+	for _, c := range diff.NewlyFailing {
+		ids = append(ids, c.ID)
+	}
+	return fmt.Sprintf("Cluster Scan %s reported %d new failures since %s: %v", diff.Target, len(ids), diff.Base, ids)
+}
@@ -0,0 +1,114 @@
+package cis
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/security-scan/pkg/kb-summarizer/report"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newReport(states map[string]report.State) *report.Report {
+	group := &report.Group{ID: "1"}
+	for _, id := range []string{"1.1", "1.2", "1.3", "1.4", "1.5"} {
+		if state, ok := states[id]; ok {
+			group.Checks = append(group.Checks, &report.Check{ID: id, State: state})
+		}
+	}
+	return &report.Report{Results: []*report.Group{group}}
+}
+
+func TestDiffReports(t *testing.T) {
+	base := newReport(map[string]report.State{
+		"1.1": report.Pass,
+		"1.2": report.Fail,
+		"1.3": report.Skip,
+		"1.4": report.Mixed,
+	})
+	target := newReport(map[string]report.State{
+		"1.1": report.Fail,
+		"1.2": report.Pass,
+		"1.3": report.Pass,
+		"1.4": report.Fail,
+		"1.5": report.Mixed,
+	})
+
+	diff := DiffReports(base, target)
+	assert.Equal(t, []CheckChange{
+		{ID: "1.1", Before: report.Pass, After: report.Fail},
+		{ID: "1.5", After: report.Mixed},
+	}, diff.NewlyFailing)
+	assert.Equal(t, []CheckChange{
+		{ID: "1.2", Before: report.Fail, After: report.Pass},
+	}, diff.NewlyPassing)
+	assert.Equal(t, []CheckChange{
+		{ID: "1.3", Before: report.Skip, After: report.Pass},
+	}, diff.SkipChanged)
+}
+
+func newScan(name string, created time.Time, profile v32.CisScanProfileType, fail int, completed bool) *v3.ClusterScan {
+	scan := &v3.ClusterScan{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c-test", CreationTimestamp: metav1.NewTime(created)},
+		Spec: v32.ClusterScanSpec{
+			ScanConfig: v32.ClusterScanConfig{CisScanConfig: &v32.CisScanConfig{Profile: profile}},
+		},
+	}
+	if completed {
+		scan.Status.CisScanStatus = &v32.CisScanStatus{Total: 10, Fail: fail, Pass: 10 - fail}
+		v32.ClusterScanConditionCompleted.True(scan)
+	}
+	return scan
+}
+
+func TestPreviousScanAndTrend(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	scans := []*v3.ClusterScan{
+		newScan("ss-4", now.Add(4*time.Hour), v32.CisScanProfileTypePermissive, 1, true),
+		newScan("ss-1", now.Add(time.Hour), v32.CisScanProfileTypePermissive, 3, true),
+		newScan("ss-2", now.Add(2*time.Hour), v32.CisScanProfileTypeHardened, 5, true),
+		newScan("ss-3", now.Add(3*time.Hour), v32.CisScanProfileTypePermissive, 0, false),
+	}
+
+	previous := PreviousScan(scans, scans[0])
+	if assert.NotNil(t, previous) {
+		assert.Equal(t, "ss-1", previous.Name)
+	}
+	assert.Nil(t, PreviousScan(scans, scans[1]))
+
+	// ss-2 is the first hardened scan and ss-4 is compared with ss-1, the previous permissive scan
+	points := Trend(scans, 2)
+	if assert.Len(t, points, 2) {
+		assert.Equal(t, "ss-2", points[0].Scan)
+		assert.Equal(t, 0, points[0].FailDelta)
+		assert.Equal(t, "ss-4", points[1].Scan)
+		assert.Equal(t, -2, points[1].FailDelta)
+	}
+
+	assert.Equal(t, "ss-2", FindCompletedScan(scans, "ss-2").Name)
+	assert.Nil(t, FindCompletedScan(scans, "ss-3"))
+	assert.Nil(t, FindCompletedScan(scans, "ss-5"))
+}
+
+func TestPreviousScanWithBenchmarkProfile(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	scans := []*v3.ClusterScan{
+		newScan("ss-3", now.Add(3*time.Hour), v32.CisScanProfileTypePermissive, 1, true),
+		newScan("ss-1", now.Add(time.Hour), v32.CisScanProfileTypePermissive, 3, true),
+		newScan("ss-2", now.Add(2*time.Hour), v32.CisScanProfileTypePermissive, 5, true),
+	}
+	scans[0].Spec.ScanConfig.CisScanConfig.BenchmarkProfile = "cattle-global-data:cbp-1"
+	scans[2].Spec.ScanConfig.CisScanConfig.BenchmarkProfile = "cattle-global-data:cbp-1"
+
+	assert.Equal(t, "permissive/cattle-global-data:cbp-1", Profile(scans[0]))
+	assert.Equal(t, "permissive", Profile(scans[1]))
+
+	// ss-3 is compared with ss-2, the previous scan with the same benchmark profile, not with ss-1
+	previous := PreviousScan(scans, scans[0])
+	if assert.NotNil(t, previous) {
+		assert.Equal(t, "ss-2", previous.Name)
+	}
+	assert.Nil(t, PreviousScan(scans, scans[2]))
+}
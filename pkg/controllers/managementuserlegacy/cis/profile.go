@@ -0,0 +1,77 @@
+package cis
+
+import (
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/security-scan/pkg/kb-summarizer/report"
+)
+
+// AcceptedRisk is the state of the failed checks that a benchmark profile accepts the risk of.
+const AcceptedRisk report.State = "acceptedRisk"
+
+// GetBenchmarkProfile returns the profile referenced by a scan config, by id or by name in the global namespace.
+func GetBenchmarkProfile(lister v3.CisBenchmarkProfileLister, id string) (*v3.CisBenchmarkProfile, error) {
+	ns, name := ref.Parse(id)
+	if ns == "" {
+		ns = namespace.GlobalNamespace
+	}
+	return lister.Get(ns, name)
+}
+
+// EffectiveScanConfig applies a benchmark profile to a scan config: the profile provides the benchmark version
+// unless the scan overrides it, the base profile and additional checks to skip.
+func EffectiveScanConfig(config *v32.CisScanConfig, profile *v3.CisBenchmarkProfile) *v32.CisScanConfig {
+	if config == nil {
+		config = &v32.CisScanConfig{}
+	}
+	config = config.DeepCopy()
+	if profile == nil {
+		return config
+	}
+
+	if config.OverrideBenchmarkVersion == "" {
+		config.OverrideBenchmarkVersion = profile.Spec.BenchmarkVersion
+	}
+	if profile.Spec.BaseProfile != "" {
+		config.Profile = profile.Spec.BaseProfile
+	}
+	skip := map[string]bool{}
+	for _, id := range config.OverrideSkip {
+		skip[id] = true
+	}
+	for _, check := range profile.Spec.SkipChecks {
+		if !skip[check.ID] {
+			skip[check.ID] = true
+			config.OverrideSkip = append(config.OverrideSkip, check.ID)
+		}
+	}
+	return config
+}
+
+// AcceptRisks marks the failed checks of the report covered by the exceptions as accepted risk and updates the
+// counts of the report. It returns the exceptions that applied.
+func AcceptRisks(r *report.Report, exceptions []v32.CisCheckException) []v32.CisCheckException {
+	if len(exceptions) == 0 {
+		return nil
+	}
+	byID := map[string]v32.CisCheckException{}
+	for _, e := range exceptions {
+		byID[e.ID] = e
+	}
+
+	var accepted []v32.CisCheckException
+	for _, group := range r.Results {
+		for _, check := range group.Checks {
+			e, ok := byID[check.ID]
+			if !ok || !failing(check.State) {
+				continue
+			}
+			check.State = AcceptedRisk
+			r.Fail--
+			accepted = append(accepted, e)
+		}
+	}
+	return accepted
+}
@@ -0,0 +1,54 @@
+package cis
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/security-scan/pkg/kb-summarizer/report"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectiveScanConfig(t *testing.T) {
+	profile := &v3.CisBenchmarkProfile{
+		Spec: v32.CisBenchmarkProfileSpec{
+			BenchmarkVersion: "rke-cis-1.5",
+			BaseProfile:      v32.CisScanProfileTypeHardened,
+			SkipChecks: []v32.CisCheckException{
+				{ID: "1.1.1", Justification: "managed by the provider"},
+				{ID: "1.1.2", Justification: "managed by the provider"},
+			},
+		},
+	}
+
+	config := EffectiveScanConfig(nil, nil)
+	assert.Equal(t, &v32.CisScanConfig{}, config)
+
+	config = EffectiveScanConfig(&v32.CisScanConfig{OverrideSkip: []string{"1.1.2", "4.2.6"}}, profile)
+	assert.Equal(t, "rke-cis-1.5", config.OverrideBenchmarkVersion)
+	assert.Equal(t, v32.CisScanProfileTypeHardened, config.Profile)
+	assert.Equal(t, []string{"1.1.2", "4.2.6", "1.1.1"}, config.OverrideSkip)
+
+	config = EffectiveScanConfig(&v32.CisScanConfig{OverrideBenchmarkVersion: "rke-cis-1.4"}, profile)
+	assert.Equal(t, "rke-cis-1.4", config.OverrideBenchmarkVersion)
+}
+
+func TestAcceptRisks(t *testing.T) {
+	r := newReport(map[string]report.State{
+		"1.1": report.Fail,
+		"1.2": report.Mixed,
+		"1.3": report.Pass,
+	})
+	r.Fail = 2
+	exceptions := []v32.CisCheckException{
+		{ID: "1.1", Justification: "compensating control"},
+		{ID: "1.3", Justification: "not failing"},
+		{ID: "1.4", Justification: "not in the report"},
+	}
+
+	accepted := AcceptRisks(r, exceptions)
+	assert.Equal(t, exceptions[:1], accepted)
+	assert.Equal(t, 1, r.Fail)
+	assert.Equal(t, AcceptedRisk, r.Results[0].Checks[0].State)
+	assert.Equal(t, report.Mixed, r.Results[0].Checks[1].State)
+}
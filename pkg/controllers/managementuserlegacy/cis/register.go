@@ -105,6 +105,7 @@ func registerDeferred(ctx context.Context, userContext *config.UserContext) {
 		cisConfigLister:              cisConfigLister,
 		cisBenchmarkVersionClient:    cisBenchmarkVersion,
 		cisBenchmarkVersionLister:    cisBenchmarkVersionLister,
+		cisBenchmarkProfileLister:    userContext.Management.Management.CisBenchmarkProfiles(namespace.GlobalNamespace).Controller().Lister(),
 		podClient:                    podClient,
 		podLister:                    podLister,
 		dsClient:                     dsClient,
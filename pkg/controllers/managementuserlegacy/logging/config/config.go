@@ -41,13 +41,31 @@
 	Kafka           = "kafka"
 	Syslog          = "syslog"
 	FluentForwarder = "fluentforwarder"
+	OTLP            = "otlp"
+	Loki            = "loki"
 	CustomTarget    = "customtarget"
 )
 
+//label source
+const (
+	LabelSourceNamespace = "namespace"
+	LabelSourceProject   = "project"
+	LabelSourceWorkload  = "workload"
+	LabelSourcePod       = "pod"
+	LabelSourceContainer = "container"
+	LabelSourceNode      = "node"
+)
+
 const (
 	GoogleKubernetesEngine = "googleKubernetesEngine"
 )
 
+//rate limit
+const (
+	DefaultRateLimitPeriodSeconds = 60
+	FluentdMetricsPort            = 24231
+)
+
 //ssl
 const (
 	DefaultCertDir = "/fluentd/etc/config/ssl"
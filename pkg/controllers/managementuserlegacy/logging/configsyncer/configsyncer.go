@@ -244,6 +244,14 @@ func GetSSLConfig(target v32.LoggingTargets) (string, string, string) {
 		certificate = target.FluentForwarderConfig.Certificate
 		clientCert = target.FluentForwarderConfig.ClientCert
 		clientKey = target.FluentForwarderConfig.ClientKey
+	} else if target.OTLPConfig != nil {
+		certificate = target.OTLPConfig.Certificate
+		clientCert = target.OTLPConfig.ClientCert
+		clientKey = target.OTLPConfig.ClientKey
+	} else if target.LokiConfig != nil {
+		certificate = target.LokiConfig.Certificate
+		clientCert = target.LokiConfig.ClientCert
+		clientKey = target.LokiConfig.ClientKey
 	} else if target.CustomTargetConfig != nil {
 		certificate = target.CustomTargetConfig.Certificate
 		clientCert = target.CustomTargetConfig.ClientCert
@@ -56,4 +56,5 @@ func registerDeferred(ctx context.Context, cluster *config.UserContext) {
 	namespaces.AddClusterScopedHandler(ctx, "namespace-logging-configsysncer", cluster.ClusterName, configSyncer.NamespaceSync)
 
 	watcher.StartEndpointWatcher(ctx, cluster)
+	watcher.StartDroppedRecordsWatcher(ctx, cluster)
 }
@@ -3,8 +3,10 @@
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
+	mgmtv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
 
 	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuserlegacy/logging/config"
@@ -164,6 +166,7 @@ func rancherLoggingApp(appCreator, systemProjectID, catalogID, driverDir, docker
 	appName := loggingconfig.AppName
 	namepspace := loggingconfig.LoggingNamespace
 	_, systemProjectName := ref.Parse(systemProjectID)
+	fluentdRepository, fluentdTag := splitImage(mgmtv3.ToolsSystemImages.LoggingSystemImages.Fluentd)
 
 	return &projectv3.App{
 		ObjectMeta: metav1.ObjectMeta{
@@ -186,6 +189,11 @@ func rancherLoggingApp(appCreator, systemProjectID, catalogID, driverDir, docker
 				"fluentd.fluentd-linux.cluster.dockerRoot":          dockerRoot,
 				"log-aggregator.log-aggregator-linux.enabled":       "true",
 				"log-aggregator.log-aggregator-linux.flexVolumeDir": driverDir,
+
+				"fluentd.image.repository":               fluentdRepository,
+				"fluentd.image.tag":                      fluentdTag,
+				"fluentd.fluentd-linux.image.repository": fluentdRepository,
+				"fluentd.fluentd-linux.image.tag":        fluentdTag,
 			},
 			Description:     "Rancher Logging for collect logs",
 			ExternalID:      catalogID,
@@ -220,6 +228,13 @@ func loggingTesterApp(appCreator, systemProjectID, catalogID, clusterConfig, pro
 	}
 }
 
+func splitImage(image string) (string, string) {
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:]
+	}
+	return image, "latest"
+}
+
 func updateInRancherLoggingAppWindowsConfig(app *projectv3.App, enableWindows bool) {
 	if app.Spec.Answers == nil {
 		app.Spec.Answers = make(map[string]string)
@@ -55,3 +55,13 @@ func getPods(podLabels map[string]string, phase k8scorev1.PodPhase) func(namespa
 		}, nil
 	}
 }
+
+func TestRancherLoggingAppFluentdImage(t *testing.T) {
+	app := rancherLoggingApp("u-1", "c-1:p-1", "catalog://?catalog=system-library&template=rancher-logging&version=0.2.1", "/var/lib/kubelet", "/var/lib/docker")
+	assert.Equal(t, "rancher/fluentd", app.Spec.Answers["fluentd.fluentd-linux.image.repository"])
+	assert.Equal(t, "v0.1.25", app.Spec.Answers["fluentd.fluentd-linux.image.tag"])
+
+	repository, tag := splitImage("registry.local:5000/rancher/fluentd")
+	assert.Equal(t, "registry.local:5000/rancher/fluentd", repository)
+	assert.Equal(t, "latest", tag)
+}
@@ -29,6 +29,10 @@
 		"fluentd.cluster.dockerRoot",
 		"fluentd.fluentd-linux.cluster.dockerRoot",
 		"fluentd.fluentd-windows.enabled",
+		"fluentd.image.repository",
+		"fluentd.image.tag",
+		"fluentd.fluentd-linux.image.repository",
+		"fluentd.fluentd-linux.image.tag",
 	}
 	windowNodeLabel = labels.Set(map[string]string{"beta.kubernetes.io/os": "windows"}).AsSelector()
 )
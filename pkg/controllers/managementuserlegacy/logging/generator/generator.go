@@ -75,11 +75,12 @@ func GenerateClusterConfig(logging v32.ClusterLoggingSpec, excludeNamespaces, ce
 func GenerateProjectConfig(projectLoggings []*mgmtv3.ProjectLogging, namespaces []*k8scorev1.Namespace, systemProjectID, certDir string) ([]byte, error) {
 	var wl []ProjectLoggingTemplateWrap
 	for _, v := range projectLoggings {
-		var containerSourcePath []string
+		var containerSourcePath, projectNamespaces []string
 		for _, v2 := range namespaces {
 			if nsProjectName, ok := v2.Annotations[project.ProjectIDAnn]; ok && nsProjectName == v.Spec.ProjectName {
 				sourcePathPattern := loggingconfig.GetNamespacePathPattern(v2.Name)
 				containerSourcePath = append(containerSourcePath, sourcePathPattern)
+				projectNamespaces = append(projectNamespaces, v2.Name)
 			}
 		}
 
@@ -88,9 +89,10 @@ func GenerateProjectConfig(projectLoggings []*mgmtv3.ProjectLogging, namespaces
 		}
 
 		sort.Strings(containerSourcePath)
+		sort.Strings(projectNamespaces)
 		containerSourcePaths := strings.Join(containerSourcePath, ",")
 		isSystemProject := v.Spec.ProjectName == systemProjectID
-		wpl, err := newWrapProjectLogging(v.Spec, containerSourcePaths, certDir, isSystemProject)
+		wpl, err := newWrapProjectLogging(v.Spec, containerSourcePaths, projectNamespaces, certDir, isSystemProject)
 		if err != nil {
 			return nil, err
 		}
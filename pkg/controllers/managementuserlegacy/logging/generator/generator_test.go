@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuserlegacy/logging/config"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/project"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGenerateProjectConfigRateLimit(t *testing.T) {
+	projectName := "c-test:p-test"
+	var namespaces []*k8scorev1.Namespace
+	for _, name := range []string{"noisy", "quiet", "default"} {
+		namespaces = append(namespaces, &k8scorev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{project.ProjectIDAnn: projectName},
+			},
+		})
+	}
+	namespaces = append(namespaces, &k8scorev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "other",
+			Annotations: map[string]string{project.ProjectIDAnn: "c-test:p-other"},
+		},
+	})
+
+	projectLogging := &mgmtv3.ProjectLogging{
+		Spec: v32.ProjectLoggingSpec{
+			ProjectName: projectName,
+			LoggingTargets: v32.LoggingTargets{
+				ElasticsearchConfig: &v32.ElasticsearchConfig{
+					Endpoint:    "https://elasticsearch:9200",
+					IndexPrefix: "test",
+				},
+			},
+			RateLimit: &v32.LoggingRateLimit{
+				ProjectLimit:   5000,
+				NamespaceLimit: 1000,
+				NamespaceLimits: map[string]int{
+					"noisy": 100,
+					"quiet": 0,
+					"other": 10,
+				},
+			},
+		},
+	}
+
+	buf, err := GenerateProjectConfig([]*mgmtv3.ProjectLogging{projectLogging}, namespaces, "", loggingconfig.DefaultCertDir)
+	if err != nil {
+		t.Fatalf("generate project config failed, %v", err)
+	}
+
+	config := string(buf)
+	for _, expected := range []string{
+		"<filter c-test:p-test.**_noisy_**>\n  @type throttle\n  group_key kubernetes.namespace_name\n  group_bucket_period_s 60\n  group_bucket_limit 100",
+		"<filter c-test:p-test.**_default_**>\n  @type throttle\n  group_key kubernetes.namespace_name\n  group_bucket_period_s 60\n  group_bucket_limit 1000",
+		"group_key projectID\n  group_bucket_period_s 60\n  group_bucket_limit 5000",
+		"name fluentd_rate_limit_received_records_total",
+		"name fluentd_rate_limit_forwarded_records_total",
+	} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("generated project config doesn't include %s", expected)
+		}
+	}
+
+	for _, unexpected := range []string{"**_quiet_**", "**_other_**"} {
+		if strings.Contains(config, unexpected) {
+			t.Errorf("generated project config shouldn't throttle %s", unexpected)
+		}
+	}
+
+	projectLogging.Spec.RateLimit = nil
+	buf, err = GenerateProjectConfig([]*mgmtv3.ProjectLogging{projectLogging}, namespaces, "", loggingconfig.DefaultCertDir)
+	if err != nil {
+		t.Fatalf("generate project config failed, %v", err)
+	}
+	if strings.Contains(string(buf), "@type throttle") {
+		t.Error("generated project config without rate limit shouldn't include throttle filters")
+	}
+}
+
+func TestValidateRateLimit(t *testing.T) {
+	cases := []struct {
+		name      string
+		rateLimit *v32.LoggingRateLimit
+		valid     bool
+	}{
+		{"nil", nil, true},
+		{"valid", &v32.LoggingRateLimit{PeriodSeconds: 60, ProjectLimit: 10, NamespaceLimits: map[string]int{"default": 5}}, true},
+		{"negative period", &v32.LoggingRateLimit{PeriodSeconds: -1}, false},
+		{"negative project limit", &v32.LoggingRateLimit{ProjectLimit: -1}, false},
+		{"negative namespace limit", &v32.LoggingRateLimit{NamespaceLimits: map[string]int{"default": -1}}, false},
+		{"invalid namespace", &v32.LoggingRateLimit{NamespaceLimits: map[string]int{"a_b>": 1}}, false},
+	}
+
+	for _, c := range cases {
+		err := ValidateRateLimit(c.rateLimit)
+		if c.valid && err != nil {
+			t.Errorf("%s: expected valid rate limit, got %v", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: expected invalid rate limit", c.name)
+		}
+	}
+}
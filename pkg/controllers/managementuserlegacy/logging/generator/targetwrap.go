@@ -1,9 +1,11 @@
 package generator
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/url"
+	"sort"
 	"strings"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -15,14 +17,33 @@
 
 type LoggingTargetTemplateWrap struct {
 	CurrentTarget string
+	LabelMappings []LabelMapping
 	ElasticsearchTemplateWrap
 	SplunkTemplateWrap
 	SyslogTemplateWrap
 	KafkaTemplateWrap
 	FluentForwarderTemplateWrap
+	OTLPTemplateWrap
+	LokiTemplateWrap
 	CustomTargetWrap
 }
 
+// LabelMapping is a field added to the records for a label of the target, Expression is the ruby expression
+// resolving its value from the kubernetes metadata of the record.
+type LabelMapping struct {
+	Name       string
+	Expression string
+}
+
+var labelSourceExpressions = map[string]string{
+	loggingconfig.LabelSourceNamespace: `record.dig("kubernetes", "namespace_name")`,
+	loggingconfig.LabelSourceProject:   `record["projectID"] || record.dig("kubernetes", "namespace_annotations", "field_cattle_io/projectId")`,
+	loggingconfig.LabelSourceWorkload:  `record.dig("kubernetes", "pod_name").to_s.sub(/(-[a-z0-9]{8,10})?-[a-z0-9]{5}$/, "")`,
+	loggingconfig.LabelSourcePod:       `record.dig("kubernetes", "pod_name")`,
+	loggingconfig.LabelSourceContainer: `record.dig("kubernetes", "container_name")`,
+	loggingconfig.LabelSourceNode:      `record.dig("kubernetes", "host")`,
+}
+
 type ClusterLoggingTemplateWrap struct {
 	ExcludeNamespace string
 
@@ -51,6 +72,20 @@ type ProjectLoggingTemplateWrap struct {
 	ContainerLogPosFilename string
 	RkeLogTag               string
 	RkeLogPosFilename       string
+	RateLimit               *RateLimitWrap
+}
+
+// RateLimitWrap holds the rate limits of a project logging, NamespaceLimits only lists the namespaces of the
+// project that are throttled.
+type RateLimitWrap struct {
+	PeriodSeconds   int
+	ProjectLimit    int
+	NamespaceLimits []NamespaceRateLimit
+}
+
+type NamespaceRateLimit struct {
+	Namespace string
+	Limit     int
 }
 
 func newWrapClusterLogging(logging v32.ClusterLoggingSpec, excludeNamespace, certDir string) (*ClusterLoggingTemplateWrap, error) {
@@ -88,7 +123,7 @@ func newWrapClusterLogging(logging v32.ClusterLoggingSpec, excludeNamespace, cer
 	}, nil
 }
 
-func newWrapProjectLogging(logging v32.ProjectLoggingSpec, containerSourcePath, certDir string, isSystemProject bool) (*ProjectLoggingTemplateWrap, error) {
+func newWrapProjectLogging(logging v32.ProjectLoggingSpec, containerSourcePath string, namespaces []string, certDir string, isSystemProject bool) (*ProjectLoggingTemplateWrap, error) {
 	wrap, err := NewLoggingTargetTemplateWrap(logging.LoggingTargets)
 	if err != nil {
 		return nil, errors.Wrapf(err, "wrapper logging target failed")
@@ -117,9 +152,41 @@ func newWrapProjectLogging(logging v32.ProjectLoggingSpec, containerSourcePath,
 		ContainerLogPosFilename:   containerLogPosFilename,
 		RkeLogTag:                 "rke-system-project",
 		RkeLogPosFilename:         "fluentd-rke-logging-system-project.pos",
+		RateLimit:                 newRateLimitWrap(logging.RateLimit, namespaces),
 	}, nil
 }
 
+func newRateLimitWrap(rateLimit *v32.LoggingRateLimit, namespaces []string) *RateLimitWrap {
+	if rateLimit == nil {
+		return nil
+	}
+
+	wrap := &RateLimitWrap{
+		PeriodSeconds: rateLimit.PeriodSeconds,
+		ProjectLimit:  rateLimit.ProjectLimit,
+	}
+	if wrap.PeriodSeconds <= 0 {
+		wrap.PeriodSeconds = loggingconfig.DefaultRateLimitPeriodSeconds
+	}
+	for _, ns := range namespaces {
+		limit, ok := rateLimit.NamespaceLimits[ns]
+		if !ok {
+			limit = rateLimit.NamespaceLimit
+		}
+		if limit > 0 {
+			wrap.NamespaceLimits = append(wrap.NamespaceLimits, NamespaceRateLimit{
+				Namespace: ns,
+				Limit:     limit,
+			})
+		}
+	}
+
+	if wrap.ProjectLimit <= 0 && len(wrap.NamespaceLimits) == 0 {
+		return nil
+	}
+	return wrap
+}
+
 type ElasticsearchTemplateWrap struct {
 	v32.ElasticsearchConfig
 	DateFormat string
@@ -160,6 +227,19 @@ type FluentServer struct {
 	v32.FluentServer
 }
 
+type OTLPTemplateWrap struct {
+	v32.OTLPConfig
+	HeadersJSON string
+	Scheme      string
+}
+
+type LokiTemplateWrap struct {
+	v32.LokiConfig
+	LabelNames []string
+	RemoveKeys string
+	Scheme     string
+}
+
 type CustomTargetWrap struct {
 	v32.CustomTargetConfig
 }
@@ -216,6 +296,28 @@ func NewLoggingTargetTemplateWrap(loggingTagets v32.LoggingTargets) (wrapLogging
 		wp.CurrentTarget = loggingconfig.FluentForwarder
 		return wp, nil
 
+	} else if loggingTagets.OTLPConfig != nil {
+
+		wrap, err := newOTLPTemplateWrap(loggingTagets.OTLPConfig)
+		if err != nil {
+			return nil, err
+		}
+		wp.OTLPTemplateWrap = *wrap
+		wp.LabelMappings = newLabelMappings(loggingTagets.OTLPConfig.Labels)
+		wp.CurrentTarget = loggingconfig.OTLP
+		return wp, nil
+
+	} else if loggingTagets.LokiConfig != nil {
+
+		wrap, err := newLokiTemplateWrap(loggingTagets.LokiConfig)
+		if err != nil {
+			return nil, err
+		}
+		wp.LokiTemplateWrap = *wrap
+		wp.LabelMappings = newLabelMappings(loggingTagets.LokiConfig.Labels)
+		wp.CurrentTarget = loggingconfig.Loki
+		return wp, nil
+
 	} else if loggingTagets.CustomTargetConfig != nil {
 
 		wrap := CustomTargetWrap{*loggingTagets.CustomTargetConfig}
@@ -331,6 +433,73 @@ func newFluentForwarderTemplateWrap(fluentForwarderConfig *v32.FluentForwarderCo
 	}, nil
 }
 
+func newOTLPTemplateWrap(otlpConfig *v32.OTLPConfig) (*OTLPTemplateWrap, error) {
+	_, s, err := parseEndpoint(otlpConfig.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ValidateLabels(otlpConfig.Labels); err != nil {
+		return nil, err
+	}
+
+	var headers string
+	if len(otlpConfig.Headers) != 0 {
+		values := make(map[string]string, len(otlpConfig.Headers))
+		for _, header := range otlpConfig.Headers {
+			values[header.Name] = header.Value
+		}
+		buf, err := json.Marshal(values)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal otlp headers failed")
+		}
+		headers = string(buf)
+	}
+
+	return &OTLPTemplateWrap{
+		OTLPConfig:  *otlpConfig,
+		HeadersJSON: headers,
+		Scheme:      s,
+	}, nil
+}
+
+func newLokiTemplateWrap(lokiConfig *v32.LokiConfig) (*LokiTemplateWrap, error) {
+	_, s, err := parseEndpoint(lokiConfig.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ValidateLabels(lokiConfig.Labels); err != nil {
+		return nil, err
+	}
+
+	var labelNames []string
+	for _, v := range newLabelMappings(lokiConfig.Labels) {
+		labelNames = append(labelNames, v.Name)
+	}
+
+	return &LokiTemplateWrap{
+		LokiConfig: *lokiConfig,
+		LabelNames: labelNames,
+		RemoveKeys: strings.Join(labelNames, ","),
+		Scheme:     s,
+	}, nil
+}
+
+func newLabelMappings(labels map[string]string) []LabelMapping {
+	var mappings []LabelMapping
+	for name, source := range labels {
+		mappings = append(mappings, LabelMapping{
+			Name:       name,
+			Expression: labelSourceExpressions[source],
+		})
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].Name < mappings[j].Name
+	})
+	return mappings
+}
+
 func parseEndpoint(endpoint string) (host string, scheme string, err error) {
 	u, err := url.ParseRequestURI(endpoint)
 	if err != nil {
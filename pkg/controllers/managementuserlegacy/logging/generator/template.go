@@ -10,6 +10,7 @@
 {{- template "filter-container" . -}}
 {{- template "filter-add-logtype" . -}}
 {{- template "filter-custom-tags" . -}}
+{{- template "filter-label-mapping" . -}}
 {{- template "filter-prometheus" . -}}
 {{- template "filter-exclude-system-component" . -}}
 {{- template "filter-sumo" . -}}
@@ -27,7 +28,9 @@
 {{- template "filter-container" $store -}}
 {{- template "filter-add-projectid" $store -}}
 {{- template "filter-custom-tags" $store -}}
+{{- template "filter-label-mapping" $store -}}
 {{- template "filter-prometheus" $store -}}
+{{- template "filter-rate-limit" $store -}}
 {{- template "filter-sumo" $store -}}
 {{- template "filter-json" $store -}}
 {{- template "match" $store -}}
@@ -31,9 +31,26 @@
 {{define "filter-container"}}
 <filter  {{ .ContainerLogSourceTag }}.**>
   @type  kubernetes_metadata
+  {{- if .LabelMappings }}
+  annotation_match ["^field.cattle.io/projectId$"]
+  {{- end}}
 </filter>
 {{end}}
 
+{{define "filter-label-mapping"}}
+{{- if .LabelMappings }}
+<filter {{ .ContainerLogSourceTag }}.**>
+  @type record_transformer
+  enable_ruby true
+  <record>
+    {{- range $i, $mapping := .LabelMappings }}
+    {{$mapping.Name}} ${ {{- $mapping.Expression -}} }
+    {{- end}}
+  </record>
+</filter>
+{{end}}
+{{end}}
+
 {{define "filter-custom-tags"}}
 {{- if .OutputTags}}
 <filter {{ .ContainerLogSourceTag }}.**>
@@ -77,6 +94,55 @@
 </filter>
 {{end}}
 
+{{define "filter-rate-limit"}}
+{{- if .RateLimit}}
+<filter {{ .ContainerLogSourceTag }}.**>
+  @type prometheus
+  <metric>
+    name fluentd_rate_limit_received_records_total
+    type counter
+    desc The total number of records received by the rate limits of the project
+    <labels>
+      project {{ .ContainerLogSourceTag }}
+      namespace $.kubernetes.namespace_name
+    </labels>
+  </metric>
+</filter>
+{{- range $i, $limit := .RateLimit.NamespaceLimits}}
+<filter {{ $.ContainerLogSourceTag }}.**_{{ $limit.Namespace }}_**>
+  @type throttle
+  group_key kubernetes.namespace_name
+  group_bucket_period_s {{ $.RateLimit.PeriodSeconds }}
+  group_bucket_limit {{ $limit.Limit }}
+  group_reset_rate_s -1
+  group_drop_logs true
+</filter>
+{{- end}}
+{{- if .RateLimit.ProjectLimit}}
+<filter {{ .ContainerLogSourceTag }}.**>
+  @type throttle
+  group_key projectID
+  group_bucket_period_s {{ .RateLimit.PeriodSeconds }}
+  group_bucket_limit {{ .RateLimit.ProjectLimit }}
+  group_reset_rate_s -1
+  group_drop_logs true
+</filter>
+{{- end}}
+<filter {{ .ContainerLogSourceTag }}.**>
+  @type prometheus
+  <metric>
+    name fluentd_rate_limit_forwarded_records_total
+    type counter
+    desc The total number of records forwarded by the rate limits of the project
+    <labels>
+      project {{ .ContainerLogSourceTag }}
+      namespace $.kubernetes.namespace_name
+    </labels>
+  </metric>
+</filter>
+{{end}}
+{{end}}
+
 {{define "filter-sumo"}}
 {{- if eq .CurrentTarget "syslog"}}
 {{- if .SyslogConfig.Token}}
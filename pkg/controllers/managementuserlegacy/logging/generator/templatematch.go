@@ -16,6 +16,8 @@
   {{- template "kafka" . -}}
   {{- template "syslog" . -}}
   {{- template "fluentforwarder" . -}}
+  {{- template "otlp" . -}}
+  {{- template "loki" . -}}
   {{- template "custom" . -}}
   {{- template "buffer" . -}}
   </store>
@@ -220,6 +222,67 @@
 {{end}}
 {{end}}
 
+{{define "otlp"}}
+{{- if eq .CurrentTarget "otlp"}}
+	@type opentelemetry
+	<http>
+	  endpoint {{.OTLPConfig.Endpoint}}
+	  {{- if .OTLPTemplateWrap.HeadersJSON }}
+	  headers {{.OTLPTemplateWrap.HeadersJSON}}
+	  {{- end}}
+	  {{- if .OTLPConfig.Compress }}
+	  compress gzip
+	  {{- end}}
+	</http>
+	{{- if eq .OTLPTemplateWrap.Scheme "https"}}
+	<transport tls>
+	  {{- if .OTLPConfig.Certificate }}
+	  ca_path {{.CertFilePrefix}}_ca.pem
+	  {{- end}}
+	  {{- if and .OTLPConfig.ClientCert .OTLPConfig.ClientKey}}
+	  cert_path {{.CertFilePrefix}}_client-cert.pem
+	  private_key_path {{.CertFilePrefix}}_client-key.pem
+	  {{- end}}
+	  insecure {{not .OTLPConfig.SSLVerify}}
+	</transport>
+	{{end}}
+{{end}}
+{{end}}
+
+{{define "loki"}}
+{{- if eq .CurrentTarget "loki"}}
+	@type loki
+	url {{.LokiConfig.Endpoint}}
+	{{- if .LokiConfig.TenantID }}
+	tenant {{.LokiConfig.TenantID}}
+	{{- end}}
+	{{- if and .LokiConfig.AuthUserName .LokiConfig.AuthPassword}}
+	username {{.LokiConfig.AuthUserName}}
+	password {{.LokiConfig.AuthPassword}}
+	{{- end}}
+	line_format json
+	extract_kubernetes_labels false
+	{{- if .LokiTemplateWrap.LabelNames }}
+	remove_keys {{.LokiTemplateWrap.RemoveKeys}}
+	<label>
+	  {{- range $i, $name := .LokiTemplateWrap.LabelNames }}
+	  {{$name}}
+	  {{- end}}
+	</label>
+	{{- end}}
+	{{- if eq .LokiTemplateWrap.Scheme "https"}}
+	{{- if .LokiConfig.Certificate }}
+	ca_cert {{.CertFilePrefix}}_ca.pem
+	{{- end}}
+	{{- if and .LokiConfig.ClientCert .LokiConfig.ClientKey}}
+	cert {{.CertFilePrefix}}_client-cert.pem
+	key {{.CertFilePrefix}}_client-key.pem
+	{{- end}}
+	insecure_tls {{not .LokiConfig.SSLVerify}}
+	{{end}}
+{{end}}
+{{end}}
+
 {{define "custom"}}
 {{- if eq .CurrentTarget "customtarget"}}
 {{.CustomTargetWrap.Content}} 
@@ -5,8 +5,11 @@
 	"regexp"
 	"strings"
 
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
 	"github.com/pkg/errors"
 	"github.com/vmware/kube-fluentd-operator/config-reloader/fluentd"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 var (
@@ -20,6 +23,28 @@
 		"security": 1,
 		"server":   -1,
 	}
+	lokiType           = "loki"
+	lokiAllowFragments = map[string]int{
+		"buffer": 1,
+		"label":  1,
+	}
+	otlpType           = "opentelemetry"
+	otlpAllowFragments = map[string]int{
+		"buffer":    1,
+		"http":      1,
+		"transport": 1,
+	}
+	labelNameReg       = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	reservedLabelNames = map[string]bool{
+		"docker":     true,
+		"kubernetes": true,
+		"log":        true,
+		"log_type":   true,
+		"projectID":  true,
+		"stream":     true,
+		"tag":        true,
+		"time":       true,
+	}
 )
 
 func ValidateCustomTags(data interface{}) error {
@@ -34,6 +59,52 @@ func ValidateCustomTarget(data interface{}) error {
 	return validateFragments("store-target", "store", data)
 }
 
+// ValidateLabels checks the label mapping of an OTLP or Loki target: label names must be valid stream label
+// names that don't override the fields of the records, and each label must map to a known label source.
+func ValidateLabels(labels map[string]string) error {
+	for name, source := range labels {
+		if !labelNameReg.MatchString(name) {
+			return errors.New("invalid label name " + name + ", label names must match " + labelNameReg.String())
+		}
+
+		if reservedLabelNames[name] {
+			return errors.New("invalid label name " + name + ", the name is reserved for a field of the log records")
+		}
+
+		if _, ok := labelSourceExpressions[source]; !ok {
+			return errors.New("invalid source " + source + " for label " + name + ", expected one of namespace, project, workload, pod, container or node")
+		}
+	}
+	return nil
+}
+
+// ValidateRateLimit checks the rate limits of a project logging: limits can't be negative and the limits of
+// namespaces must be keyed by valid namespace names.
+func ValidateRateLimit(rateLimit *v32.LoggingRateLimit) error {
+	if rateLimit == nil {
+		return nil
+	}
+
+	if rateLimit.PeriodSeconds < 0 {
+		return errors.New("invalid rate limit period, it can't be negative")
+	}
+
+	if rateLimit.ProjectLimit < 0 || rateLimit.NamespaceLimit < 0 {
+		return errors.New("invalid rate limit, limits can't be negative")
+	}
+
+	for ns, limit := range rateLimit.NamespaceLimits {
+		if errs := validation.IsDNS1123Label(ns); len(errs) != 0 {
+			return errors.New("invalid namespace " + ns + " in rate limits: " + strings.Join(errs, ", "))
+		}
+
+		if limit < 0 {
+			return errors.New("invalid rate limit of namespace " + ns + ", limits can't be negative")
+		}
+	}
+	return nil
+}
+
 func validateFragments(templateName, fragmentName string, data interface{}) error {
 	fragments, err := generateFragments(templateName, data)
 	if err != nil {
@@ -51,6 +122,10 @@ func validateFragments(templateName, fragmentName string, data interface{}) erro
 		allow = filterAllowFragments
 	case fluentdForwardType:
 		allow = forwardAllowFragments
+	case lokiType:
+		allow = lokiAllowFragments
+	case otlpType:
+		allow = otlpAllowFragments
 	default:
 		allow = generalAllowFragnent
 	}
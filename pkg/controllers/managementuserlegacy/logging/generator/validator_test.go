@@ -211,3 +211,88 @@ func compareEscapeString(input, expected string) error {
 	}
 	return nil
 }
+
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		caseName       string
+		labels         map[string]string
+		expectedErrMsg string
+	}{
+		{"valid labels", map[string]string{"namespace": "namespace", "app": "workload", "project_id": "project"}, ""},
+		{"invalid label name", map[string]string{"app-name": "workload"}, "invalid label name"},
+		{"label name include fluentd configure element", map[string]string{"</label>": "namespace"}, "invalid label name"},
+		{"reserved label name", map[string]string{"log": "pod"}, "reserved"},
+		{"unknown label source", map[string]string{"app": "deployment"}, "invalid source"},
+	}
+
+	for _, tt := range tests {
+		var actualErrMsg string
+		if err := ValidateLabels(tt.labels); err != nil {
+			actualErrMsg = err.Error()
+		}
+		if tt.expectedErrMsg == "" && actualErrMsg != "" {
+			t.Errorf("test %s failed, expected no error, actual %s", tt.caseName, actualErrMsg)
+			continue
+		}
+		if err := compareErr(actualErrMsg, tt.expectedErrMsg); err != nil {
+			t.Errorf("test %s failed, %v", tt.caseName, err)
+		}
+	}
+}
+
+func TestGenerateLabelTargets(t *testing.T) {
+	labels := map[string]string{
+		"namespace": "namespace",
+		"project":   "project",
+		"workload":  "workload",
+	}
+	targets := map[string]v32.LoggingTargets{
+		loggingconfig.OTLP: {
+			OTLPConfig: &v32.OTLPConfig{
+				Endpoint:    "https://otel-collector:4318",
+				Headers:     []v32.OTLPHeader{{Name: "Authorization", Value: "Bearer token"}},
+				Labels:      labels,
+				Compress:    true,
+				Certificate: "ca",
+			},
+		},
+		loggingconfig.Loki: {
+			LokiConfig: &v32.LokiConfig{
+				Endpoint:     "https://loki:3100",
+				TenantID:     "tenant",
+				AuthUserName: "user",
+				AuthPassword: "password",
+				Labels:       labels,
+			},
+		},
+	}
+
+	for name, target := range targets {
+		spec := v32.ClusterLoggingSpec{
+			LoggingTargets: target,
+			ClusterName:    "c-test",
+		}
+		buf, err := GenerateClusterConfig(spec, "", loggingconfig.DefaultCertDir)
+		if err != nil {
+			t.Errorf("generate %s config failed, %v", name, err)
+			continue
+		}
+
+		config := string(buf)
+		for _, expected := range []string{
+			`annotation_match ["^field.cattle.io/projectId$"]`,
+			`namespace ${record.dig("kubernetes", "namespace_name")}`,
+			`workload ${record.dig("kubernetes", "pod_name")`,
+		} {
+			if !strings.Contains(config, expected) {
+				t.Errorf("generated %s config doesn't include %s", name, expected)
+			}
+		}
+	}
+
+	loki := targets[loggingconfig.Loki]
+	loki.LokiConfig.Labels = map[string]string{"app": "deployment"}
+	if _, err := GenerateClusterConfig(v32.ClusterLoggingSpec{LoggingTargets: loki, ClusterName: "c-test"}, "", loggingconfig.DefaultCertDir); err == nil {
+		t.Error("generate loki config with unknown label source should return error")
+	}
+}
@@ -48,6 +48,26 @@ func (p *PasswordGetter) GetPasswordFromSecret(loggingTarget *v32.LoggingTargets
 		}
 	}
 
+	if loggingTarget.LokiConfig != nil && loggingTarget.LokiConfig.AuthPassword != "" && strings.HasPrefix(loggingTarget.LokiConfig.AuthPassword, passwordSecretPrefix) {
+		if loggingTarget.LokiConfig.AuthPassword, err = passwordutil.GetValueForPasswordField(loggingTarget.LokiConfig.AuthPassword, p.secrets); err != nil {
+			return
+		}
+	}
+
+	if loggingTarget.OTLPConfig != nil && len(loggingTarget.OTLPConfig.Headers) != 0 {
+		var newHeaders []v32.OTLPHeader
+		for _, header := range loggingTarget.OTLPConfig.Headers {
+			newHeader := header
+			if header.Value != "" && strings.HasPrefix(header.Value, passwordSecretPrefix) {
+				if newHeader.Value, err = passwordutil.GetValueForPasswordField(header.Value, p.secrets); err != nil {
+					return
+				}
+			}
+			newHeaders = append(newHeaders, newHeader)
+		}
+		loggingTarget.OTLPConfig.Headers = newHeaders
+	}
+
 	if loggingTarget.FluentForwarderConfig != nil && len(loggingTarget.FluentForwarderConfig.FluentServers) != 0 {
 		var newFluentdServers []v32.FluentServer
 		for _, server := range loggingTarget.FluentForwarderConfig.FluentServers {
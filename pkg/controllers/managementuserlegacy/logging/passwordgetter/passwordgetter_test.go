@@ -20,6 +20,7 @@
 	userName            = "user1"
 	esEndpoint          = "https://localhost:9200"
 	fluentdEndpoint     = "https://localhost:24224"
+	otlpEndpoint        = "https://localhost:4318/v1/logs"
 )
 
 var (
@@ -32,6 +33,7 @@
 }{
 	{in: elasticTarget(passwordWrapValue), out: elasticTarget(passwordSecretValue)},
 	{in: fluentdTarget(passwordWrapValue), out: fluentdTarget(passwordSecretValue)},
+	{in: otlpTarget(passwordWrapValue), out: otlpTarget(passwordSecretValue)},
 }
 
 var (
@@ -96,3 +98,17 @@ func fluentdTarget(password string) v32.LoggingTargets {
 		},
 	}
 }
+
+func otlpTarget(password string) v32.LoggingTargets {
+	return v32.LoggingTargets{
+		OTLPConfig: &v32.OTLPConfig{
+			Endpoint: otlpEndpoint,
+			Headers: []v32.OTLPHeader{
+				{
+					Name:  "Authorization",
+					Value: password,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,361 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuserlegacy/logging/config"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+)
+
+// checks of a logging target report
+const (
+	CheckConnect      = "connect"
+	CheckTLSHandshake = "tlsHandshake"
+	CheckAuth         = "auth"
+	CheckIndex        = "index"
+	CheckTopic        = "topic"
+	CheckSendRecord   = "sendRecord"
+)
+
+// DiagnoseLoggingTarget checks each endpoint of the logging target: it connects to the endpoint, completes the TLS
+// handshake and sends a sample record, reporting rejected credentials and missing indexes or topics on their own.
+func DiagnoseLoggingTarget(ctx context.Context, dial dialer.Dialer, loggingTargets v32.LoggingTargets) []v32.LoggingTargetReport {
+	if loggingTargets.ElasticsearchConfig != nil {
+		return []v32.LoggingTargetReport{diagnoseElasticsearch(ctx, dial, loggingTargets.ElasticsearchConfig)}
+	} else if loggingTargets.SplunkConfig != nil {
+		return []v32.LoggingTargetReport{diagnoseSplunk(ctx, dial, loggingTargets.SplunkConfig)}
+	} else if loggingTargets.SyslogConfig != nil {
+		return []v32.LoggingTargetReport{diagnoseSyslog(ctx, dial, loggingTargets.SyslogConfig)}
+	} else if loggingTargets.KafkaConfig != nil {
+		return diagnoseKafka(ctx, dial, loggingTargets.KafkaConfig)
+	} else if loggingTargets.FluentForwarderConfig != nil {
+		return diagnoseFluentForwarder(ctx, dial, loggingTargets.FluentForwarderConfig)
+	} else if loggingTargets.OTLPConfig != nil {
+		return []v32.LoggingTargetReport{diagnoseOTLP(ctx, dial, loggingTargets.OTLPConfig)}
+	} else if loggingTargets.LokiConfig != nil {
+		return []v32.LoggingTargetReport{diagnoseLoki(ctx, dial, loggingTargets.LokiConfig)}
+	} else if loggingTargets.CustomTargetConfig != nil {
+		r := newTargetReport(loggingconfig.CustomTarget, "")
+		r.skip(CheckSendRecord, "custom targets are only checked by the fluentd dry run")
+		return []v32.LoggingTargetReport{r.LoggingTargetReport}
+	}
+
+	return nil
+}
+
+func diagnoseElasticsearch(ctx context.Context, dial dialer.Dialer, config *v32.ElasticsearchConfig) v32.LoggingTargetReport {
+	r := newTargetReport(loggingconfig.Elasticsearch, config.Endpoint)
+	u, tlsConfig, ok := r.parseEndpoint(config.Endpoint, func(hostname string) (*tls.Config, error) {
+		return buildTLSConfig(config.Certificate, config.ClientCert, config.ClientKey, config.ClientKeyPass, config.SSLVersion, hostname, config.SSLVerify)
+	})
+	if !ok || !r.connect(ctx, dial, u.Host, tlsConfig) {
+		return r.LoggingTargetReport
+	}
+
+	w := &elasticsearchTestWrap{config}
+	index := getIndex(config.DateFormat, config.IndexPrefix)
+	exists, err := w.indexExists(dial, tlsConfig, index)
+	if isHTTPStatus(err, http.StatusUnauthorized, http.StatusForbidden) {
+		r.add(CheckAuth, err)
+		return r.LoggingTargetReport
+	}
+	if config.AuthUserName != "" && err == nil {
+		r.add(CheckAuth, nil)
+	}
+	if err == nil && !exists {
+		r.note(CheckIndex, "index "+index+" doesn't exist yet, it is created by the first record")
+	} else {
+		r.add(CheckIndex, err)
+	}
+
+	r.add(CheckSendRecord, w.TestReachable(ctx, dial, true))
+	return r.LoggingTargetReport
+}
+
+func diagnoseSplunk(ctx context.Context, dial dialer.Dialer, config *v32.SplunkConfig) v32.LoggingTargetReport {
+	r := newTargetReport(loggingconfig.Splunk, config.Endpoint)
+	u, tlsConfig, ok := r.parseEndpoint(config.Endpoint, func(hostname string) (*tls.Config, error) {
+		return buildTLSConfig(config.Certificate, config.ClientCert, config.ClientKey, config.ClientKeyPass, "", hostname, config.SSLVerify)
+	})
+	if !ok || !r.connect(ctx, dial, u.Host, tlsConfig) {
+		return r.LoggingTargetReport
+	}
+
+	err := (&splunkTestWrap{config}).TestReachable(ctx, dial, true)
+	if config.Index != "" && isHTTPStatus(err, http.StatusBadRequest) && strings.Contains(err.Error(), "Incorrect index") {
+		r.add(CheckAuth, nil)
+		r.add(CheckIndex, err)
+		return r.LoggingTargetReport
+	}
+	r.addHTTPSend(err, true)
+	return r.LoggingTargetReport
+}
+
+func diagnoseOTLP(ctx context.Context, dial dialer.Dialer, config *v32.OTLPConfig) v32.LoggingTargetReport {
+	r := newTargetReport(loggingconfig.OTLP, config.Endpoint)
+	u, tlsConfig, ok := r.parseEndpoint(config.Endpoint, func(hostname string) (*tls.Config, error) {
+		return buildTLSConfig(config.Certificate, config.ClientCert, config.ClientKey, "", "", hostname, config.SSLVerify)
+	})
+	if !ok || !r.connect(ctx, dial, u.Host, tlsConfig) {
+		return r.LoggingTargetReport
+	}
+
+	r.addHTTPSend((&otlpTestWrap{config}).TestReachable(ctx, dial, true), len(config.Headers) != 0)
+	return r.LoggingTargetReport
+}
+
+func diagnoseLoki(ctx context.Context, dial dialer.Dialer, config *v32.LokiConfig) v32.LoggingTargetReport {
+	r := newTargetReport(loggingconfig.Loki, config.Endpoint)
+	u, tlsConfig, ok := r.parseEndpoint(config.Endpoint, func(hostname string) (*tls.Config, error) {
+		return buildTLSConfig(config.Certificate, config.ClientCert, config.ClientKey, "", "", hostname, config.SSLVerify)
+	})
+	if !ok || !r.connect(ctx, dial, u.Host, tlsConfig) {
+		return r.LoggingTargetReport
+	}
+
+	r.addHTTPSend((&lokiTestWrap{config}).TestReachable(ctx, dial, true), config.AuthUserName != "")
+	return r.LoggingTargetReport
+}
+
+func diagnoseSyslog(ctx context.Context, dial dialer.Dialer, config *v32.SyslogConfig) v32.LoggingTargetReport {
+	r := newTargetReport(loggingconfig.Syslog, config.Endpoint)
+	if config.Protocol == "udp" {
+		r.skip(CheckConnect, "udp is connectionless, only the sample record is sent")
+	} else {
+		hostname, _, err := net.SplitHostPort(config.Endpoint)
+		if err != nil {
+			r.add(CheckConnect, errors.Wrapf(err, "couldn't parse url %s", config.Endpoint))
+			return r.LoggingTargetReport
+		}
+
+		var tlsConfig *tls.Config
+		if config.EnableTLS {
+			tlsConfig, err = buildTLSConfig(config.Certificate, config.ClientCert, config.ClientKey, "", "", hostname, config.SSLVerify)
+			if err != nil {
+				r.add(CheckTLSHandshake, err)
+				return r.LoggingTargetReport
+			}
+			tlsConfig = withServerName(tlsConfig, hostname)
+		}
+
+		if !r.connect(ctx, dial, config.Endpoint, tlsConfig) {
+			return r.LoggingTargetReport
+		}
+	}
+
+	r.add(CheckSendRecord, (&syslogTestWrap{config}).TestReachable(ctx, dial, true))
+	return r.LoggingTargetReport
+}
+
+func diagnoseKafka(ctx context.Context, dial dialer.Dialer, config *v32.KafkaConfig) []v32.LoggingTargetReport {
+	buildKafkaTLS := func(hostname string) (*tls.Config, error) {
+		return buildTLSConfig(config.Certificate, config.ClientCert, config.ClientKey, "", "", hostname, true)
+	}
+
+	if config.ZookeeperEndpoint != "" {
+		r := newTargetReport(loggingconfig.Kafka, config.ZookeeperEndpoint)
+		if u, tlsConfig, ok := r.parseEndpoint(config.ZookeeperEndpoint, buildKafkaTLS); ok && r.connect(ctx, dial, u.Host, tlsConfig) {
+			r.skip(CheckTopic, "topics aren't checked through zookeeper")
+			r.skip(CheckSendRecord, "records aren't sent through zookeeper")
+		}
+		return []v32.LoggingTargetReport{r.LoggingTargetReport}
+	}
+
+	var reports []v32.LoggingTargetReport
+	for i, endpoint := range config.BrokerEndpoints {
+		r := newTargetReport(loggingconfig.Kafka, endpoint)
+		u, tlsConfig, ok := r.parseEndpoint(endpoint, buildKafkaTLS)
+		if !ok || !r.connect(ctx, dial, u.Host, tlsConfig) || i > 0 {
+			reports = append(reports, r.LoggingTargetReport)
+			continue
+		}
+
+		// the topic and the sample record are checked through the first broker, like the brokers of fluentd
+		if config.SaslUsername != "" && config.SaslPassword != "" {
+			r.skip(CheckAuth, "SASL authentication isn't supported by the tester")
+			r.skip(CheckTopic, "SASL authentication isn't supported by the tester")
+			r.skip(CheckSendRecord, "SASL authentication isn't supported by the tester")
+		} else {
+			w := &kafkaTestWrap{config}
+			exists, err := w.topicExists(ctx, dial, tlsConfig, u.Host)
+			if err == nil && !exists {
+				r.note(CheckTopic, "topic "+config.Topic+" doesn't exist yet, it is created by the first record")
+			} else {
+				r.add(CheckTopic, err)
+			}
+			r.add(CheckSendRecord, w.TestReachable(ctx, dial, true))
+		}
+		reports = append(reports, r.LoggingTargetReport)
+	}
+	return reports
+}
+
+func diagnoseFluentForwarder(ctx context.Context, dial dialer.Dialer, config *v32.FluentForwarderConfig) []v32.LoggingTargetReport {
+	var reports []v32.LoggingTargetReport
+	for _, s := range config.FluentServers {
+		reports = append(reports, diagnoseFluentServer(ctx, dial, config, s))
+	}
+	return reports
+}
+
+func diagnoseFluentServer(ctx context.Context, dial dialer.Dialer, config *v32.FluentForwarderConfig, server v32.FluentServer) v32.LoggingTargetReport {
+	r := newTargetReport(loggingconfig.FluentForwarder, server.Endpoint)
+	host, _, err := net.SplitHostPort(server.Endpoint)
+	if err != nil {
+		r.add(CheckConnect, errors.Wrapf(err, "couldn't parse url %s", server.Endpoint))
+		return r.LoggingTargetReport
+	}
+
+	var tlsConfig *tls.Config
+	if config.EnableTLS {
+		serverName := server.Hostname
+		if serverName == "" {
+			serverName = host
+		}
+		tlsConfig, err = buildTLSConfig(config.Certificate, config.ClientCert, config.ClientKey, config.ClientKeyPass, "", serverName, config.SSLVerify)
+		if err != nil {
+			r.add(CheckTLSHandshake, err)
+			return r.LoggingTargetReport
+		}
+		tlsConfig = withServerName(tlsConfig, serverName)
+	}
+
+	if !r.connect(ctx, dial, server.Endpoint, tlsConfig) {
+		return r.LoggingTargetReport
+	}
+
+	single := *config
+	single.FluentServers = []v32.FluentServer{server}
+	r.add(CheckSendRecord, (&fluentForwarderTestWrap{&single}).TestReachable(ctx, dial, true))
+	return r.LoggingTargetReport
+}
+
+type targetReport struct {
+	v32.LoggingTargetReport
+}
+
+func newTargetReport(target, endpoint string) *targetReport {
+	return &targetReport{
+		LoggingTargetReport: v32.LoggingTargetReport{
+			Target:   target,
+			Endpoint: endpoint,
+			Success:  true,
+		},
+	}
+}
+
+// add records the outcome of a check and returns whether it succeeded.
+func (r *targetReport) add(name string, err error) bool {
+	check := v32.LoggingTargetCheck{
+		Name:    name,
+		Success: err == nil,
+	}
+	if err != nil {
+		check.Message = err.Error()
+		r.Success = false
+	}
+	r.Checks = append(r.Checks, check)
+	return err == nil
+}
+
+func (r *targetReport) note(name, message string) {
+	r.Checks = append(r.Checks, v32.LoggingTargetCheck{
+		Name:    name,
+		Success: true,
+		Message: message,
+	})
+}
+
+func (r *targetReport) skip(name, reason string) {
+	r.Checks = append(r.Checks, v32.LoggingTargetCheck{
+		Name:    name,
+		Success: true,
+		Skipped: true,
+		Message: reason,
+	})
+}
+
+// parseEndpoint parses the url of the endpoint and builds the TLS config used for https endpoints.
+func (r *targetReport) parseEndpoint(endpoint string, buildTLS func(hostname string) (*tls.Config, error)) (*url.URL, *tls.Config, bool) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		r.add(CheckConnect, errors.Wrapf(err, "couldn't parse url %s", endpoint))
+		return nil, nil, false
+	}
+
+	if u.Scheme != "https" {
+		return u, nil, true
+	}
+
+	tlsConfig, err := buildTLS(u.Hostname())
+	if err != nil {
+		r.add(CheckTLSHandshake, err)
+		return nil, nil, false
+	}
+	return u, withServerName(tlsConfig, u.Hostname()), true
+}
+
+// connect opens a connection to the host, and completes the TLS handshake when tlsConfig is set.
+func (r *targetReport) connect(ctx context.Context, dial dialer.Dialer, host string, tlsConfig *tls.Config) bool {
+	conn, err := newTCPConn(ctx, dial, host, nil, false)
+	if !r.add(CheckConnect, err) {
+		return false
+	}
+	conn.Close()
+
+	if tlsConfig == nil {
+		return true
+	}
+
+	conn, err = newTCPConn(ctx, dial, host, tlsConfig, true)
+	if !r.add(CheckTLSHandshake, err) {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// addHTTPSend records the outcome of sending the sample record to an HTTP target, credentials rejected by the
+// target fail the auth check.
+func (r *targetReport) addHTTPSend(err error, hasAuth bool) {
+	if isHTTPStatus(err, http.StatusUnauthorized, http.StatusForbidden) {
+		r.add(CheckAuth, err)
+		return
+	}
+
+	if hasAuth && err == nil {
+		r.add(CheckAuth, nil)
+	}
+	r.add(CheckSendRecord, err)
+}
+
+func isHTTPStatus(err error, statusCodes ...int) bool {
+	respErr, ok := errors.Cause(err).(*httpResponseError)
+	if !ok {
+		return false
+	}
+
+	for _, v := range statusCodes {
+		if respErr.statusCode == v {
+			return true
+		}
+	}
+	return false
+}
+
+// withServerName returns the TLS config used by the handshake of the connection check, buildTLSConfig returns
+// nil when the target doesn't configure any certificate.
+func withServerName(tlsConfig *tls.Config, serverName string) *tls.Config {
+	if tlsConfig != nil {
+		return tlsConfig
+	}
+	return &tls.Config{ServerName: serverName}
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+func TestDiagnoseLoggingTarget(t *testing.T) {
+	dial := (&net.Dialer{}).DialContext
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/loki/api/v1/push":
+			if user, password, _ := r.BasicAuth(); user != "user" || password != "password" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "/services/collector":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"text":"Incorrect index","code":7}`))
+		case "/_bulk":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tests := []struct {
+		caseName string
+		target   v32.LoggingTargets
+		success  bool
+		checks   map[string]bool
+	}{
+		{
+			caseName: "loki",
+			target:   v32.LoggingTargets{LokiConfig: &v32.LokiConfig{Endpoint: server.URL, AuthUserName: "user", AuthPassword: "password"}},
+			success:  true,
+			checks:   map[string]bool{CheckConnect: true, CheckAuth: true, CheckSendRecord: true},
+		},
+		{
+			caseName: "loki rejected credentials",
+			target:   v32.LoggingTargets{LokiConfig: &v32.LokiConfig{Endpoint: server.URL, AuthUserName: "user", AuthPassword: "wrong"}},
+			success:  false,
+			checks:   map[string]bool{CheckConnect: true, CheckAuth: false},
+		},
+		{
+			caseName: "elasticsearch missing index",
+			target:   v32.LoggingTargets{ElasticsearchConfig: &v32.ElasticsearchConfig{Endpoint: server.URL, IndexPrefix: "rancher", DateFormat: "YYYY"}},
+			success:  true,
+			checks:   map[string]bool{CheckConnect: true, CheckIndex: true, CheckSendRecord: true},
+		},
+		{
+			caseName: "splunk incorrect index",
+			target:   v32.LoggingTargets{SplunkConfig: &v32.SplunkConfig{Endpoint: server.URL, Token: "token", Index: "missing"}},
+			success:  false,
+			checks:   map[string]bool{CheckConnect: true, CheckAuth: true, CheckIndex: false},
+		},
+		{
+			caseName: "unreachable endpoint",
+			target:   v32.LoggingTargets{OTLPConfig: &v32.OTLPConfig{Endpoint: "http://127.0.0.1:1"}},
+			success:  false,
+			checks:   map[string]bool{CheckConnect: false},
+		},
+		{
+			caseName: "custom target",
+			target:   v32.LoggingTargets{CustomTargetConfig: &v32.CustomTargetConfig{Content: "@type stdout"}},
+			success:  true,
+			checks:   map[string]bool{CheckSendRecord: true},
+		},
+	}
+
+	for _, tt := range tests {
+		reports := DiagnoseLoggingTarget(context.Background(), dial, tt.target)
+		if len(reports) != 1 {
+			t.Errorf("test %s failed, expected 1 report, actual %d", tt.caseName, len(reports))
+			continue
+		}
+
+		report := reports[0]
+		if report.Success != tt.success {
+			t.Errorf("test %s failed, expected success %v, actual %v: %+v", tt.caseName, tt.success, report.Success, report.Checks)
+		}
+
+		if len(report.Checks) != len(tt.checks) {
+			t.Errorf("test %s failed, expected checks %v, actual %+v", tt.caseName, tt.checks, report.Checks)
+			continue
+		}
+		for _, check := range report.Checks {
+			if expected, ok := tt.checks[check.Name]; !ok || expected != check.Success {
+				t.Errorf("test %s failed, unexpected check %+v", tt.caseName, check)
+			}
+		}
+	}
+}
@@ -63,6 +63,30 @@ func (w *elasticsearchTestWrap) TestReachable(ctx context.Context, dial dialer.D
 	return testReachableHTTP(dial, req, tlsConfig)
 }
 
+// indexExists reports whether the index exists, a missing index is created by the first record.
+func (w *elasticsearchTestWrap) indexExists(dial dialer.Dialer, tlsConfig *tls.Config, index string) (bool, error) {
+	url, err := url.Parse(w.Endpoint)
+	if err != nil {
+		return false, errors.Wrapf(err, "couldn't parse url %s", w.Endpoint)
+	}
+
+	url.Path = path.Join(url.Path, index)
+	req, err := http.NewRequest(http.MethodHead, url.String(), nil)
+	if err != nil {
+		return false, errors.Wrap(err, "create request failed")
+	}
+
+	if w.AuthUserName != "" && w.AuthPassword != "" {
+		req.SetBasicAuth(w.AuthUserName, w.AuthPassword)
+	}
+
+	err = testReachableHTTP(dial, req, tlsConfig)
+	if isHTTPStatus(err, http.StatusNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 func getIndex(dateFormat, prefix string) string {
 	var index string
 	today := time.Now()
@@ -193,6 +193,23 @@ func (w *kafkaTestWrap) checkEndpointReachable(ctx context.Context, endpointURL
 	return nil
 }
 
+// topicExists reads the partitions of the topic from the broker, a missing topic is created by the tester.
+func (w *kafkaTestWrap) topicExists(ctx context.Context, dial dialer.Dialer, tlsConfig *tls.Config, smartHost string) (bool, error) {
+	conn, err := w.kafkaConn(ctx, dial, tlsConfig, smartHost)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ReadPartitions(w.Topic); err != nil {
+		if errors.Cause(err) == kafka.UnknownTopicOrPartition {
+			return false, nil
+		}
+		return false, errors.Wrap(wrapErrEOF(err), "couldn't read kafka partitions")
+	}
+	return true, nil
+}
+
 func (w *kafkaTestWrap) getKafkaPartitionLeader(kafkaConn *kafka.Conn) (*kafka.Broker, error) {
 	partitions, err := kafkaConn.ReadPartitions(w.Topic)
 	if err != nil {
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+)
+
+type lokiTestWrap struct {
+	*v32.LokiConfig
+}
+
+func (w *lokiTestWrap) TestReachable(ctx context.Context, dial dialer.Dialer, includeSendTestLog bool) error {
+	url, err := url.Parse(w.Endpoint)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't parse url %s", w.Endpoint)
+	}
+
+	isTLS := url.Scheme == "https"
+	var tlsConfig *tls.Config
+	if isTLS {
+		tlsConfig, err = buildTLSConfig(w.Certificate, w.ClientCert, w.ClientKey, "", "", url.Hostname(), w.SSLVerify)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !includeSendTestLog {
+		conn, err := newTCPConn(ctx, dial, url.Host, tlsConfig, true)
+		if err != nil {
+			return err
+		}
+		conn.Close()
+		return nil
+	}
+
+	url.Path = path.Join(url.Path, "/loki/api/v1/push")
+	pushTestData := []byte(fmt.Sprintf(`{"streams": [{"stream": {"source": "rancher"}, "values": [["%d", "%s"]]}]}`, time.Now().UnixNano(), testMessage))
+	req, err := http.NewRequest(http.MethodPost, url.String(), bytes.NewReader(pushTestData))
+	if err != nil {
+		return errors.Wrap(err, "create request failed")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if w.TenantID != "" {
+		req.Header.Set("X-Scope-OrgID", w.TenantID)
+	}
+
+	if w.AuthUserName != "" && w.AuthPassword != "" {
+		req.SetBasicAuth(w.AuthUserName, w.AuthPassword)
+	}
+
+	return testReachableHTTP(dial, req, tlsConfig)
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+)
+
+type otlpTestWrap struct {
+	*v32.OTLPConfig
+}
+
+func (w *otlpTestWrap) TestReachable(ctx context.Context, dial dialer.Dialer, includeSendTestLog bool) error {
+	url, err := url.Parse(w.Endpoint)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't parse url %s", w.Endpoint)
+	}
+
+	isTLS := url.Scheme == "https"
+	var tlsConfig *tls.Config
+	if isTLS {
+		tlsConfig, err = buildTLSConfig(w.Certificate, w.ClientCert, w.ClientKey, "", "", url.Hostname(), w.SSLVerify)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !includeSendTestLog {
+		conn, err := newTCPConn(ctx, dial, url.Host, tlsConfig, true)
+		if err != nil {
+			return err
+		}
+		conn.Close()
+		return nil
+	}
+
+	url.Path = path.Join(url.Path, "/v1/logs")
+	logsTestData := []byte(fmt.Sprintf(`{"resourceLogs": [{"scopeLogs": [{"logRecords": [{"timeUnixNano": "%d", "body": {"stringValue": "%s"}}]}]}]}`, time.Now().UnixNano(), testMessage))
+	req, err := http.NewRequest(http.MethodPost, url.String(), bytes.NewReader(logsTestData))
+	if err != nil {
+		return errors.Wrap(err, "create request failed")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	for _, header := range w.Headers {
+		req.Header.Set(header.Name, header.Value)
+	}
+
+	return testReachableHTTP(dial, req, tlsConfig)
+}
@@ -52,6 +52,10 @@ func NewLoggingTargetTestWrap(loggingTargets v32.LoggingTargets) LoggingTargetTe
 		return &kafkaTestWrap{loggingTargets.KafkaConfig}
 	} else if loggingTargets.FluentForwarderConfig != nil {
 		return &fluentForwarderTestWrap{loggingTargets.FluentForwarderConfig}
+	} else if loggingTargets.OTLPConfig != nil {
+		return &otlpTestWrap{loggingTargets.OTLPConfig}
+	} else if loggingTargets.LokiConfig != nil {
+		return &lokiTestWrap{loggingTargets.LokiConfig}
 	} else if loggingTargets.CustomTargetConfig != nil {
 		return &customTargetTestWrap{loggingTargets.CustomTargetConfig}
 	}
@@ -80,12 +84,23 @@ func testReachableHTTP(dial dialer.Dialer, req *http.Request, tlsConfig *tls.Con
 		if err != nil {
 			return errors.Wrapf(err, "couldn't read response body from %s, response code is %v", req.URL.String(), res.StatusCode)
 		}
-		return fmt.Errorf("response code from %s is %v, not include in the 2xx success HTTP status codes, response body: %s", req.URL.String(), res.StatusCode, string(body))
+		return &httpResponseError{url: req.URL.String(), statusCode: res.StatusCode, body: string(body)}
 	}
 
 	return nil
 }
 
+// httpResponseError is returned by testReachableHTTP when the target answers with a non 2xx status code.
+type httpResponseError struct {
+	url        string
+	statusCode int
+	body       string
+}
+
+func (e *httpResponseError) Error() string {
+	return fmt.Sprintf("response code from %s is %v, not include in the 2xx success HTTP status codes, response body: %s", e.url, e.statusCode, e.body)
+}
+
 func writeToUDPConn(data []byte, smartHost string) error {
 	conn, err := net.Dial("udp", smartHost)
 	if err != nil {
@@ -0,0 +1,269 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuserlegacy/logging/config"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	"github.com/rancher/wrangler/pkg/ticker"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	receivedRecordsMetric  = "fluentd_rate_limit_received_records_total"
+	forwardedRecordsMetric = "fluentd_rate_limit_forwarded_records_total"
+)
+
+var droppedRecords = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: "logging",
+		Name:      "rate_limit_dropped_records",
+		Help:      "The number of records dropped by the rate limits of a project logging since fluentd started",
+	},
+	[]string{"cluster", "project", "namespace"},
+)
+
+func init() {
+	prometheus.MustRegister(droppedRecords)
+}
+
+type droppedRecordsWatcher struct {
+	dialerFactory   dialer.Factory
+	clusterName     string
+	k8sClient       kubernetes.Interface
+	projectLoggings mgmtv3.ProjectLoggingInterface
+}
+
+// StartDroppedRecordsWatcher periodically collects the counters of the rate limits from the fluentd pods of the
+// cluster and records the dropped records on the status of the project loggings.
+func StartDroppedRecordsWatcher(ctx context.Context, cluster *config.UserContext) {
+	s := &droppedRecordsWatcher{
+		dialerFactory:   cluster.Management.Dialer,
+		clusterName:     cluster.ClusterName,
+		k8sClient:       cluster.K8sClient,
+		projectLoggings: cluster.Management.Management.ProjectLoggings(metav1.NamespaceAll),
+	}
+	go s.watch(ctx, 60*time.Second)
+}
+
+func (d *droppedRecordsWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		if err := d.sync(ctx); err != nil {
+			logrus.Error(err)
+		}
+	}
+}
+
+func (d *droppedRecordsWatcher) sync(ctx context.Context) error {
+	pls, err := d.projectLoggings.Controller().Lister().List(metav1.NamespaceAll, labels.NewSelector())
+	if err != nil {
+		return errors.Wrapf(err, "list projectlogging fail in dropped records watcher")
+	}
+
+	var limited []*mgmtv3.ProjectLogging
+	for _, v := range pls {
+		clusterName, _ := ref.Parse(v.Spec.ProjectName)
+		if clusterName == d.clusterName && (v.Spec.RateLimit != nil || v.Status.DroppedRecords != nil) {
+			limited = append(limited, v)
+		}
+	}
+	if len(limited) == 0 {
+		return nil
+	}
+
+	counts, err := d.collect(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range limited {
+		var dropped *v32.LoggingDroppedRecords
+		if v.Spec.RateLimit != nil {
+			dropped = countsToDroppedRecords(counts[v.Spec.ProjectName])
+			for ns, count := range dropped.Namespaces {
+				droppedRecords.With(prometheus.Labels{
+					"cluster":   d.clusterName,
+					"project":   v.Spec.ProjectName,
+					"namespace": ns,
+				}).Set(float64(count))
+			}
+		} else {
+			for ns := range v.Status.DroppedRecords.Namespaces {
+				droppedRecords.Delete(prometheus.Labels{
+					"cluster":   d.clusterName,
+					"project":   v.Spec.ProjectName,
+					"namespace": ns,
+				})
+			}
+		}
+
+		if sameDroppedRecords(v.Status.DroppedRecords, dropped) {
+			continue
+		}
+		if dropped != nil {
+			dropped.LastUpdated = time.Now().UTC().Format(time.RFC3339)
+		}
+
+		updatedObj := v.DeepCopy()
+		updatedObj.Status.DroppedRecords = dropped
+		if _, err := d.projectLoggings.Update(updatedObj); err != nil {
+			return errors.Wrapf(err, "set dropped records of projectlogging %s:%s fail", v.Namespace, v.Name)
+		}
+	}
+
+	return nil
+}
+
+// collect scrapes the fluentd pods of the cluster and sums the dropped records per project and namespace.
+func (d *droppedRecordsWatcher) collect(ctx context.Context) (map[string]map[string]int64, error) {
+	clusterDialer, err := d.dialerFactory.ClusterDialer(d.clusterName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get cluster dailer %s failed", d.clusterName)
+	}
+
+	pods, err := d.k8sClient.CoreV1().Pods(loggingconfig.LoggingNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(loggingconfig.FluentdSelector).String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "list fluentd pods fail in dropped records watcher")
+	}
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext: clusterDialer,
+		},
+	}
+
+	counts := map[string]map[string]int64{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			continue
+		}
+		families, err := scrapeMetrics(ctx, client, pod.Status.PodIP)
+		if err != nil {
+			logrus.Warnf("scrape fluentd metrics of pod %s failed: %v", pod.Name, err)
+			continue
+		}
+		for project, namespaces := range droppedRecordsByNamespace(families) {
+			if counts[project] == nil {
+				counts[project] = map[string]int64{}
+			}
+			for ns, count := range namespaces {
+				counts[project][ns] += count
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+func scrapeMetrics(ctx context.Context, client *http.Client, podIP string) (map[string]*dto.MetricFamily, error) {
+	url := fmt.Sprintf("http://%s/metrics", net.JoinHostPort(podIP, strconv.Itoa(loggingconfig.FluentdMetricsPort)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return nil, fmt.Errorf("get %s returned %d: %s", url, res.StatusCode, body)
+	}
+
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(res.Body)
+}
+
+// droppedRecordsByNamespace computes the records dropped per project and namespace from the counters of the rate
+// limits of one fluentd pod.
+func droppedRecordsByNamespace(families map[string]*dto.MetricFamily) map[string]map[string]int64 {
+	received := counterValues(families[receivedRecordsMetric])
+	forwarded := counterValues(families[forwardedRecordsMetric])
+
+	dropped := map[string]map[string]int64{}
+	for key, count := range received {
+		diff := count - forwarded[key]
+		if diff < 0 {
+			diff = 0
+		}
+		if dropped[key.project] == nil {
+			dropped[key.project] = map[string]int64{}
+		}
+		dropped[key.project][key.namespace] += diff
+	}
+	return dropped
+}
+
+type rateLimitKey struct {
+	project   string
+	namespace string
+}
+
+func counterValues(family *dto.MetricFamily) map[rateLimitKey]int64 {
+	values := map[rateLimitKey]int64{}
+	if family == nil {
+		return values
+	}
+
+	for _, m := range family.GetMetric() {
+		var key rateLimitKey
+		for _, l := range m.GetLabel() {
+			switch l.GetName() {
+			case "project":
+				key.project = l.GetValue()
+			case "namespace":
+				key.namespace = l.GetValue()
+			}
+		}
+		values[key] += int64(m.GetCounter().GetValue())
+	}
+	return values
+}
+
+func countsToDroppedRecords(namespaces map[string]int64) *v32.LoggingDroppedRecords {
+	dropped := &v32.LoggingDroppedRecords{}
+	for ns, count := range namespaces {
+		if count == 0 {
+			continue
+		}
+		if dropped.Namespaces == nil {
+			dropped.Namespaces = map[string]int64{}
+		}
+		dropped.Namespaces[ns] = count
+		dropped.Total += count
+	}
+	return dropped
+}
+
+func sameDroppedRecords(current, dropped *v32.LoggingDroppedRecords) bool {
+	if current == nil || dropped == nil {
+		return current == dropped
+	}
+	return current.Total == dropped.Total && reflect.DeepEqual(current.Namespaces, dropped.Namespaces)
+}
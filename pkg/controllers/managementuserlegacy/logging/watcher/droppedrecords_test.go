@@ -0,0 +1,43 @@
+package watcher
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+)
+
+const fluentdMetrics = `# TYPE fluentd_rate_limit_received_records_total counter
+fluentd_rate_limit_received_records_total{project="c-test:p-test",namespace="noisy"} 1500.0
+fluentd_rate_limit_received_records_total{project="c-test:p-test",namespace="quiet"} 20.0
+fluentd_rate_limit_received_records_total{project="c-test:p-other",namespace="other"} 5.0
+# TYPE fluentd_rate_limit_forwarded_records_total counter
+fluentd_rate_limit_forwarded_records_total{project="c-test:p-test",namespace="noisy"} 1000.0
+fluentd_rate_limit_forwarded_records_total{project="c-test:p-test",namespace="quiet"} 20.0
+fluentd_rate_limit_forwarded_records_total{project="c-test:p-other",namespace="other"} 5.0
+# TYPE fluentd_input_status_num_records_total counter
+fluentd_input_status_num_records_total{tag="c-test:p-test.noisy",hostname="node1"} 1500.0
+`
+
+func TestDroppedRecordsByNamespace(t *testing.T) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(fluentdMetrics))
+	if err != nil {
+		t.Fatalf("parse metrics failed, %v", err)
+	}
+
+	dropped := droppedRecordsByNamespace(families)
+	expected := map[string]map[string]int64{
+		"c-test:p-test":  {"noisy": 500, "quiet": 0},
+		"c-test:p-other": {"other": 0},
+	}
+	if !reflect.DeepEqual(dropped, expected) {
+		t.Errorf("expected dropped records %v, got %v", expected, dropped)
+	}
+
+	records := countsToDroppedRecords(dropped["c-test:p-test"])
+	if records.Total != 500 || !reflect.DeepEqual(records.Namespaces, map[string]int64{"noisy": 500}) {
+		t.Errorf("unexpected dropped records %+v", records)
+	}
+}
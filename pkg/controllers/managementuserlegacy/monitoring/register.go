@@ -4,6 +4,7 @@
 	"context"
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
 	"github.com/rancher/rancher/pkg/monitoring"
 	"github.com/rancher/rancher/pkg/systemaccount"
 	"github.com/rancher/rancher/pkg/types/config"
@@ -101,6 +102,7 @@ func registerDeferred(ctx context.Context, agentContext *config.UserContext) {
 		cattleClustersClient: cattleClustersClient,
 		cattleCatalogManager: cattleContext.CatalogManager,
 		agentEndpointsLister: agentClusterMonitoringEndpointLister,
+		clusterStore:         clusterprovisioner.NewPersistentStore(cattleContext.Core.Namespaces(""), cattleContext.Core),
 		app:                  ah,
 	}
 	cattleClustersClient.AddHandler(ctx, "cluster-monitoring-handler", ch.sync)
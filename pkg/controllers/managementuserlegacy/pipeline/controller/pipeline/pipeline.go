@@ -32,7 +32,18 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 		sourceCodeCredentials:      sourceCodeCredentials,
 	}
 
+	refPoller := &RefPoller{
+		clusterName:                cluster.ClusterName,
+		pipelineLister:             pipelines.Controller().Lister(),
+		pipelines:                  pipelines,
+		pipelineExecutions:         cluster.Management.Project.PipelineExecutions(""),
+		sourceCodeCredentialLister: sourceCodeCredentialLister,
+		sourceCodeCredentials:      sourceCodeCredentials,
+	}
+
 	pipelines.AddClusterScopedLifecycle(ctx, "pipeline-controller", cluster.ClusterName, pipelineLifecycle)
+
+	go refPoller.sync(ctx, pollCheckInterval)
 }
 
 func (l *Lifecycle) Create(obj *v3.Pipeline) (runtime.Object, error) {
@@ -71,6 +82,7 @@ func (l *Lifecycle) sync(obj *v3.Pipeline) (*v3.Pipeline, error) {
 			}
 			updatedCred = updatedCred.DeepCopy()
 			updatedCred.Spec.AccessToken = ""
+			updatedCred.Spec.SSHPrivateKey = ""
 			obj.Status.SourceCodeCredential = updatedCred
 		}
 	}
@@ -0,0 +1,180 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/norman/controller"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/providers"
+	"github.com/rancher/rancher/pkg/pipeline/remote"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// The poller triggers the pipelines whose repositories are polled for changes instead of
+// sending webhooks, it compares the branches and tags of the repositories with the ones
+// seen on the previous poll.
+
+const (
+	pollCheckInterval = 15 * time.Second
+	refsBranchPrefix  = "refs/heads/"
+	refsTagPrefix     = "refs/tags/"
+)
+
+type RefPoller struct {
+	clusterName string
+
+	pipelineLister             v3.PipelineLister
+	pipelines                  v3.PipelineInterface
+	pipelineExecutions         v3.PipelineExecutionInterface
+	sourceCodeCredentialLister v3.SourceCodeCredentialLister
+	sourceCodeCredentials      v3.SourceCodeCredentialInterface
+}
+
+func (p *RefPoller) sync(ctx context.Context, syncInterval time.Duration) {
+	for range ticker.Context(ctx, syncInterval) {
+		p.poll()
+	}
+}
+
+func (p *RefPoller) poll() {
+	pipelines, err := p.pipelineLister.List("", labels.Everything())
+	if err != nil {
+		logrus.Errorf("Error listing Pipelines - %v", err)
+		return
+	}
+	now := time.Now()
+	for _, pipeline := range pipelines {
+		if !controller.ObjectInCluster(p.clusterName, pipeline) || !isPollDue(pipeline, now) {
+			continue
+		}
+		if err := p.pollPipeline(pipeline.DeepCopy(), now); err != nil {
+			logrus.Warnf("failed to poll the repository of pipeline %s: %v", pipeline.Name, err)
+		}
+	}
+}
+
+func isPollDue(pipeline *v3.Pipeline, now time.Time) bool {
+	interval := time.Duration(pipeline.Spec.TriggerPollInterval) * time.Second
+	if interval <= 0 || pipeline.Spec.SourceCodeCredentialName == "" {
+		return false
+	}
+	lastPolled, err := time.Parse(time.RFC3339, pipeline.Status.LastPolled)
+	return err != nil || now.Sub(lastPolled) >= interval
+}
+
+// pollPipeline triggers the pipeline for at most one changed ref, as the name of an execution depends on the next
+// run of the pipeline. The other changed refs keep their previous commit so that they are triggered by the next polls.
+func (p *RefPoller) pollPipeline(pipeline *v3.Pipeline, now time.Time) error {
+	ns, name := ref.Parse(pipeline.Spec.SourceCodeCredentialName)
+	credential, err := p.sourceCodeCredentialLister.Get(ns, name)
+	if err != nil {
+		return err
+	}
+	_, projID := ref.Parse(pipeline.Spec.ProjectName)
+	scpConfig, err := providers.GetSourceCodeProviderConfig(credential.Spec.SourceCodeType, projID)
+	if err != nil {
+		return err
+	}
+	remote, err := remote.New(scpConfig)
+	if err != nil {
+		return err
+	}
+	refLister, ok := remote.(model.RefLister)
+	if !ok {
+		return errors.Errorf("polling is not supported by the %s provider", remote.Type())
+	}
+	accessToken, err := utils.EnsureAccessToken(p.sourceCodeCredentials, remote, credential)
+	if err != nil {
+		return err
+	}
+	refs, err := refLister.ListRefs(pipeline.Spec.RepositoryURL, accessToken)
+	if err != nil {
+		return err
+	}
+
+	firstPoll := pipeline.Status.LastPolled == ""
+	names := make([]string, 0, len(refs))
+	for name := range refs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	polled := map[string]string{}
+	triggered := false
+	for _, name := range names {
+		commit := refs[name]
+		previous, seen := pipeline.Status.PolledRefs[name]
+		if firstPoll || previous == commit {
+			polled[name] = commit
+			continue
+		}
+		if triggered {
+			if seen {
+				polled[name] = previous
+			}
+			continue
+		}
+		info := pollBuildInfo(pipeline, name, commit)
+		if info == nil {
+			polled[name] = commit
+			continue
+		}
+		execution, err := p.trigger(pipeline, info)
+		if err != nil {
+			// keep the previous commit so that the ref is triggered again by the next poll
+			logrus.Warnf("failed to trigger pipeline %s for %s: %v", pipeline.Name, name, err)
+			if seen {
+				polled[name] = previous
+			}
+			continue
+		}
+		polled[name] = commit
+		triggered = execution != nil
+	}
+
+	pipeline.Status.PolledRefs = polled
+	pipeline.Status.LastPolled = now.Format(time.RFC3339)
+	_, err = p.pipelines.Update(pipeline)
+	return err
+}
+
+func (p *RefPoller) trigger(pipeline *v3.Pipeline, info *model.BuildInfo) (*v3.PipelineExecution, error) {
+	pipelineConfig, err := providers.GetPipelineConfigByBranch(p.sourceCodeCredentials, p.sourceCodeCredentialLister, pipeline, info.Branch)
+	if err != nil {
+		return nil, err
+	}
+	if pipelineConfig == nil {
+		//no pipeline config to run
+		return nil, nil
+	}
+	return utils.GenerateExecution(p.pipelineExecutions, pipeline, pipelineConfig, info)
+}
+
+// pollBuildInfo returns the build info of a changed branch or tag, or nil when the pipeline is not triggered by its
+// event.
+func pollBuildInfo(pipeline *v3.Pipeline, name string, commit string) *model.BuildInfo {
+	info := &model.BuildInfo{
+		TriggerType: utils.TriggerTypePoll,
+		Commit:      commit,
+		Ref:         name,
+	}
+	switch {
+	case strings.HasPrefix(name, refsBranchPrefix) && pipeline.Spec.TriggerWebhookPush:
+		info.Event = utils.WebhookEventPush
+		info.Branch = strings.TrimPrefix(name, refsBranchPrefix)
+	case strings.HasPrefix(name, refsTagPrefix) && pipeline.Spec.TriggerWebhookTag:
+		info.Event = utils.WebhookEventTag
+		info.Branch = strings.TrimPrefix(name, refsTagPrefix)
+	default:
+		return nil
+	}
+	return info
+}
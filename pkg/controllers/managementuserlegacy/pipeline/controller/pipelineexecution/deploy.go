@@ -14,6 +14,7 @@
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	"github.com/rancher/rancher/pkg/controllers/managementagent/nslabels"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/resourcequota"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
 	images "github.com/rancher/rancher/pkg/image"
 	namespaceutil "github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/rancher/pkg/pipeline/utils"
@@ -38,10 +39,26 @@
 const projectIDFieldLabel = "field.cattle.io/projectId"
 const defaultPortRange = "34000-35000"
 
-func (l *Lifecycle) deploy(projectName string) error {
+func (l *Lifecycle) deploy(execution *v3.PipelineExecution) error {
+	projectName := execution.Spec.ProjectName
 	clusterID, projectID := ref.Parse(projectName)
+	pipelineEngine, err := utils.GetPipelineEngine(l.pipelineSettingLister, execution)
+	if err != nil {
+		return err
+	}
 	ns := getPipelineNamespace(clusterID, projectID)
 	if _, err := l.namespaceLister.Get("", ns.Name); err == nil {
+		if pipelineEngine == utils.EngineJenkins {
+			// jenkins is not deployed for the projects that started with another engine
+			nsName := utils.GetPipelineCommonName(projectName)
+			if _, err := l.serviceLister.Get(nsName, utils.JenkinsName); apierrors.IsNotFound(err) {
+				if err := l.deployJenkins(nsName); err != nil {
+					return err
+				}
+			} else if err != nil {
+				return err
+			}
+		}
 		return l.reconcileRb(projectName)
 	} else if !apierrors.IsNotFound(err) {
 		return err
@@ -124,13 +141,10 @@ func (l *Lifecycle) deploy(projectName string) error {
 	if _, err := l.networkPolicies.Create(np); err != nil && !apierrors.IsAlreadyExists(err) {
 		return errors.Wrapf(err, "Error create a pipeline networkpolicy")
 	}
-	jenkinsService := getJenkinsService(nsName)
-	if _, err := l.services.Create(jenkinsService); err != nil && !apierrors.IsAlreadyExists(err) {
-		return errors.Wrapf(err, "Error creating the jenkins service")
-	}
-	jenkinsDeployment := GetJenkinsDeployment(nsName)
-	if _, err := l.deployments.Create(jenkinsDeployment); err != nil && !apierrors.IsAlreadyExists(err) {
-		return errors.Wrapf(err, "Error creating the jenkins deployment")
+	if pipelineEngine == utils.EngineJenkins {
+		if err := l.deployJenkins(nsName); err != nil {
+			return err
+		}
 	}
 	registryService := getRegistryService(nsName)
 	if _, err := l.services.Create(registryService); err != nil && !apierrors.IsAlreadyExists(err) {
@@ -193,6 +207,18 @@ func (l *Lifecycle) waitResourceQuotaInitCondition(namespace string) error {
 	return nil
 }
 
+func (l *Lifecycle) deployJenkins(nsName string) error {
+	jenkinsService := getJenkinsService(nsName)
+	if _, err := l.services.Create(jenkinsService); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "Error creating the jenkins service")
+	}
+	jenkinsDeployment := GetJenkinsDeployment(nsName)
+	if _, err := l.deployments.Create(jenkinsDeployment); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "Error creating the jenkins deployment")
+	}
+	return nil
+}
+
 func getCommonPipelineNamespace() *corev1.Namespace {
 	return &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -247,7 +247,7 @@ func (l *Lifecycle) Sync(obj *v3.PipelineExecution) (runtime.Object, error) {
 	v32.PipelineExecutionConditionInitialized.CreateUnknownIfNotExists(obj)
 	obj.Labels[utils.PipelineFinishLabel] = "false"
 
-	if err := l.deploy(obj.Spec.ProjectName); err != nil {
+	if err := l.deploy(obj); err != nil {
 		obj.Labels[utils.PipelineFinishLabel] = "true"
 		obj.Status.ExecutionState = utils.StateFailed
 		v32.PipelineExecutionConditionInitialized.False(obj)
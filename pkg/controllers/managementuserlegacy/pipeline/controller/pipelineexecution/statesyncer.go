@@ -119,7 +119,7 @@ func (s *ExecutionStateSyncer) checkAndRun(execution *v3.PipelineExecution) {
 	}
 	if v32.PipelineExecutionConditionInitialized.GetMessage(execution) == "" {
 		e := execution.DeepCopy()
-		v32.PipelineExecutionConditionInitialized.Message(e, "Setting up jenkins. If it is not deployed, this can take a few minutes.")
+		v32.PipelineExecutionConditionInitialized.Message(e, "Setting up pipeline engine. If it is not deployed, this can take a few minutes.")
 		if err := s.updateExecutionAndLastRunState(e); err != nil {
 			logrus.Error(err)
 		}
@@ -30,6 +30,9 @@
 	utils.SettingExecutorMemoryLimit:   utils.SettingExecutorMemoryLimitDefault,
 	utils.SettingExecutorCPURequest:    utils.SettingExecutorCPURequestDefault,
 	utils.SettingExecutorCPULimit:      utils.SettingExecutorCPULimitDefault,
+	utils.SettingEngine:                utils.SettingEngineDefault,
+	utils.SettingWorkspaceSize:         utils.WorkspaceSizeDefault,
+	utils.SettingWorkspaceStorageClass: "",
 }
 
 func Register(ctx context.Context, cluster *config.UserContext) {
@@ -83,6 +86,7 @@ func (l *Syncer) addSourceCodeProviderConfigs(obj *v3.Project) error {
 		model.GitlabType:          pclient.GitlabPipelineConfigType,
 		model.BitbucketCloudType:  pclient.BitbucketCloudPipelineConfigType,
 		model.BitbucketServerType: pclient.BitbucketServerPipelineConfigType,
+		model.GitType:             pclient.GitPipelineConfigType,
 	}
 	for name, pType := range supportedProviders {
 		if err := l.addSourceCodeProviderConfig(name, pType, false, obj); err != nil {
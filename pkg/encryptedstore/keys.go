@@ -0,0 +1,139 @@
+package encryptedstore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// KeysFileEnv points at the file holding the key encryption keys. The file is expected to be mounted
+	// from outside of the local cluster so that a copy of etcd alone is not enough to read the stored secrets.
+	KeysFileEnv = "CATTLE_ENCRYPTION_KEYS_FILE"
+
+	dataKeySize = 32
+)
+
+var (
+	defaultKeyring     *Keyring
+	defaultKeyringErr  error
+	defaultKeyringOnce sync.Once
+)
+
+// Keyring holds the key encryption keys used to wrap the per secret data keys. New data keys are always
+// wrapped with the primary key; the other keys are kept so that secrets written before a rotation can
+// still be read until they are re-encrypted.
+//
+// The keys file is JSON of the form {"primary": "key2", "keys": {"key1": "<base64>", "key2": "<base64>"}}
+// where every key is 32 bytes of random data.
+type Keyring struct {
+	Primary string            `json:"primary"`
+	Keys    map[string][]byte `json:"keys"`
+}
+
+// DefaultKeyring returns the keyring loaded from the file named by CATTLE_ENCRYPTION_KEYS_FILE, or nil if
+// the variable is not set, in which case secrets are stored without application level encryption.
+func DefaultKeyring() (*Keyring, error) {
+	defaultKeyringOnce.Do(func() {
+		path := os.Getenv(KeysFileEnv)
+		if path == "" {
+			return
+		}
+		defaultKeyring, defaultKeyringErr = LoadKeyring(path)
+		if defaultKeyringErr == nil {
+			logrus.Infof("[GenericEncryptedStore]: encrypting secrets with key %s", defaultKeyring.Primary)
+		}
+	})
+	return defaultKeyring, defaultKeyringErr
+}
+
+func LoadKeyring(path string) (*Keyring, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading encryption keys: %w", err)
+	}
+	keyring := &Keyring{}
+	if err := json.Unmarshal(content, keyring); err != nil {
+		return nil, fmt.Errorf("parsing encryption keys file %s: %w", path, err)
+	}
+	return keyring, keyring.Validate()
+}
+
+func (k *Keyring) Validate() error {
+	if k.Primary == "" {
+		return fmt.Errorf("no primary encryption key set")
+	}
+	if _, ok := k.Keys[k.Primary]; !ok {
+		return fmt.Errorf("primary encryption key %s not found", k.Primary)
+	}
+	for id, key := range k.Keys {
+		if len(key) != dataKeySize {
+			return fmt.Errorf("encryption key %s must be %d bytes, got %d", id, dataKeySize, len(key))
+		}
+	}
+	return nil
+}
+
+// newDataKey generates a new data key and returns it along with its encrypted form and the ID of the key used to
+// encrypt it.
+func (k *Keyring) newDataKey() (dataKey []byte, keyID string, wrapped []byte, err error) {
+	dataKey = make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, "", nil, err
+	}
+	wrapped, err = seal(k.Keys[k.Primary], dataKey, []byte(k.Primary))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	return dataKey, k.Primary, wrapped, nil
+}
+
+func (k *Keyring) unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	kek, ok := k.Keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("encryption key %s not found", keyID)
+	}
+	return open(kek, wrapped, []byte(keyID))
+}
+
+// seal encrypts plaintext with AES-GCM, prefixing the result with the random nonce. The additional data
+// binds the ciphertext to where it is stored so values can't be swapped between keys or secrets.
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
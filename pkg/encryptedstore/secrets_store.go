@@ -1,7 +1,10 @@
 package encryptedstore
 
 import (
+	"encoding/base64"
+	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
@@ -9,18 +12,29 @@
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 const (
 	defaultNamespace = "cattle-system"
+
+	keyIDAnnotation   = "encryptedstore.cattle.io/key-id"
+	dataKeyAnnotation = "encryptedstore.cattle.io/data-key"
+	// storeLabel marks the secrets written by a store, its value is the prefix of the store without the dash
+	storeLabel = "encryptedstore.cattle.io/store"
 )
 
+// GenericEncryptedStore persists string maps in secrets. When a keyring is configured every secret is
+// envelope encrypted: its values are sealed with a data key unique to the secret, and the data key is in
+// turn sealed with the keyring's primary key and stored in an annotation. Secrets written without
+// encryption are still read as plaintext and get encrypted on their next write or re-encryption.
 type GenericEncryptedStore struct {
 	prefix       string
 	namespace    string
 	secrets      v1.SecretInterface
 	secretLister v1.SecretLister
+	keyring      *Keyring
 }
 
 func NewGenericEncryptedStore(prefix, namespace string, namespaceInterface v1.NamespaceInterface, secretsGetter v1.SecretsGetter) (*GenericEncryptedStore, error) {
@@ -39,11 +53,17 @@ func NewGenericEncryptedStore(prefix, namespace string, namespaceInterface v1.Na
 		return nil, err
 	}
 
+	keyring, err := DefaultKeyring()
+	if err != nil {
+		return nil, err
+	}
+
 	return &GenericEncryptedStore{
 		prefix:       prefix,
 		namespace:    namespace,
 		secrets:      secretsGetter.Secrets(namespace),
 		secretLister: secretsGetter.Secrets(namespace).Controller().Lister(),
+		keyring:      keyring,
 	}, nil
 }
 
@@ -53,18 +73,17 @@ func (g *GenericEncryptedStore) Get(name string) (map[string]string, error) {
 		return nil, err
 	}
 
-	result := map[string]string{}
-	for k, v := range sec.Data {
-		result[k] = string(v)
-	}
-
-	return result, nil
+	return g.decode(sec)
 }
 
 func (g *GenericEncryptedStore) getKey(name string) string {
 	return g.prefix + name
 }
 
+func (g *GenericEncryptedStore) storeName() string {
+	return strings.TrimSuffix(g.prefix, "-")
+}
+
 func (g *GenericEncryptedStore) Set(name string, data map[string]string) error {
 	return g.set(name, data)
 }
@@ -76,7 +95,9 @@ func (g *GenericEncryptedStore) set(name string, data map[string]string) error {
 		logrus.Debugf("[GenericEncryptedStore]: Creating secret for %v", g.getKey(name))
 		sec = &corev1.Secret{}
 		sec.Name = g.getKey(name)
-		sec.StringData = data
+		if err := g.encode(sec, data); err != nil {
+			return err
+		}
 		if _, err := g.secrets.Create(sec); err != nil {
 			if !errors.IsAlreadyExists(err) {
 				return err
@@ -90,8 +111,11 @@ func (g *GenericEncryptedStore) set(name string, data map[string]string) error {
 		return err
 	}
 
-	secToUpdate := prepareSecretForUpdate(sec, data)
-	if !reflect.DeepEqual(secToUpdate.Data, sec.Data) {
+	secToUpdate, changed, err := g.prepareSecretForUpdate(sec, data)
+	if err != nil {
+		return err
+	}
+	if changed {
 		logrus.Debugf("[GenericEncryptedStore]: updating secret %v", g.getKey(name))
 		_, err = g.secrets.Update(secToUpdate)
 		if err != nil {
@@ -118,8 +142,11 @@ func (g *GenericEncryptedStore) updateSecretWithBackoff(name string, data map[st
 			logrus.Errorf("[GenericEncryptedStore]: error getting secret %v from db: %v", g.getKey(name), err)
 			return false, err
 		}
-		secToUpdate := prepareSecretForUpdate(secret, data)
-		if !reflect.DeepEqual(secToUpdate.Data, secret.Data) {
+		secToUpdate, changed, err := g.prepareSecretForUpdate(secret, data)
+		if err != nil {
+			return false, err
+		}
+		if changed {
 			_, err = g.secrets.Update(secToUpdate)
 			if err != nil {
 				if errors.IsConflict(err) {
@@ -135,15 +162,159 @@ func (g *GenericEncryptedStore) updateSecretWithBackoff(name string, data map[st
 	})
 }
 
-func prepareSecretForUpdate(secret *corev1.Secret, data map[string]string) *corev1.Secret {
+// prepareSecretForUpdate merges data into the current content of secret and returns the re-encoded copy,
+// along with whether it differs from what is stored.
+func (g *GenericEncryptedStore) prepareSecretForUpdate(secret *corev1.Secret, data map[string]string) (*corev1.Secret, bool, error) {
+	current, err := g.decode(secret)
+	if err != nil {
+		return nil, false, err
+	}
+	merged := make(map[string]string, len(current)+len(data))
+	for k, v := range current {
+		merged[k] = v
+	}
+	for k, v := range data {
+		merged[k] = v
+	}
+	if reflect.DeepEqual(merged, current) && g.encodedWithPrimaryKey(secret) {
+		return secret, false, nil
+	}
+
 	secToUpdate := secret.DeepCopy()
-	if secToUpdate.Data == nil {
-		secToUpdate.Data = map[string][]byte{}
+	if err := g.encode(secToUpdate, merged); err != nil {
+		return nil, false, err
+	}
+	return secToUpdate, true, nil
+}
+
+// encodedWithPrimaryKey returns whether secret is stored the way the store would write it now: encrypted
+// with the primary key, or in plaintext when no keyring is configured.
+func (g *GenericEncryptedStore) encodedWithPrimaryKey(secret *corev1.Secret) bool {
+	keyID := secret.Annotations[keyIDAnnotation]
+	if g.keyring == nil {
+		return keyID == ""
+	}
+	return keyID == g.keyring.Primary
+}
+
+func (g *GenericEncryptedStore) encode(secret *corev1.Secret, data map[string]string) error {
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[storeLabel] = g.storeName()
+	secret.StringData = nil
+	secret.Data = make(map[string][]byte, len(data))
+	if g.keyring == nil {
+		delete(secret.Annotations, keyIDAnnotation)
+		delete(secret.Annotations, dataKeyAnnotation)
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+		return nil
+	}
+
+	dataKey, keyID, wrapped, err := g.keyring.newDataKey()
+	if err != nil {
+		return err
 	}
 	for k, v := range data {
-		secToUpdate.Data[k] = []byte(v)
+		sealed, err := seal(dataKey, []byte(v), additionalData(secret.Name, k))
+		if err != nil {
+			return err
+		}
+		secret.Data[k] = sealed
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[keyIDAnnotation] = keyID
+	secret.Annotations[dataKeyAnnotation] = base64.StdEncoding.EncodeToString(wrapped)
+	return nil
+}
+
+func (g *GenericEncryptedStore) decode(secret *corev1.Secret) (map[string]string, error) {
+	result := map[string]string{}
+	keyID := secret.Annotations[keyIDAnnotation]
+	if keyID == "" {
+		for k, v := range secret.Data {
+			result[k] = string(v)
+		}
+		return result, nil
+	}
+
+	if g.keyring == nil {
+		return nil, fmt.Errorf("secret %s is encrypted with key %s but no encryption keys are configured, set %s", secret.Name, keyID, KeysFileEnv)
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(secret.Annotations[dataKeyAnnotation])
+	if err != nil {
+		return nil, fmt.Errorf("decoding data key of secret %s: %w", secret.Name, err)
+	}
+	dataKey, err := g.keyring.unwrap(keyID, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data key of secret %s: %w", secret.Name, err)
+	}
+	for k, v := range secret.Data {
+		plaintext, err := open(dataKey, v, additionalData(secret.Name, k))
+		if err != nil {
+			return nil, fmt.Errorf("decrypting key %s of secret %s: %w", k, secret.Name, err)
+		}
+		result[k] = string(plaintext)
+	}
+	return result, nil
+}
+
+func additionalData(secretName, key string) []byte {
+	return []byte(secretName + "/" + key)
+}
+
+// Reencrypt rewrites every secret of the store that is not encrypted with the primary key, which encrypts
+// secrets written before encryption was enabled and moves secrets off rotated keys so those can be retired.
+// Secrets are selected by the label the store sets on them, names lists the objects the store keeps secrets
+// for so that the secrets written before the label existed are found as well. It returns the number of
+// secrets rewritten.
+func (g *GenericEncryptedStore) Reencrypt(names []string) (int, error) {
+	secrets, err := g.secrets.List(metav1.ListOptions{
+		LabelSelector: labels.Set{storeLabel: g.storeName()}.String(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	candidates := map[string]*corev1.Secret{}
+	for i := range secrets.Items {
+		candidates[secrets.Items[i].Name] = &secrets.Items[i]
+	}
+	for _, name := range names {
+		if _, ok := candidates[g.getKey(name)]; ok {
+			continue
+		}
+		secret, err := g.secrets.GetNamespaced(g.namespace, g.getKey(name), metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		candidates[secret.Name] = secret
+	}
+
+	count := 0
+	for _, secret := range candidates {
+		if g.encodedWithPrimaryKey(secret) {
+			continue
+		}
+		secToUpdate, changed, err := g.prepareSecretForUpdate(secret, nil)
+		if err != nil {
+			return count, err
+		}
+		if !changed {
+			continue
+		}
+		if _, err := g.secrets.Update(secToUpdate); err != nil {
+			return count, fmt.Errorf("re-encrypting secret %s: %w", secret.Name, err)
+		}
+		count++
 	}
-	return secToUpdate
+	return count, nil
 }
 
 func (g *GenericEncryptedStore) Remove(name string) error {
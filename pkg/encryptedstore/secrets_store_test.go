@@ -0,0 +1,163 @@
+package encryptedstore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newTestStore(keyring *Keyring, secrets map[string]*corev1.Secret) *GenericEncryptedStore {
+	save := func(secret *corev1.Secret) (*corev1.Secret, error) {
+		secrets[secret.Name] = secret.DeepCopy()
+		return secret, nil
+	}
+	return &GenericEncryptedStore{
+		prefix:    "c-",
+		namespace: defaultNamespace,
+		keyring:   keyring,
+		secrets: &fakes.SecretInterfaceMock{
+			CreateFunc: save,
+			UpdateFunc: save,
+			GetNamespacedFunc: func(namespace string, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+				if secret, ok := secrets[name]; ok {
+					return secret.DeepCopy(), nil
+				}
+				return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+			},
+			ListFunc: func(opts metav1.ListOptions) (*corev1.SecretList, error) {
+				selector, err := labels.Parse(opts.LabelSelector)
+				if err != nil {
+					return nil, err
+				}
+				list := &corev1.SecretList{}
+				for _, secret := range secrets {
+					if selector.Matches(labels.Set(secret.Labels)) {
+						list.Items = append(list.Items, *secret.DeepCopy())
+					}
+				}
+				return list, nil
+			},
+		},
+		secretLister: &fakes.SecretListerMock{
+			GetFunc: func(namespace string, name string) (*corev1.Secret, error) {
+				if secret, ok := secrets[name]; ok {
+					return secret.DeepCopy(), nil
+				}
+				return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+			},
+		},
+	}
+}
+
+func testKeyring(primary string, ids ...string) *Keyring {
+	keyring := &Keyring{Primary: primary, Keys: map[string][]byte{}}
+	for i, id := range ids {
+		keyring.Keys[id] = bytes.Repeat([]byte{byte(i + 1)}, dataKeySize)
+	}
+	return keyring
+}
+
+func TestSetEncryptsData(t *testing.T) {
+	secrets := map[string]*corev1.Secret{}
+	store := newTestStore(testKeyring("key1", "key1"), secrets)
+
+	require.NoError(t, store.Set("test", map[string]string{"cluster": "credentials"}))
+	require.NoError(t, store.Set("test", map[string]string{"status": "active"}))
+
+	secret := secrets["c-test"]
+	assert.Equal(t, "key1", secret.Annotations[keyIDAnnotation])
+	assert.NotContains(t, string(secret.Data["cluster"]), "credentials")
+
+	data, err := store.Get("test")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cluster": "credentials", "status": "active"}, data)
+}
+
+func TestGetReadsPlaintextSecrets(t *testing.T) {
+	secrets := map[string]*corev1.Secret{
+		"c-test": {
+			ObjectMeta: metav1.ObjectMeta{Name: "c-test"},
+			Data:       map[string][]byte{"cluster": []byte("credentials")},
+		},
+	}
+	store := newTestStore(testKeyring("key1", "key1"), secrets)
+
+	data, err := store.Get("test")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cluster": "credentials"}, data)
+}
+
+func TestGetRejectsTamperedData(t *testing.T) {
+	secrets := map[string]*corev1.Secret{}
+	store := newTestStore(testKeyring("key1", "key1"), secrets)
+	require.NoError(t, store.Set("one", map[string]string{"cluster": "first"}))
+	require.NoError(t, store.Set("two", map[string]string{"cluster": "second"}))
+
+	// values are bound to the secret they were written to
+	secrets["c-one"].Data["cluster"] = secrets["c-two"].Data["cluster"]
+	_, err := store.Get("one")
+	assert.Error(t, err)
+}
+
+func TestReencrypt(t *testing.T) {
+	secrets := map[string]*corev1.Secret{
+		"c-plain": {
+			ObjectMeta: metav1.ObjectMeta{Name: "c-plain"},
+			Data:       map[string][]byte{"cluster": []byte("plain")},
+		},
+		"c-other": {
+			ObjectMeta: metav1.ObjectMeta{Name: "c-other"},
+			Data:       map[string][]byte{"token": []byte("untouched")},
+		},
+	}
+	require.NoError(t, newTestStore(testKeyring("key1", "key1"), secrets).Set("old", map[string]string{"cluster": "old"}))
+	assert.Equal(t, "c", secrets["c-old"].Labels[storeLabel])
+
+	// c-plain predates the store label and is only found through its name, c-other isn't a secret of the
+	// store even though it has the same prefix
+	store := newTestStore(testKeyring("key2", "key1", "key2"), secrets)
+	count, err := store.Reencrypt([]string{"plain", "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	for name, value := range map[string]string{"plain": "plain", "old": "old"} {
+		assert.Equal(t, "key2", secrets["c-"+name].Annotations[keyIDAnnotation])
+		data, err := store.Get(name)
+		require.NoError(t, err)
+		assert.Equal(t, value, data["cluster"])
+	}
+	assert.Equal(t, "untouched", string(secrets["c-other"].Data["token"]))
+	assert.Empty(t, secrets["c-other"].Annotations[keyIDAnnotation])
+
+	// the old key is no longer needed once everything has been re-encrypted
+	store = newTestStore(testKeyring("key2", "key0", "key2"), secrets)
+	_, err = store.Get("old")
+	assert.NoError(t, err)
+
+	count, err = store.Reencrypt([]string{"plain"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestGetEncryptedWithoutKeys(t *testing.T) {
+	secrets := map[string]*corev1.Secret{}
+	require.NoError(t, newTestStore(testKeyring("key1", "key1"), secrets).Set("test", map[string]string{"cluster": "credentials"}))
+
+	_, err := newTestStore(nil, secrets).Get("test")
+	assert.Error(t, err)
+}
+
+func TestKeyringValidate(t *testing.T) {
+	assert.NoError(t, testKeyring("key1", "key1").Validate())
+	assert.Error(t, testKeyring("", "key1").Validate())
+	assert.Error(t, testKeyring("key2", "key1").Validate())
+	assert.Error(t, (&Keyring{Primary: "key1", Keys: map[string][]byte{"key1": []byte("short")}}).Validate())
+}
@@ -101,6 +101,12 @@
 		true,
 		true,
 		true)
+	RequireSignedCharts = newFeature(
+		"require-signed-charts",
+		"Only allow installing and upgrading charts with a verified signature, in every cluster and from every repo except the ones Rancher installs its own charts from",
+		false,
+		true,
+		true)
 )
 
 type Feature struct {
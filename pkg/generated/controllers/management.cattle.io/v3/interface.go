@@ -32,6 +32,8 @@ func init() {
 type Interface interface {
 	APIService() APIServiceController
 	ActiveDirectoryProvider() ActiveDirectoryProviderController
+	AlertHistory() AlertHistoryController
+	AlertMaintenanceWindow() AlertMaintenanceWindowController
 	AuthConfig() AuthConfigController
 	AuthProvider() AuthProviderController
 	AuthToken() AuthTokenController
@@ -39,6 +41,7 @@ type Interface interface {
 	Catalog() CatalogController
 	CatalogTemplate() CatalogTemplateController
 	CatalogTemplateVersion() CatalogTemplateVersionController
+	CisBenchmarkProfile() CisBenchmarkProfileController
 	CisBenchmarkVersion() CisBenchmarkVersionController
 	CisConfig() CisConfigController
 	CloudCredential() CloudCredentialController
@@ -125,6 +128,12 @@ func (c *version) APIService() APIServiceController {
 func (c *version) ActiveDirectoryProvider() ActiveDirectoryProviderController {
 	return NewActiveDirectoryProviderController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ActiveDirectoryProvider"}, "activedirectoryproviders", false, c.controllerFactory)
 }
+func (c *version) AlertHistory() AlertHistoryController {
+	return NewAlertHistoryController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "AlertHistory"}, "alerthistories", true, c.controllerFactory)
+}
+func (c *version) AlertMaintenanceWindow() AlertMaintenanceWindowController {
+	return NewAlertMaintenanceWindowController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "AlertMaintenanceWindow"}, "alertmaintenancewindows", true, c.controllerFactory)
+}
 func (c *version) AuthConfig() AuthConfigController {
 	return NewAuthConfigController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "AuthConfig"}, "authconfigs", false, c.controllerFactory)
 }
@@ -146,6 +155,9 @@ func (c *version) CatalogTemplate() CatalogTemplateController {
 func (c *version) CatalogTemplateVersion() CatalogTemplateVersionController {
 	return NewCatalogTemplateVersionController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "CatalogTemplateVersion"}, "catalogtemplateversions", true, c.controllerFactory)
 }
+func (c *version) CisBenchmarkProfile() CisBenchmarkProfileController {
+	return NewCisBenchmarkProfileController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "CisBenchmarkProfile"}, "cisbenchmarkprofiles", true, c.controllerFactory)
+}
 func (c *version) CisBenchmarkVersion() CisBenchmarkVersionController {
 	return NewCisBenchmarkVersionController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "CisBenchmarkVersion"}, "cisbenchmarkversions", true, c.controllerFactory)
 }
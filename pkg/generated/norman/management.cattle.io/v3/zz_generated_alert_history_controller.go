@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	AlertHistoryGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "AlertHistory",
+	}
+	AlertHistoryResource = metav1.APIResource{
+		Name:         "alerthistories",
+		SingularName: "alerthistory",
+		Namespaced:   true,
+
+		Kind: AlertHistoryGroupVersionKind.Kind,
+	}
+
+	AlertHistoryGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "alerthistories",
+	}
+)
+
+func init() {
+	resource.Put(AlertHistoryGroupVersionResource)
+}
+
+// Deprecated use v3.AlertHistory instead
+type AlertHistory = v3.AlertHistory
+
+func NewAlertHistory(namespace, name string, obj v3.AlertHistory) *v3.AlertHistory {
+	obj.APIVersion, obj.Kind = AlertHistoryGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type AlertHistoryHandlerFunc func(key string, obj *v3.AlertHistory) (runtime.Object, error)
+
+type AlertHistoryChangeHandlerFunc func(obj *v3.AlertHistory) (runtime.Object, error)
+
+type AlertHistoryLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.AlertHistory, err error)
+	Get(namespace, name string) (*v3.AlertHistory, error)
+}
+
+type AlertHistoryController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() AlertHistoryLister
+	AddHandler(ctx context.Context, name string, handler AlertHistoryHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync AlertHistoryHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler AlertHistoryHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler AlertHistoryHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type AlertHistoryInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.AlertHistory) (*v3.AlertHistory, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.AlertHistory, error)
+	Get(name string, opts metav1.GetOptions) (*v3.AlertHistory, error)
+	Update(*v3.AlertHistory) (*v3.AlertHistory, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.AlertHistoryList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.AlertHistoryList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() AlertHistoryController
+	AddHandler(ctx context.Context, name string, sync AlertHistoryHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync AlertHistoryHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle AlertHistoryLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle AlertHistoryLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync AlertHistoryHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync AlertHistoryHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle AlertHistoryLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle AlertHistoryLifecycle)
+}
+
+type alertHistoryLister struct {
+	ns         string
+	controller *alertHistoryController
+}
+
+func (l *alertHistoryLister) List(namespace string, selector labels.Selector) (ret []*v3.AlertHistory, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.AlertHistory))
+	})
+	return
+}
+
+func (l *alertHistoryLister) Get(namespace, name string) (*v3.AlertHistory, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    AlertHistoryGroupVersionKind.Group,
+			Resource: AlertHistoryGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.AlertHistory), nil
+}
+
+type alertHistoryController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *alertHistoryController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *alertHistoryController) Lister() AlertHistoryLister {
+	return &alertHistoryLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *alertHistoryController) AddHandler(ctx context.Context, name string, handler AlertHistoryHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AlertHistory); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertHistoryController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler AlertHistoryHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AlertHistory); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertHistoryController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler AlertHistoryHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AlertHistory); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertHistoryController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler AlertHistoryHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AlertHistory); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type alertHistoryFactory struct {
+}
+
+func (c alertHistoryFactory) Object() runtime.Object {
+	return &v3.AlertHistory{}
+}
+
+func (c alertHistoryFactory) List() runtime.Object {
+	return &v3.AlertHistoryList{}
+}
+
+func (s *alertHistoryClient) Controller() AlertHistoryController {
+	genericController := controller.NewGenericController(s.ns, AlertHistoryGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(AlertHistoryGroupVersionResource, AlertHistoryGroupVersionKind.Kind, true))
+
+	return &alertHistoryController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type alertHistoryClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   AlertHistoryController
+}
+
+func (s *alertHistoryClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *alertHistoryClient) Create(o *v3.AlertHistory) (*v3.AlertHistory, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.AlertHistory), err
+}
+
+func (s *alertHistoryClient) Get(name string, opts metav1.GetOptions) (*v3.AlertHistory, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.AlertHistory), err
+}
+
+func (s *alertHistoryClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.AlertHistory, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.AlertHistory), err
+}
+
+func (s *alertHistoryClient) Update(o *v3.AlertHistory) (*v3.AlertHistory, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.AlertHistory), err
+}
+
+func (s *alertHistoryClient) UpdateStatus(o *v3.AlertHistory) (*v3.AlertHistory, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.AlertHistory), err
+}
+
+func (s *alertHistoryClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *alertHistoryClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *alertHistoryClient) List(opts metav1.ListOptions) (*v3.AlertHistoryList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.AlertHistoryList), err
+}
+
+func (s *alertHistoryClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.AlertHistoryList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.AlertHistoryList), err
+}
+
+func (s *alertHistoryClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *alertHistoryClient) Patch(o *v3.AlertHistory, patchType types.PatchType, data []byte, subresources ...string) (*v3.AlertHistory, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.AlertHistory), err
+}
+
+func (s *alertHistoryClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *alertHistoryClient) AddHandler(ctx context.Context, name string, sync AlertHistoryHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *alertHistoryClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync AlertHistoryHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *alertHistoryClient) AddLifecycle(ctx context.Context, name string, lifecycle AlertHistoryLifecycle) {
+	sync := NewAlertHistoryLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *alertHistoryClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle AlertHistoryLifecycle) {
+	sync := NewAlertHistoryLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *alertHistoryClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync AlertHistoryHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *alertHistoryClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync AlertHistoryHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *alertHistoryClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle AlertHistoryLifecycle) {
+	sync := NewAlertHistoryLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *alertHistoryClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle AlertHistoryLifecycle) {
+	sync := NewAlertHistoryLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
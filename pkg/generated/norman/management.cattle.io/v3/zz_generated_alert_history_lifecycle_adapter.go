@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type AlertHistoryLifecycle interface {
+	Create(obj *v3.AlertHistory) (runtime.Object, error)
+	Remove(obj *v3.AlertHistory) (runtime.Object, error)
+	Updated(obj *v3.AlertHistory) (runtime.Object, error)
+}
+
+type alertHistoryLifecycleAdapter struct {
+	lifecycle AlertHistoryLifecycle
+}
+
+func (w *alertHistoryLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *alertHistoryLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *alertHistoryLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.AlertHistory))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *alertHistoryLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.AlertHistory))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *alertHistoryLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.AlertHistory))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewAlertHistoryLifecycleAdapter(name string, clusterScoped bool, client AlertHistoryInterface, l AlertHistoryLifecycle) AlertHistoryHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(AlertHistoryGroupVersionResource)
+	}
+	adapter := &alertHistoryLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.AlertHistory) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	AlertMaintenanceWindowGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "AlertMaintenanceWindow",
+	}
+	AlertMaintenanceWindowResource = metav1.APIResource{
+		Name:         "alertmaintenancewindows",
+		SingularName: "alertmaintenancewindow",
+		Namespaced:   true,
+
+		Kind: AlertMaintenanceWindowGroupVersionKind.Kind,
+	}
+
+	AlertMaintenanceWindowGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "alertmaintenancewindows",
+	}
+)
+
+func init() {
+	resource.Put(AlertMaintenanceWindowGroupVersionResource)
+}
+
+// Deprecated use v3.AlertMaintenanceWindow instead
+type AlertMaintenanceWindow = v3.AlertMaintenanceWindow
+
+func NewAlertMaintenanceWindow(namespace, name string, obj v3.AlertMaintenanceWindow) *v3.AlertMaintenanceWindow {
+	obj.APIVersion, obj.Kind = AlertMaintenanceWindowGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type AlertMaintenanceWindowHandlerFunc func(key string, obj *v3.AlertMaintenanceWindow) (runtime.Object, error)
+
+type AlertMaintenanceWindowChangeHandlerFunc func(obj *v3.AlertMaintenanceWindow) (runtime.Object, error)
+
+type AlertMaintenanceWindowLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.AlertMaintenanceWindow, err error)
+	Get(namespace, name string) (*v3.AlertMaintenanceWindow, error)
+}
+
+type AlertMaintenanceWindowController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() AlertMaintenanceWindowLister
+	AddHandler(ctx context.Context, name string, handler AlertMaintenanceWindowHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync AlertMaintenanceWindowHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler AlertMaintenanceWindowHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler AlertMaintenanceWindowHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type AlertMaintenanceWindowInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.AlertMaintenanceWindow) (*v3.AlertMaintenanceWindow, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.AlertMaintenanceWindow, error)
+	Get(name string, opts metav1.GetOptions) (*v3.AlertMaintenanceWindow, error)
+	Update(*v3.AlertMaintenanceWindow) (*v3.AlertMaintenanceWindow, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.AlertMaintenanceWindowList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.AlertMaintenanceWindowList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() AlertMaintenanceWindowController
+	AddHandler(ctx context.Context, name string, sync AlertMaintenanceWindowHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync AlertMaintenanceWindowHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle AlertMaintenanceWindowLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle AlertMaintenanceWindowLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync AlertMaintenanceWindowHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync AlertMaintenanceWindowHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle AlertMaintenanceWindowLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle AlertMaintenanceWindowLifecycle)
+}
+
+type alertMaintenanceWindowLister struct {
+	ns         string
+	controller *alertMaintenanceWindowController
+}
+
+func (l *alertMaintenanceWindowLister) List(namespace string, selector labels.Selector) (ret []*v3.AlertMaintenanceWindow, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.AlertMaintenanceWindow))
+	})
+	return
+}
+
+func (l *alertMaintenanceWindowLister) Get(namespace, name string) (*v3.AlertMaintenanceWindow, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    AlertMaintenanceWindowGroupVersionKind.Group,
+			Resource: AlertMaintenanceWindowGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.AlertMaintenanceWindow), nil
+}
+
+type alertMaintenanceWindowController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *alertMaintenanceWindowController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *alertMaintenanceWindowController) Lister() AlertMaintenanceWindowLister {
+	return &alertMaintenanceWindowLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *alertMaintenanceWindowController) AddHandler(ctx context.Context, name string, handler AlertMaintenanceWindowHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AlertMaintenanceWindow); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertMaintenanceWindowController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler AlertMaintenanceWindowHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AlertMaintenanceWindow); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertMaintenanceWindowController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler AlertMaintenanceWindowHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AlertMaintenanceWindow); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertMaintenanceWindowController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler AlertMaintenanceWindowHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AlertMaintenanceWindow); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type alertMaintenanceWindowFactory struct {
+}
+
+func (c alertMaintenanceWindowFactory) Object() runtime.Object {
+	return &v3.AlertMaintenanceWindow{}
+}
+
+func (c alertMaintenanceWindowFactory) List() runtime.Object {
+	return &v3.AlertMaintenanceWindowList{}
+}
+
+func (s *alertMaintenanceWindowClient) Controller() AlertMaintenanceWindowController {
+	genericController := controller.NewGenericController(s.ns, AlertMaintenanceWindowGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(AlertMaintenanceWindowGroupVersionResource, AlertMaintenanceWindowGroupVersionKind.Kind, true))
+
+	return &alertMaintenanceWindowController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type alertMaintenanceWindowClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   AlertMaintenanceWindowController
+}
+
+func (s *alertMaintenanceWindowClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *alertMaintenanceWindowClient) Create(o *v3.AlertMaintenanceWindow) (*v3.AlertMaintenanceWindow, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.AlertMaintenanceWindow), err
+}
+
+func (s *alertMaintenanceWindowClient) Get(name string, opts metav1.GetOptions) (*v3.AlertMaintenanceWindow, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.AlertMaintenanceWindow), err
+}
+
+func (s *alertMaintenanceWindowClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.AlertMaintenanceWindow, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.AlertMaintenanceWindow), err
+}
+
+func (s *alertMaintenanceWindowClient) Update(o *v3.AlertMaintenanceWindow) (*v3.AlertMaintenanceWindow, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.AlertMaintenanceWindow), err
+}
+
+func (s *alertMaintenanceWindowClient) UpdateStatus(o *v3.AlertMaintenanceWindow) (*v3.AlertMaintenanceWindow, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.AlertMaintenanceWindow), err
+}
+
+func (s *alertMaintenanceWindowClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *alertMaintenanceWindowClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *alertMaintenanceWindowClient) List(opts metav1.ListOptions) (*v3.AlertMaintenanceWindowList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.AlertMaintenanceWindowList), err
+}
+
+func (s *alertMaintenanceWindowClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.AlertMaintenanceWindowList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.AlertMaintenanceWindowList), err
+}
+
+func (s *alertMaintenanceWindowClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *alertMaintenanceWindowClient) Patch(o *v3.AlertMaintenanceWindow, patchType types.PatchType, data []byte, subresources ...string) (*v3.AlertMaintenanceWindow, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.AlertMaintenanceWindow), err
+}
+
+func (s *alertMaintenanceWindowClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *alertMaintenanceWindowClient) AddHandler(ctx context.Context, name string, sync AlertMaintenanceWindowHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *alertMaintenanceWindowClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync AlertMaintenanceWindowHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *alertMaintenanceWindowClient) AddLifecycle(ctx context.Context, name string, lifecycle AlertMaintenanceWindowLifecycle) {
+	sync := NewAlertMaintenanceWindowLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *alertMaintenanceWindowClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle AlertMaintenanceWindowLifecycle) {
+	sync := NewAlertMaintenanceWindowLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *alertMaintenanceWindowClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync AlertMaintenanceWindowHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *alertMaintenanceWindowClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync AlertMaintenanceWindowHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *alertMaintenanceWindowClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle AlertMaintenanceWindowLifecycle) {
+	sync := NewAlertMaintenanceWindowLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *alertMaintenanceWindowClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle AlertMaintenanceWindowLifecycle) {
+	sync := NewAlertMaintenanceWindowLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
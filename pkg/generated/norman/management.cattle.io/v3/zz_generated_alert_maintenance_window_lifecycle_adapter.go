@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type AlertMaintenanceWindowLifecycle interface {
+	Create(obj *v3.AlertMaintenanceWindow) (runtime.Object, error)
+	Remove(obj *v3.AlertMaintenanceWindow) (runtime.Object, error)
+	Updated(obj *v3.AlertMaintenanceWindow) (runtime.Object, error)
+}
+
+type alertMaintenanceWindowLifecycleAdapter struct {
+	lifecycle AlertMaintenanceWindowLifecycle
+}
+
+func (w *alertMaintenanceWindowLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *alertMaintenanceWindowLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *alertMaintenanceWindowLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.AlertMaintenanceWindow))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *alertMaintenanceWindowLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.AlertMaintenanceWindow))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *alertMaintenanceWindowLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.AlertMaintenanceWindow))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewAlertMaintenanceWindowLifecycleAdapter(name string, clusterScoped bool, client AlertMaintenanceWindowInterface, l AlertMaintenanceWindowLifecycle) AlertMaintenanceWindowHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(AlertMaintenanceWindowGroupVersionResource)
+	}
+	adapter := &alertMaintenanceWindowLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.AlertMaintenanceWindow) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
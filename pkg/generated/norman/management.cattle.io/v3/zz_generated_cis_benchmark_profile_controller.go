@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	CisBenchmarkProfileGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "CisBenchmarkProfile",
+	}
+	CisBenchmarkProfileResource = metav1.APIResource{
+		Name:         "cisbenchmarkprofiles",
+		SingularName: "cisbenchmarkprofile",
+		Namespaced:   true,
+
+		Kind: CisBenchmarkProfileGroupVersionKind.Kind,
+	}
+
+	CisBenchmarkProfileGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "cisbenchmarkprofiles",
+	}
+)
+
+func init() {
+	resource.Put(CisBenchmarkProfileGroupVersionResource)
+}
+
+// Deprecated use v3.CisBenchmarkProfile instead
+type CisBenchmarkProfile = v3.CisBenchmarkProfile
+
+func NewCisBenchmarkProfile(namespace, name string, obj v3.CisBenchmarkProfile) *v3.CisBenchmarkProfile {
+	obj.APIVersion, obj.Kind = CisBenchmarkProfileGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type CisBenchmarkProfileHandlerFunc func(key string, obj *v3.CisBenchmarkProfile) (runtime.Object, error)
+
+type CisBenchmarkProfileChangeHandlerFunc func(obj *v3.CisBenchmarkProfile) (runtime.Object, error)
+
+type CisBenchmarkProfileLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.CisBenchmarkProfile, err error)
+	Get(namespace, name string) (*v3.CisBenchmarkProfile, error)
+}
+
+type CisBenchmarkProfileController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() CisBenchmarkProfileLister
+	AddHandler(ctx context.Context, name string, handler CisBenchmarkProfileHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync CisBenchmarkProfileHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler CisBenchmarkProfileHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler CisBenchmarkProfileHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type CisBenchmarkProfileInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.CisBenchmarkProfile) (*v3.CisBenchmarkProfile, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.CisBenchmarkProfile, error)
+	Get(name string, opts metav1.GetOptions) (*v3.CisBenchmarkProfile, error)
+	Update(*v3.CisBenchmarkProfile) (*v3.CisBenchmarkProfile, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.CisBenchmarkProfileList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.CisBenchmarkProfileList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() CisBenchmarkProfileController
+	AddHandler(ctx context.Context, name string, sync CisBenchmarkProfileHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync CisBenchmarkProfileHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle CisBenchmarkProfileLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle CisBenchmarkProfileLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync CisBenchmarkProfileHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync CisBenchmarkProfileHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle CisBenchmarkProfileLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle CisBenchmarkProfileLifecycle)
+}
+
+type cisBenchmarkProfileLister struct {
+	ns         string
+	controller *cisBenchmarkProfileController
+}
+
+func (l *cisBenchmarkProfileLister) List(namespace string, selector labels.Selector) (ret []*v3.CisBenchmarkProfile, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.CisBenchmarkProfile))
+	})
+	return
+}
+
+func (l *cisBenchmarkProfileLister) Get(namespace, name string) (*v3.CisBenchmarkProfile, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    CisBenchmarkProfileGroupVersionKind.Group,
+			Resource: CisBenchmarkProfileGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.CisBenchmarkProfile), nil
+}
+
+type cisBenchmarkProfileController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *cisBenchmarkProfileController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *cisBenchmarkProfileController) Lister() CisBenchmarkProfileLister {
+	return &cisBenchmarkProfileLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *cisBenchmarkProfileController) AddHandler(ctx context.Context, name string, handler CisBenchmarkProfileHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CisBenchmarkProfile); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cisBenchmarkProfileController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler CisBenchmarkProfileHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CisBenchmarkProfile); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cisBenchmarkProfileController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler CisBenchmarkProfileHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CisBenchmarkProfile); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cisBenchmarkProfileController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler CisBenchmarkProfileHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CisBenchmarkProfile); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type cisBenchmarkProfileFactory struct {
+}
+
+func (c cisBenchmarkProfileFactory) Object() runtime.Object {
+	return &v3.CisBenchmarkProfile{}
+}
+
+func (c cisBenchmarkProfileFactory) List() runtime.Object {
+	return &v3.CisBenchmarkProfileList{}
+}
+
+func (s *cisBenchmarkProfileClient) Controller() CisBenchmarkProfileController {
+	genericController := controller.NewGenericController(s.ns, CisBenchmarkProfileGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(CisBenchmarkProfileGroupVersionResource, CisBenchmarkProfileGroupVersionKind.Kind, true))
+
+	return &cisBenchmarkProfileController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type cisBenchmarkProfileClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   CisBenchmarkProfileController
+}
+
+func (s *cisBenchmarkProfileClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *cisBenchmarkProfileClient) Create(o *v3.CisBenchmarkProfile) (*v3.CisBenchmarkProfile, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.CisBenchmarkProfile), err
+}
+
+func (s *cisBenchmarkProfileClient) Get(name string, opts metav1.GetOptions) (*v3.CisBenchmarkProfile, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.CisBenchmarkProfile), err
+}
+
+func (s *cisBenchmarkProfileClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.CisBenchmarkProfile, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.CisBenchmarkProfile), err
+}
+
+func (s *cisBenchmarkProfileClient) Update(o *v3.CisBenchmarkProfile) (*v3.CisBenchmarkProfile, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.CisBenchmarkProfile), err
+}
+
+func (s *cisBenchmarkProfileClient) UpdateStatus(o *v3.CisBenchmarkProfile) (*v3.CisBenchmarkProfile, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.CisBenchmarkProfile), err
+}
+
+func (s *cisBenchmarkProfileClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *cisBenchmarkProfileClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *cisBenchmarkProfileClient) List(opts metav1.ListOptions) (*v3.CisBenchmarkProfileList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.CisBenchmarkProfileList), err
+}
+
+func (s *cisBenchmarkProfileClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.CisBenchmarkProfileList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.CisBenchmarkProfileList), err
+}
+
+func (s *cisBenchmarkProfileClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *cisBenchmarkProfileClient) Patch(o *v3.CisBenchmarkProfile, patchType types.PatchType, data []byte, subresources ...string) (*v3.CisBenchmarkProfile, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.CisBenchmarkProfile), err
+}
+
+func (s *cisBenchmarkProfileClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *cisBenchmarkProfileClient) AddHandler(ctx context.Context, name string, sync CisBenchmarkProfileHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *cisBenchmarkProfileClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync CisBenchmarkProfileHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *cisBenchmarkProfileClient) AddLifecycle(ctx context.Context, name string, lifecycle CisBenchmarkProfileLifecycle) {
+	sync := NewCisBenchmarkProfileLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *cisBenchmarkProfileClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle CisBenchmarkProfileLifecycle) {
+	sync := NewCisBenchmarkProfileLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *cisBenchmarkProfileClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync CisBenchmarkProfileHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *cisBenchmarkProfileClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync CisBenchmarkProfileHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *cisBenchmarkProfileClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle CisBenchmarkProfileLifecycle) {
+	sync := NewCisBenchmarkProfileLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *cisBenchmarkProfileClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle CisBenchmarkProfileLifecycle) {
+	sync := NewCisBenchmarkProfileLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type CisBenchmarkProfileLifecycle interface {
+	Create(obj *v3.CisBenchmarkProfile) (runtime.Object, error)
+	Remove(obj *v3.CisBenchmarkProfile) (runtime.Object, error)
+	Updated(obj *v3.CisBenchmarkProfile) (runtime.Object, error)
+}
+
+type cisBenchmarkProfileLifecycleAdapter struct {
+	lifecycle CisBenchmarkProfileLifecycle
+}
+
+func (w *cisBenchmarkProfileLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *cisBenchmarkProfileLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *cisBenchmarkProfileLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.CisBenchmarkProfile))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *cisBenchmarkProfileLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.CisBenchmarkProfile))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *cisBenchmarkProfileLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.CisBenchmarkProfile))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewCisBenchmarkProfileLifecycleAdapter(name string, clusterScoped bool, client CisBenchmarkProfileInterface, l CisBenchmarkProfileLifecycle) CisBenchmarkProfileHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(CisBenchmarkProfileGroupVersionResource)
+	}
+	adapter := &cisBenchmarkProfileLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.CisBenchmarkProfile) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -50,6 +50,7 @@ type Interface interface {
 	ProjectAlertsGetter
 	NotifiersGetter
 	ClusterAlertGroupsGetter
+	AlertMaintenanceWindowsGetter
 	ProjectAlertGroupsGetter
 	ClusterAlertRulesGetter
 	ProjectAlertRulesGetter
@@ -65,6 +66,7 @@ type Interface interface {
 	ClusterScansGetter
 	MonitorMetricsGetter
 	ClusterMonitorGraphsGetter
+	AlertHistoriesGetter
 	ProjectMonitorGraphsGetter
 	CloudCredentialsGetter
 	ClusterTemplatesGetter
@@ -74,6 +76,7 @@ type Interface interface {
 	RkeAddonsGetter
 	CisConfigsGetter
 	CisBenchmarkVersionsGetter
+	CisBenchmarkProfilesGetter
 	FleetWorkspacesGetter
 }
 
@@ -661,6 +664,20 @@ func (c *Client) ClusterAlertGroups(namespace string) ClusterAlertGroupInterface
 	}
 }
 
+type AlertMaintenanceWindowsGetter interface {
+	AlertMaintenanceWindows(namespace string) AlertMaintenanceWindowInterface
+}
+
+func (c *Client) AlertMaintenanceWindows(namespace string) AlertMaintenanceWindowInterface {
+	sharedClient := c.clientFactory.ForResourceKind(AlertMaintenanceWindowGroupVersionResource, AlertMaintenanceWindowGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &AlertMaintenanceWindowResource, AlertMaintenanceWindowGroupVersionKind, alertMaintenanceWindowFactory{})
+	return &alertMaintenanceWindowClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type ProjectAlertGroupsGetter interface {
 	ProjectAlertGroups(namespace string) ProjectAlertGroupInterface
 }
@@ -871,6 +888,20 @@ func (c *Client) ClusterMonitorGraphs(namespace string) ClusterMonitorGraphInter
 	}
 }
 
+type AlertHistoriesGetter interface {
+	AlertHistories(namespace string) AlertHistoryInterface
+}
+
+func (c *Client) AlertHistories(namespace string) AlertHistoryInterface {
+	sharedClient := c.clientFactory.ForResourceKind(AlertHistoryGroupVersionResource, AlertHistoryGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &AlertHistoryResource, AlertHistoryGroupVersionKind, alertHistoryFactory{})
+	return &alertHistoryClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type ProjectMonitorGraphsGetter interface {
 	ProjectMonitorGraphs(namespace string) ProjectMonitorGraphInterface
 }
@@ -997,6 +1028,20 @@ func (c *Client) CisBenchmarkVersions(namespace string) CisBenchmarkVersionInter
 	}
 }
 
+type CisBenchmarkProfilesGetter interface {
+	CisBenchmarkProfiles(namespace string) CisBenchmarkProfileInterface
+}
+
+func (c *Client) CisBenchmarkProfiles(namespace string) CisBenchmarkProfileInterface {
+	sharedClient := c.clientFactory.ForResourceKind(CisBenchmarkProfileGroupVersionResource, CisBenchmarkProfileGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &CisBenchmarkProfileResource, CisBenchmarkProfileGroupVersionKind, cisBenchmarkProfileFactory{})
+	return &cisBenchmarkProfileClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type FleetWorkspacesGetter interface {
 	FleetWorkspaces(namespace string) FleetWorkspaceInterface
 }
@@ -40,6 +40,8 @@ type data struct {
 	Token           string
 	EndpointEnabled bool
 	Nodes           []kubeNode
+	ExecCommand     string
+	AuthProvider    string
 }
 
 func ForBasic(host, username, password string) (string, error) {
@@ -97,6 +99,16 @@ func getDefaultNode(clusterName, clusterID, host string) kubeNode {
 }
 
 func ForTokenBased(clusterName, clusterID, host, token string) (string, error) {
+	return forTokenBased(clusterName, clusterID, host, token, "")
+}
+
+// ForExecBased returns a kubeconfig that does not embed a token. kubectl instead invokes the
+// kubeconfig-exec-command login helper, which logs in to authProvider and caches short-lived tokens.
+func ForExecBased(clusterName, clusterID, host, authProvider string) (string, error) {
+	return forTokenBased(clusterName, clusterID, host, "", authProvider)
+}
+
+func forTokenBased(clusterName, clusterID, host, token, authProvider string) (string, error) {
 	data := &data{
 		ClusterName:     clusterName,
 		ClusterID:       clusterID,
@@ -106,6 +118,8 @@ func ForTokenBased(clusterName, clusterID, host, token string) (string, error) {
 		Token:           token,
 		Nodes:           []kubeNode{getDefaultNode(clusterName, clusterID, host)},
 		EndpointEnabled: false,
+		ExecCommand:     settings.KubeconfigExecCommand.Get(),
+		AuthProvider:    authProvider,
 	}
 
 	if data.ClusterName == "" {
@@ -118,6 +132,16 @@ func ForTokenBased(clusterName, clusterID, host, token string) (string, error) {
 }
 
 func ForClusterTokenBased(cluster *managementv3.Cluster, nodes []*mgmtv3.Node, clusterID, host, token string) (string, error) {
+	return forClusterTokenBased(cluster, nodes, clusterID, host, token, "")
+}
+
+// ForClusterExecBased is the ForExecBased equivalent of ForClusterTokenBased, for clusters with the
+// authorized cluster endpoint enabled.
+func ForClusterExecBased(cluster *managementv3.Cluster, nodes []*mgmtv3.Node, clusterID, host, authProvider string) (string, error) {
+	return forClusterTokenBased(cluster, nodes, clusterID, host, "", authProvider)
+}
+
+func forClusterTokenBased(cluster *managementv3.Cluster, nodes []*mgmtv3.Node, clusterID, host, token, authProvider string) (string, error) {
 	clusterName := cluster.Name
 	if clusterName == "" {
 		clusterName = clusterID
@@ -158,6 +182,8 @@ func ForClusterTokenBased(cluster *managementv3.Cluster, nodes []*mgmtv3.Node, c
 		Token:           token,
 		Nodes:           nodesForConfig,
 		EndpointEnabled: true,
+		ExecCommand:     settings.KubeconfigExecCommand.Get(),
+		AuthProvider:    authProvider,
 	}
 
 	buf := &bytes.Buffer{}
@@ -0,0 +1,99 @@
+package login
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const pollInterval = 2 * time.Second
+
+// browserLogin starts a login in the Rancher UI and polls the public authTokens API until the UI hands back the
+// token. The URL can be opened on any device, so it also works for terminals without a browser.
+func (c *client) browserLogin(ctx context.Context, provider *authProvider) (*loginResponse, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := json.Marshal(key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	requestID := hex.EncodeToString(id)
+
+	query := url.Values{}
+	query.Set("requestId", requestID)
+	query.Set("publicKey", base64.StdEncoding.EncodeToString(publicKey))
+	query.Set("responseType", c.responseType())
+	loginURL := fmt.Sprintf("%s/dashboard/auth/login?%s", c.server, query.Encode())
+
+	if c.opts.OpenBrowser == nil || c.opts.OpenBrowser(loginURL) != nil {
+		fmt.Fprintf(c.opts.Stderr, "Log in with %s by opening the following URL in a browser:\n\n%s\n\n", provider.ID, loginURL)
+	}
+	fmt.Fprintln(c.opts.Stderr, "Waiting for the login to complete...")
+
+	ctx, cancel := context.WithTimeout(ctx, c.opts.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	path := "/v3-public/authTokens/" + requestID
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "timed out waiting for login")
+		case <-ticker.C:
+		}
+
+		resp := &loginResponse{}
+		if err := c.do(ctx, http.MethodGet, path, nil, resp); err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if resp.Token == "" {
+			continue
+		}
+
+		token, err := decryptToken(key, resp.Token)
+		if err != nil {
+			return nil, err
+		}
+		resp.Token = token
+
+		if err := c.do(ctx, http.MethodDelete, path, nil, nil); err != nil {
+			fmt.Fprintf(c.opts.Stderr, "failed to clean up login request: %v\n", err)
+		}
+		return resp, nil
+	}
+}
+
+func decryptToken(key *rsa.PrivateKey, encrypted string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encrypted))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode token")
+	}
+	token, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, data, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt token")
+	}
+	return string(token), nil
+}
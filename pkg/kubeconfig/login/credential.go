@@ -0,0 +1,85 @@
+package login
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	execCredentialAPIVersion = "client.authentication.k8s.io/v1beta1"
+	execCredentialKind       = "ExecCredential"
+
+	// expiryMargin keeps a cached token from being handed to kubectl right before it expires
+	expiryMargin = time.Minute
+)
+
+// ExecCredential is the client.authentication.k8s.io/v1beta1 ExecCredential kubectl expects on stdout.
+type ExecCredential struct {
+	APIVersion string               `json:"apiVersion"`
+	Kind       string               `json:"kind"`
+	Status     ExecCredentialStatus `json:"status"`
+}
+
+type ExecCredentialStatus struct {
+	Token               string     `json:"token"`
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+}
+
+func newExecCredential(token string, expiresAt *time.Time) *ExecCredential {
+	return &ExecCredential{
+		APIVersion: execCredentialAPIVersion,
+		Kind:       execCredentialKind,
+		Status: ExecCredentialStatus{
+			Token:               token,
+			ExpirationTimestamp: expiresAt,
+		},
+	}
+}
+
+func (e *ExecCredential) valid(now time.Time) bool {
+	if e == nil || e.Status.Token == "" {
+		return false
+	}
+	return e.Status.ExpirationTimestamp == nil || e.Status.ExpirationTimestamp.After(now.Add(expiryMargin))
+}
+
+// cacheFile returns the file caching the credential for the server, cluster, user and auth provider in opts.
+func cacheFile(opts *Options) string {
+	digest := sha256.Sum256([]byte(opts.Server + "\x00" + opts.Cluster + "\x00" + opts.User + "\x00" + opts.AuthProvider))
+	return filepath.Join(opts.CacheDir, hex.EncodeToString(digest[:16])+".json")
+}
+
+func loadCredential(opts *Options) *ExecCredential {
+	data, err := ioutil.ReadFile(cacheFile(opts))
+	if err != nil {
+		return nil
+	}
+	credential := &ExecCredential{}
+	if err := json.Unmarshal(data, credential); err != nil {
+		return nil
+	}
+	return credential
+}
+
+func saveCredential(opts *Options, credential *ExecCredential) error {
+	if err := os.MkdirAll(opts.CacheDir, 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(cacheFile(opts), data, 0600)
+}
+
+func deleteCredential(opts *Options) error {
+	if err := os.Remove(cacheFile(opts)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+// Package login implements the kubectl exec credential plugin referenced by exec based kubeconfigs. It logs in to
+// Rancher with the configured auth provider, caches the resulting short-lived kubeconfig token and prints it as an
+// ExecCredential.
+package login
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	kubeconfigResponseType = "kubeconfig"
+	defaultLoginTimeout    = 5 * time.Minute
+)
+
+var (
+	// basicProviders log in with a username and password
+	basicProviders = map[string]bool{
+		"localProvider":           true,
+		"activeDirectoryProvider": true,
+		"openLdapProvider":        true,
+		"freeIpaProvider":         true,
+	}
+	// browserProviders log in through the Rancher UI, which hands the token back through the authTokens API
+	browserProviders = map[string]bool{
+		"pingProvider":         true,
+		"adfsProvider":         true,
+		"keyCloakProvider":     true,
+		"oktaProvider":         true,
+		"shibbolethProvider":   true,
+		"oidcProvider":         true,
+		"keyCloakOIDCProvider": true,
+	}
+)
+
+type Options struct {
+	// Server is the Rancher server URL or host
+	Server string
+	// User names the kubeconfig user the token is for, it only keys the cache
+	User string
+	// Cluster is set when the token must also be valid for the authorized cluster endpoint
+	Cluster string
+	// AuthProvider is the ID or type of the auth provider to log in with, local is used when unset
+	AuthProvider string
+	CacheDir     string
+	CACerts      []byte
+	SkipVerify   bool
+	// Timeout bounds how long a browser login waits for the user
+	Timeout time.Duration
+
+	Stdin  io.Reader
+	Stderr io.Writer
+	// ReadPassword reads a password without echoing it, Stdin is used when unset
+	ReadPassword func() (string, error)
+	// OpenBrowser opens the login URL, the URL is only printed when unset or when it fails
+	OpenBrowser func(url string) error
+}
+
+// Token returns a cached credential when it is still valid and logs in to fetch a new one otherwise.
+func Token(ctx context.Context, opts Options) (*ExecCredential, error) {
+	if opts.Timeout == 0 {
+		opts.Timeout = defaultLoginTimeout
+	}
+
+	if credential := loadCredential(&opts); credential.valid(time.Now()) {
+		return credential, nil
+	}
+
+	client, err := newClient(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := client.login(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveCredential(&opts, credential); err != nil {
+		fmt.Fprintf(opts.Stderr, "failed to cache kubeconfig token: %v\n", err)
+	}
+	return credential, nil
+}
+
+// Logout removes the cached credential so the next call to Token logs in again.
+func Logout(opts Options) error {
+	return deleteCredential(&opts)
+}
+
+type authProvider struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type loginResponse struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type client struct {
+	opts   *Options
+	server string
+	http   *http.Client
+}
+
+func newClient(opts *Options) (*client, error) {
+	server := strings.TrimSuffix(opts.Server, "/")
+	if server == "" {
+		return nil, errors.New("server is required")
+	}
+	if !strings.HasPrefix(server, "https://") && !strings.HasPrefix(server, "http://") {
+		server = "https://" + server
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if len(opts.CACerts) > 0 && !pool.AppendCertsFromPEM(opts.CACerts) {
+		return nil, errors.New("failed to parse CA certificates")
+	}
+
+	return &client{
+		opts:   opts,
+		server: server,
+		http: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{
+					RootCAs:            pool,
+					InsecureSkipVerify: opts.SkipVerify,
+				},
+			},
+		},
+	}, nil
+}
+
+func (c *client) login(ctx context.Context) (*ExecCredential, error) {
+	provider, err := c.authProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *loginResponse
+	switch {
+	case basicProviders[provider.Type]:
+		resp, err = c.basicLogin(ctx, provider)
+	case browserProviders[provider.Type]:
+		resp, err = c.browserLogin(ctx, provider)
+	default:
+		return nil, fmt.Errorf("logging in with auth provider [%s] is not supported, ask your administrator to enable kubeconfig-generate-token", provider.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if resp.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, resp.ExpiresAt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse token expiration [%s]", resp.ExpiresAt)
+		}
+		expiresAt = &t
+	}
+	return newExecCredential(resp.Token, expiresAt), nil
+}
+
+func (c *client) authProvider(ctx context.Context) (*authProvider, error) {
+	var providers struct {
+		Data []authProvider `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v3-public/authProviders", nil, &providers); err != nil {
+		return nil, errors.Wrap(err, "failed to list auth providers")
+	}
+
+	want := c.opts.AuthProvider
+	if want == "" {
+		want = "local"
+	}
+	for _, provider := range providers.Data {
+		if strings.EqualFold(provider.ID, want) || strings.EqualFold(provider.Type, want) {
+			return &provider, nil
+		}
+	}
+	return nil, fmt.Errorf("auth provider [%s] is not enabled", want)
+}
+
+func (c *client) responseType() string {
+	if c.opts.Cluster != "" {
+		return kubeconfigResponseType + "_" + c.opts.Cluster
+	}
+	return kubeconfigResponseType
+}
+
+func (c *client) basicLogin(ctx context.Context, provider *authProvider) (*loginResponse, error) {
+	reader := bufio.NewReader(c.opts.Stdin)
+	fmt.Fprint(c.opts.Stderr, "Username: ")
+	username, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read username")
+	}
+
+	fmt.Fprint(c.opts.Stderr, "Password: ")
+	var password string
+	if c.opts.ReadPassword != nil {
+		password, err = c.opts.ReadPassword()
+		fmt.Fprintln(c.opts.Stderr)
+	} else {
+		password, err = reader.ReadString('\n')
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read password")
+	}
+
+	body := map[string]interface{}{
+		"username":     strings.TrimSpace(username),
+		"password":     strings.TrimRight(password, "\r\n"),
+		"responseType": c.responseType(),
+		"description":  "Kubeconfig token",
+	}
+	resp := &loginResponse{}
+	path := fmt.Sprintf("/v3-public/%ss/%s?action=login", provider.Type, provider.ID)
+	if err := c.do(ctx, http.MethodPost, path, body, resp); err != nil {
+		return nil, errors.Wrap(err, "login failed")
+	}
+	return resp, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, into interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = strings.NewReader(string(data))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		return &statusError{code: resp.StatusCode, url: redact(c.server + path), body: string(data)}
+	}
+	if into == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, into)
+}
+
+type statusError struct {
+	code int
+	url  string
+	body string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s returned %d: %s", e.url, e.code, e.body)
+}
+
+func isNotFound(err error) bool {
+	statusErr, ok := errors.Cause(err).(*statusError)
+	return ok && statusErr.code == http.StatusNotFound
+}
+
+func redact(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	u.RawQuery = ""
+	return u.String()
+}
@@ -0,0 +1,187 @@
+package login
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeRancher struct {
+	sync.Mutex
+	provider   authProvider
+	logins     int
+	authTokens map[string]string
+}
+
+func (f *fakeRancher) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	switch {
+	case req.URL.Path == "/v3-public/authProviders":
+		json.NewEncoder(rw).Encode(map[string]interface{}{"data": []authProvider{f.provider}})
+	case req.URL.Path == "/v3-public/localProviders/local" && req.URL.Query().Get("action") == "login":
+		var body map[string]string
+		json.NewDecoder(req.Body).Decode(&body)
+		if body["username"] != "admin" || body["password"] != "secret" || body["responseType"] != "kubeconfig_c-1" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.logins++
+		json.NewEncoder(rw).Encode(loginResponse{Token: "kubeconfig-admin:abc", ExpiresAt: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)})
+	case strings.HasPrefix(req.URL.Path, "/v3-public/authTokens/"):
+		id := strings.TrimPrefix(req.URL.Path, "/v3-public/authTokens/")
+		token, ok := f.authTokens[id]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if req.Method == http.MethodDelete {
+			delete(f.authTokens, id)
+			return
+		}
+		json.NewEncoder(rw).Encode(loginResponse{Token: token})
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// completeLogin plays the part of the Rancher UI, encrypting the token with the public key from the login URL.
+func (f *fakeRancher) completeLogin(loginURL string) error {
+	u, err := url.Parse(loginURL)
+	if err != nil {
+		return err
+	}
+	keyBytes, err := base64.StdEncoding.DecodeString(u.Query().Get("publicKey"))
+	if err != nil {
+		return err
+	}
+	pubKey := &rsa.PublicKey{}
+	if err := json.Unmarshal(keyBytes, pubKey); err != nil {
+		return err
+	}
+	encrypted, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pubKey, []byte("kubeconfig-user:xyz"), nil)
+	if err != nil {
+		return err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.logins++
+	f.authTokens[u.Query().Get("requestId")] = base64.StdEncoding.EncodeToString(encrypted)
+	return nil
+}
+
+func newTestOptions(t *testing.T, server *httptest.Server, stdin string) Options {
+	return Options{
+		Server:     server.URL,
+		User:       "c-1",
+		Cluster:    "c-1",
+		CacheDir:   t.TempDir(),
+		SkipVerify: true,
+		Stdin:      strings.NewReader(stdin),
+		Stderr:     &bytes.Buffer{},
+	}
+}
+
+func TestBasicLoginIsCached(t *testing.T) {
+	fake := &fakeRancher{provider: authProvider{ID: "local", Type: "localProvider"}}
+	server := httptest.NewTLSServer(fake)
+	defer server.Close()
+
+	opts := newTestOptions(t, server, "admin\nsecret\n")
+	credential, err := Token(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credential.Status.Token != "kubeconfig-admin:abc" || credential.Status.ExpirationTimestamp == nil {
+		t.Fatalf("unexpected credential %+v", credential.Status)
+	}
+	if credential.APIVersion != execCredentialAPIVersion || credential.Kind != execCredentialKind {
+		t.Fatalf("unexpected credential type %s/%s", credential.APIVersion, credential.Kind)
+	}
+
+	if _, err := Token(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+	if fake.logins != 1 {
+		t.Fatalf("expected the cached token to be reused, got %d logins", fake.logins)
+	}
+
+	if err := Logout(opts); err != nil {
+		t.Fatal(err)
+	}
+	opts.Stdin = strings.NewReader("admin\nsecret\n")
+	if _, err := Token(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+	if fake.logins != 2 {
+		t.Fatalf("expected a new login after logout, got %d logins", fake.logins)
+	}
+}
+
+func TestBrowserLogin(t *testing.T) {
+	fake := &fakeRancher{
+		provider:   authProvider{ID: "keycloakoidc", Type: "keyCloakOIDCProvider"},
+		authTokens: map[string]string{},
+	}
+	server := httptest.NewTLSServer(fake)
+	defer server.Close()
+
+	opts := newTestOptions(t, server, "")
+	opts.AuthProvider = "keycloakoidc"
+	opts.OpenBrowser = fake.completeLogin
+
+	credential, err := Token(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credential.Status.Token != "kubeconfig-user:xyz" {
+		t.Fatalf("unexpected token %s", credential.Status.Token)
+	}
+	if len(fake.authTokens) != 0 {
+		t.Fatal("expected the auth token to be deleted after login")
+	}
+}
+
+func TestUnsupportedProvider(t *testing.T) {
+	fake := &fakeRancher{provider: authProvider{ID: "github", Type: "githubProvider"}}
+	server := httptest.NewTLSServer(fake)
+	defer server.Close()
+
+	opts := newTestOptions(t, server, "")
+	opts.AuthProvider = "github"
+	if _, err := Token(context.Background(), opts); err == nil {
+		t.Fatal("expected an error for an unsupported provider")
+	}
+}
+
+func TestCredentialValid(t *testing.T) {
+	now := time.Now()
+	soon := now.Add(30 * time.Second)
+	later := now.Add(time.Hour)
+
+	if newExecCredential("", nil).valid(now) {
+		t.Error("empty token should not be valid")
+	}
+	if !newExecCredential("token", nil).valid(now) {
+		t.Error("token without expiration should be valid")
+	}
+	if newExecCredential("token", &soon).valid(now) {
+		t.Error("token expiring within the margin should not be valid")
+	}
+	if !newExecCredential("token", &later).valid(now) {
+		t.Error("token expiring later should be valid")
+	}
+}
@@ -27,10 +27,13 @@
         - token
         - --server={{.Host}}
         - --user={{.User}}
+{{- if .AuthProvider }}
+        - --auth-provider={{.AuthProvider}}
+{{- end }}
 {{- if .EndpointEnabled }}
         - --cluster={{.ClusterID}}
 {{- end }}
-      command: rancher
+      command: {{.ExecCommand}}
 {{- end }}
 
 contexts:
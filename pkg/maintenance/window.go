@@ -0,0 +1,47 @@
+package maintenance
+
+import (
+	"fmt"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/robfig/cron"
+)
+
+// Validate checks that the window has a parsable cron schedule and a positive duration.
+func Validate(window *v32.MaintenanceWindow) error {
+	if window.DurationMinutes < 1 {
+		return fmt.Errorf("duration must be at least one minute")
+	}
+	if _, err := cron.ParseStandard(window.CronSchedule); err != nil {
+		return fmt.Errorf("error parsing cron schedule %v: %v", window.CronSchedule, err)
+	}
+	return nil
+}
+
+// Open reports whether the window is open at now. When the window is closed the returned duration
+// is the time left until it next opens, otherwise it is the time left until it closes.
+func Open(window *v32.MaintenanceWindow, now time.Time) (bool, time.Duration, error) {
+	start, end, err := Occurrence(window, now)
+	if err != nil {
+		return false, 0, err
+	}
+	if !start.After(now) {
+		return true, end.Sub(now), nil
+	}
+	return false, start.Sub(now), nil
+}
+
+// Occurrence returns the bounds of the occurrence of the window that is open at now, or of the next one
+// if the window is closed.
+func Occurrence(window *v32.MaintenanceWindow, now time.Time) (time.Time, time.Time, error) {
+	schedule, err := cron.ParseStandard(window.CronSchedule)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("error parsing cron schedule %v: %v", window.CronSchedule, err)
+	}
+	duration := time.Duration(window.DurationMinutes) * time.Minute
+
+	// The window is open if it was started by an activation within the last duration.
+	start := schedule.Next(now.Add(-duration))
+	return start, start.Add(duration), nil
+}
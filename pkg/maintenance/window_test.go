@@ -0,0 +1,57 @@
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpen(t *testing.T) {
+	// every day from 02:00 to 04:00
+	window := &v32.MaintenanceWindow{CronSchedule: "0 2 * * *", DurationMinutes: 120}
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		open     bool
+		duration time.Duration
+	}{
+		{name: "before window", now: day.Add(time.Hour), open: false, duration: time.Hour},
+		{name: "window start", now: day.Add(2 * time.Hour), open: true, duration: 2 * time.Hour},
+		{name: "inside window", now: day.Add(3 * time.Hour), open: true, duration: time.Hour},
+		{name: "window end", now: day.Add(4 * time.Hour), open: false, duration: 22 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open, duration, err := Open(window, tt.now)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.open, open)
+			assert.Equal(t, tt.duration, duration)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Validate(&v32.MaintenanceWindow{CronSchedule: "0 2 * * 6", DurationMinutes: 60}))
+	assert.NotNil(t, Validate(&v32.MaintenanceWindow{CronSchedule: "junk", DurationMinutes: 60}))
+	assert.NotNil(t, Validate(&v32.MaintenanceWindow{CronSchedule: "0 2 * * 6"}))
+}
+
+func TestOccurrence(t *testing.T) {
+	window := &v32.MaintenanceWindow{CronSchedule: "0 2 * * *", DurationMinutes: 120}
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.Local)
+
+	start, end, err := Occurrence(window, day.Add(3*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, day.Add(2*time.Hour), start)
+	assert.Equal(t, day.Add(4*time.Hour), end)
+
+	start, end, err = Occurrence(window, day.Add(5*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, day.Add(26*time.Hour), start)
+	assert.Equal(t, day.Add(28*time.Hour), end)
+}
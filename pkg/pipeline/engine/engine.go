@@ -1,8 +1,12 @@
 package engine
 
 import (
+	"fmt"
+
 	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/pipeline/engine/jenkins"
+	"github.com/rancher/rancher/pkg/pipeline/engine/pod"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
 	"github.com/rancher/rancher/pkg/types/config"
 )
 
@@ -24,7 +28,7 @@ func New(cluster *config.UserContext, useCache bool) PipelineEngine {
 	pipelineSettingLister := cluster.Management.Project.PipelineSettings("").Controller().Lister()
 	dialer := cluster.Management.Dialer
 
-	engine := &jenkins.Engine{
+	jenkinsEngine := &jenkins.Engine{
 		UseCache:                   useCache,
 		Secrets:                    secrets,
 		ManagementSecretLister:     managementSecretLister,
@@ -35,12 +39,110 @@ func New(cluster *config.UserContext, useCache bool) PipelineEngine {
 		Dialer:                     dialer,
 		ClusterName:                cluster.ClusterName,
 	}
+	podEngine := &pod.Engine{
+		UseCache:                   useCache,
+		K8sClient:                  cluster.K8sClient,
+		Secrets:                    secrets,
+		PersistentVolumeClaims:     cluster.Core.PersistentVolumeClaims(""),
+		ManagementSecretLister:     managementSecretLister,
+		SourceCodeCredentials:      sourceCodeCredentials,
+		SourceCodeCredentialLister: sourceCodeCredentialLister,
+		PipelineLister:             pipelineLister,
+		PipelineSettingLister:      pipelineSettingLister,
+		Dialer:                     dialer,
+		ClusterName:                cluster.ClusterName,
+	}
 	if useCache {
-		engine.ServiceLister = cluster.Core.Services("").Controller().Lister()
-		engine.PodLister = cluster.Core.Pods("").Controller().Lister()
-		engine.SecretLister = secrets.Controller().Lister()
+		jenkinsEngine.ServiceLister = cluster.Core.Services("").Controller().Lister()
+		jenkinsEngine.PodLister = cluster.Core.Pods("").Controller().Lister()
+		jenkinsEngine.SecretLister = secrets.Controller().Lister()
+		podEngine.ServiceLister = jenkinsEngine.ServiceLister
+		podEngine.PodLister = jenkinsEngine.PodLister
+		podEngine.SecretLister = jenkinsEngine.SecretLister
 	} else {
-		engine.Services = cluster.Core.Services("")
+		jenkinsEngine.Services = cluster.Core.Services("")
+		podEngine.Services = jenkinsEngine.Services
+	}
+	return &selector{
+		pipelineSettingLister: pipelineSettingLister,
+		engines: map[string]PipelineEngine{
+			utils.EngineJenkins: jenkinsEngine,
+			utils.EnginePod:     podEngine,
+		},
+	}
+}
+
+// selector passes each execution to the engine set for its project when it started, which is recorded on the
+// execution so that changing the setting doesn't affect the running executions.
+type selector struct {
+	pipelineSettingLister v3.PipelineSettingLister
+	engines               map[string]PipelineEngine
+}
+
+func (s *selector) engine(execution *v3.PipelineExecution) (string, PipelineEngine, error) {
+	name, err := utils.GetPipelineEngine(s.pipelineSettingLister, execution)
+	if err != nil {
+		return "", nil, err
+	}
+	engine, ok := s.engines[name]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown pipeline engine %q", name)
+	}
+	return name, engine, nil
+}
+
+func (s *selector) PreCheck(execution *v3.PipelineExecution) (bool, error) {
+	_, engine, err := s.engine(execution)
+	if err != nil {
+		return false, err
+	}
+	return engine.PreCheck(execution)
+}
+
+func (s *selector) RunPipelineExecution(execution *v3.PipelineExecution) error {
+	name, engine, err := s.engine(execution)
+	if err != nil {
+		return err
+	}
+	if execution.Annotations == nil {
+		execution.Annotations = map[string]string{}
+	}
+	execution.Annotations[utils.PipelineEngineLabel] = name
+	return engine.RunPipelineExecution(execution)
+}
+
+func (s *selector) RerunExecution(execution *v3.PipelineExecution) error {
+	name, engine, err := s.engine(execution)
+	if err != nil {
+		return err
+	}
+	if execution.Annotations == nil {
+		execution.Annotations = map[string]string{}
+	}
+	execution.Annotations[utils.PipelineEngineLabel] = name
+	return engine.RerunExecution(execution)
+}
+
+func (s *selector) StopExecution(execution *v3.PipelineExecution) error {
+	_, engine, err := s.engine(execution)
+	if err != nil {
+		return err
+	}
+	return engine.StopExecution(execution)
+}
+
+func (s *selector) GetStepLog(execution *v3.PipelineExecution, stage int, step int) (string, error) {
+	_, engine, err := s.engine(execution)
+	if err != nil {
+		return "", err
+	}
+	return engine.GetStepLog(execution, stage, step)
+}
+
+func (s *selector) SyncExecution(execution *v3.PipelineExecution) (bool, error) {
+	_, engine, err := s.engine(execution)
+	if err != nil {
+		return false, err
 	}
-	return engine
+	return engine.SyncExecution(execution)
 }
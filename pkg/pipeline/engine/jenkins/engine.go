@@ -120,19 +120,11 @@ func (j *Engine) getJenkinsClient(execution *v3.PipelineExecution) (*Client, err
 	}
 	token := string(secret.Data[utils.PipelineSecretTokenKey])
 
-	if j.HTTPClient == nil {
-		dial, err := j.Dialer.ClusterDialer(j.ClusterName)
-		if err != nil {
-			return nil, err
-		}
-		j.HTTPClient = &http.Client{
-			Transport: &http.Transport{
-				DialContext: dial,
-			},
-			Timeout: 15 * time.Second,
-		}
+	httpClient, err := j.getHTTPClient()
+	if err != nil {
+		return nil, err
 	}
-	return New(url, user, token, j.HTTPClient)
+	return New(url, user, token, httpClient)
 }
 
 func (j *Engine) RunPipelineExecution(execution *v3.PipelineExecution) error {
@@ -173,6 +165,12 @@ func (j *Engine) RunPipelineExecution(execution *v3.PipelineExecution) error {
 }
 
 func (j *Engine) preparePipeline(execution *v3.PipelineExecution) error {
+	return PrepareRegistryCredentials(execution, j.ManagementSecretLister, j.Secrets)
+}
+
+// PrepareRegistryCredentials copies the credentials of the registries the publish image steps of an execution push
+// to in the pipeline namespace of the project.
+func PrepareRegistryCredentials(execution *v3.PipelineExecution, managementSecretLister v1.SecretLister, secrets v1.SecretInterface) error {
 	var registry string
 	for _, stage := range execution.Spec.PipelineConfig.Stages {
 		for _, step := range stage.Steps {
@@ -184,7 +182,7 @@ func (j *Engine) preparePipeline(execution *v3.PipelineExecution) error {
 					_, projectID := ref.Parse(execution.Spec.ProjectName)
 					registry = fmt.Sprintf("%s.%s-pipeline", utils.LocalRegistry, projectID)
 				}
-				if err := j.prepareRegistryCredential(execution, registry); err != nil {
+				if err := prepareRegistryCredential(execution, registry, managementSecretLister, secrets); err != nil {
 					return err
 				}
 			}
@@ -193,14 +191,14 @@ func (j *Engine) preparePipeline(execution *v3.PipelineExecution) error {
 	return nil
 }
 
-func (j *Engine) prepareRegistryCredential(execution *v3.PipelineExecution, registry string) error {
-	secrets, err := j.ManagementSecretLister.List(execution.Namespace, labels.Everything())
+func prepareRegistryCredential(execution *v3.PipelineExecution, registry string, managementSecretLister v1.SecretLister, secrets v1.SecretInterface) error {
+	projectSecrets, err := managementSecretLister.List(execution.Namespace, labels.Everything())
 	if err != nil {
 		return err
 	}
 	username := ""
 	password := ""
-	for _, s := range secrets {
+	for _, s := range projectSecrets {
 		if s.Type == "kubernetes.io/dockerconfigjson" {
 			m := map[string]interface{}{}
 			if err := json.Unmarshal(s.Data[".dockerconfigjson"], &m); err != nil {
@@ -239,9 +237,9 @@ func (j *Engine) prepareRegistryCredential(execution *v3.PipelineExecution, regi
 			utils.PublishSecretPwKey:   []byte(password),
 		},
 	}
-	_, err = j.Secrets.Create(secret)
+	_, err = secrets.Create(secret)
 	if apierrors.IsAlreadyExists(err) {
-		if _, err := j.Secrets.Update(secret); err != nil {
+		if _, err := secrets.Update(secret); err != nil {
 			return err
 		}
 		return nil
@@ -644,12 +642,7 @@ func (j Engine) setCredential(client *Client, execution *v3.PipelineExecution, c
 	if credentialID == "" {
 		return nil
 	}
-	ns, name := ref.Parse(credentialID)
-	credential, err := j.SourceCodeCredentialLister.Get(ns, name)
-	if err != nil {
-		return err
-	}
-	err = client.getCredential(credentialID)
+	err := client.getCredential(credentialID)
 	if e, ok := err.(*httperror.APIError); !ok || e.Code.Status != http.StatusNotFound {
 		return err
 	}
@@ -659,25 +652,20 @@ func (j Engine) setCredential(client *Client, execution *v3.PipelineExecution, c
 	jenkinsCred.Scope = "GLOBAL"
 	jenkinsCred.ID = execution.Name
 
-	_, projID := ref.Parse(execution.Spec.ProjectName)
-	scpConfig, err := providers.GetSourceCodeProviderConfig(credential.Spec.SourceCodeType, projID)
+	jenkinsCred.Username, jenkinsCred.Password, err = GetGitCredential(execution, credentialID, j.SourceCodeCredentialLister, j.SourceCodeCredentials)
 	if err != nil {
 		return err
 	}
-	remote, err := remote.New(scpConfig)
+	privateKey, _, err := GetGitSSHCredential(credentialID, j.SourceCodeCredentialLister)
 	if err != nil {
 		return err
 	}
-
-	jenkinsCred.Username = credential.Spec.GitLoginName
-	jenkinsCred.Password = credential.Spec.AccessToken
-	if credential.Spec.GitCloneToken != "" {
-		jenkinsCred.Password = credential.Spec.GitCloneToken
-	}
-	if accessToken, err := utils.EnsureAccessToken(j.SourceCodeCredentials, remote, credential); err != nil {
-		return err
-	} else if accessToken != credential.Spec.AccessToken {
-		jenkinsCred.Password = accessToken
+	if privateKey != "" {
+		jenkinsCred.Class = "com.cloudbees.jenkins.plugins.sshcredentials.impl.BasicSSHUserPrivateKey"
+		jenkinsCred.PrivateKeySource = &PrivateKeySource{
+			StaplerClass: "com.cloudbees.jenkins.plugins.sshcredentials.impl.BasicSSHUserPrivateKey$DirectEntryPrivateKeySource",
+			PrivateKey:   privateKey,
+		}
 	}
 
 	bodyContent := map[string]interface{}{}
@@ -691,6 +679,49 @@ func (j Engine) setCredential(client *Client, execution *v3.PipelineExecution, c
 	return client.createCredential(buff.Bytes())
 }
 
+// GetGitCredential returns the user name and password cloning the repository of an execution with a source code
+// credential, refreshing its access token when needed.
+func GetGitCredential(execution *v3.PipelineExecution, credentialID string, sourceCodeCredentialLister v3.SourceCodeCredentialLister,
+	sourceCodeCredentials v3.SourceCodeCredentialInterface) (string, string, error) {
+	ns, name := ref.Parse(credentialID)
+	credential, err := sourceCodeCredentialLister.Get(ns, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	_, projID := ref.Parse(execution.Spec.ProjectName)
+	scpConfig, err := providers.GetSourceCodeProviderConfig(credential.Spec.SourceCodeType, projID)
+	if err != nil {
+		return "", "", err
+	}
+	remote, err := remote.New(scpConfig)
+	if err != nil {
+		return "", "", err
+	}
+
+	password := credential.Spec.AccessToken
+	if credential.Spec.GitCloneToken != "" {
+		password = credential.Spec.GitCloneToken
+	}
+	if accessToken, err := utils.EnsureAccessToken(sourceCodeCredentials, remote, credential); err != nil {
+		return "", "", err
+	} else if accessToken != credential.Spec.AccessToken {
+		password = accessToken
+	}
+	return credential.Spec.GitLoginName, password, nil
+}
+
+// GetGitSSHCredential returns the ssh private key and known hosts of a source code credential, they are empty for the
+// credentials authenticating with tokens.
+func GetGitSSHCredential(credentialID string, sourceCodeCredentialLister v3.SourceCodeCredentialLister) (string, string, error) {
+	ns, name := ref.Parse(credentialID)
+	credential, err := sourceCodeCredentialLister.Get(ns, name)
+	if err != nil {
+		return "", "", err
+	}
+	return credential.Spec.SSHPrivateKey, credential.Spec.SSHKnownHosts, nil
+}
+
 func translatePreparingMessage(log string) string {
 	log = strings.TrimRight(log, "\n")
 	lines := strings.Split(log, "\n")
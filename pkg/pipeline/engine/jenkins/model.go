@@ -262,12 +262,18 @@ type BuildInfo struct {
 }
 
 type Credential struct {
-	Scope       string `json:"scope"`
-	ID          string `json:"id"`
-	Username    string `json:"username"`
-	Password    string `json:"password"`
-	Description string `json:"description"`
-	Class       string `json:"$class"`
+	Scope            string            `json:"scope"`
+	ID               string            `json:"id"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password"`
+	Description      string            `json:"description"`
+	Class            string            `json:"$class"`
+	PrivateKeySource *PrivateKeySource `json:"privateKeySource,omitempty"`
+}
+
+type PrivateKeySource struct {
+	StaplerClass string `json:"stapler-class"`
+	PrivateKey   string `json:"privateKey"`
 }
 
 type WFBuildInfo struct {
@@ -0,0 +1,61 @@
+package jenkins
+
+import (
+	"github.com/pkg/errors"
+	apiv1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	v1 "k8s.io/api/core/v1"
+)
+
+// StepConverter converts the steps of an execution to the containers running them, for the engines that run the
+// steps in their own pods instead of jenkins agents.
+type StepConverter struct {
+	converter *jenkinsPipelineConverter
+}
+
+func NewStepConverter(execution *v3.PipelineExecution, pipelineSettingLister v3.PipelineSettingLister, secretLister apiv1.SecretLister) (*StepConverter, error) {
+	if execution == nil {
+		return nil, errors.New("nil pipeline execution")
+	}
+	if err := utils.ValidPipelineConfig(execution.Spec.PipelineConfig); err != nil {
+		return nil, err
+	}
+	converter, err := initJenkinsPipelineConverter(execution, pipelineSettingLister, secretLister)
+	if err != nil {
+		return nil, err
+	}
+	parsePreservedEnvVar(converter.execution)
+	return &StepConverter{converter: converter}, nil
+}
+
+// StepContainer returns the container of a step. Its command keeps the container running, callers set the command
+// running the step.
+func (s *StepConverter) StepContainer(stage int, step int) (v1.Container, error) {
+	return s.converter.getStepContainer(stage, step)
+}
+
+// ConfigPod adds the image pull secrets of the project to a step pod and the git CA certificates to its containers.
+func (s *StepConverter) ConfigPod(pod *v1.Pod) {
+	if len(s.converter.opts.imagePullSecretNames) > 0 {
+		s.converter.configImagePullSecrets(pod)
+	}
+	if s.converter.opts.gitCaCerts == "" {
+		return
+	}
+	s.converter.injectGitCaCert(pod)
+	for i := range pod.Spec.Containers {
+		if !hasVolumeMount(pod.Spec.Containers[i], utils.GitCaCertVolumeName) {
+			s.converter.injectGitCaCertToContainer(&pod.Spec.Containers[i])
+		}
+	}
+}
+
+func hasVolumeMount(container v1.Container, name string) bool {
+	for _, mount := range container.VolumeMounts {
+		if mount.Name == name {
+			return true
+		}
+	}
+	return false
+}
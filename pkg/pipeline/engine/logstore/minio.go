@@ -0,0 +1,130 @@
+package logstore
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Store keeps the logs of the finished steps of pipeline executions in the minio deployed in the pipeline namespace
+// of the project.
+type Store struct {
+	// UseCache affects resources that is not cached in follower instances of HA mode
+	UseCache      bool
+	ServiceLister v1.ServiceLister
+	Services      v1.ServiceInterface
+	SecretLister  v1.SecretLister
+	Secrets       v1.SecretInterface
+	// HTTPClient returns the client reaching the services of the cluster
+	HTTPClient func() (*http.Client, error)
+}
+
+func (s *Store) getMinioURL(ns string) (string, error) {
+	var (
+		svc *corev1.Service
+		err error
+	)
+	if s.UseCache {
+		svc, err = s.ServiceLister.Get(ns, utils.MinioName)
+	} else {
+		svc, err = s.Services.GetNamespaced(ns, utils.MinioName, metav1.GetOptions{})
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%d", svc.Spec.ClusterIP, utils.MinioPort), nil
+}
+
+func (s *Store) getMinioClient(ns string) (*minio.Client, error) {
+	url, err := s.getMinioURL(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	user := utils.PipelineSecretDefaultUser
+	var secret *corev1.Secret
+	if s.UseCache {
+		secret, err = s.SecretLister.Get(ns, utils.PipelineSecretName)
+	} else {
+		secret, err = s.Secrets.GetNamespaced(ns, utils.PipelineSecretName, metav1.GetOptions{})
+	}
+	if err != nil || secret.Data == nil {
+		return nil, fmt.Errorf("error get minio token - %v", err)
+	}
+	token := string(secret.Data[utils.PipelineSecretTokenKey])
+
+	httpClient, err := s.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// https://github.com/minio/minio-go/blob/0be3a44757352b6e617ef00eb47829bce29baab1/api.go#L153
+	opt := minio.Options{
+		Creds:        credentials.NewStaticV4(user, token, ""),
+		Secure:       false,
+		Region:       "",
+		BucketLookup: minio.BucketLookupAuto,
+		Transport:    httpClient.Transport,
+	}
+	return minio.New(url, &opt)
+}
+
+func logName(execution *v3.PipelineExecution, stage int, step int) string {
+	return fmt.Sprintf("%s-%d-%d", execution.Name, stage, step)
+}
+
+// GetStepLog returns the saved log of a step.
+func (s *Store) GetStepLog(execution *v3.PipelineExecution, stage int, step int) (string, error) {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	client, err := s.getMinioClient(ns)
+	if err != nil {
+		return "", err
+	}
+
+	reader, err := client.GetObject(context.TODO(), utils.MinioLogBucket, logName(execution, stage, step), minio.GetObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// SaveStepLog saves the log of a finished step.
+func (s *Store) SaveStepLog(execution *v3.PipelineExecution, stage int, step int, message string) error {
+	bucketName := utils.MinioLogBucket
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	client, err := s.getMinioClient(ns)
+	if err != nil {
+		return err
+	}
+	//Make Bucket
+	exists, err := client.BucketExists(context.TODO(), bucketName)
+	if err != nil {
+		logrus.Error(err)
+	}
+	if !exists {
+		makeBucketOpt := minio.MakeBucketOptions{Region: utils.MinioBucketLocation}
+		if err := client.MakeBucket(context.TODO(), bucketName, makeBucketOpt); err != nil {
+			return err
+		}
+	}
+
+	_, err = client.PutObject(context.TODO(), bucketName, logName(execution, stage, step), strings.NewReader(message), int64(len(message)), minio.PutObjectOptions{})
+	return err
+}
@@ -0,0 +1,496 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/engine/jenkins"
+	"github.com/rancher/rancher/pkg/pipeline/engine/logstore"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Engine runs each step of a pipeline execution in its own pod in the pipeline namespace of the project, without
+// jenkins. The stages run one after the other and the steps of a stage run in parallel, sharing a workspace volume
+// that holds the cloned repository.
+type Engine struct {
+	// UseCache affects resources that is not cached in follower instances of HA mode
+	UseCache      bool
+	HTTPClient    *http.Client
+	K8sClient     kubernetes.Interface
+	PodLister     v1.PodLister
+	ServiceLister v1.ServiceLister
+	Services      v1.ServiceInterface
+
+	Secrets                    v1.SecretInterface
+	SecretLister               v1.SecretLister
+	ManagementSecretLister     v1.SecretLister
+	PersistentVolumeClaims     v1.PersistentVolumeClaimInterface
+	SourceCodeCredentials      v3.SourceCodeCredentialInterface
+	SourceCodeCredentialLister v3.SourceCodeCredentialLister
+	PipelineLister             v3.PipelineLister
+	PipelineSettingLister      v3.PipelineSettingLister
+
+	ClusterName string
+	Dialer      dialer.Factory
+}
+
+func (p *Engine) getHTTPClient() (*http.Client, error) {
+	if p.HTTPClient == nil {
+		dial, err := p.Dialer.ClusterDialer(p.ClusterName)
+		if err != nil {
+			return nil, err
+		}
+		p.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				DialContext: dial,
+			},
+			Timeout: 15 * time.Second,
+		}
+	}
+	return p.HTTPClient, nil
+}
+
+func (p *Engine) logStore() *logstore.Store {
+	return &logstore.Store{
+		UseCache:      p.UseCache,
+		ServiceLister: p.ServiceLister,
+		Services:      p.Services,
+		SecretLister:  p.SecretLister,
+		Secrets:       p.Secrets,
+		HTTPClient:    p.getHTTPClient,
+	}
+}
+
+// PreCheck waits for the minio keeping the step logs, the steps don't need other workloads.
+func (p *Engine) PreCheck(execution *v3.PipelineExecution) (bool, error) {
+	set := labels.Set(map[string]string{utils.LabelKeyApp: utils.MinioName})
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	pods, err := p.PodLister.List(ns, set.AsSelector())
+	if err != nil {
+		return false, err
+	}
+	if len(pods) <= 0 {
+		return false, errors.New("minio pod not found")
+	}
+
+	for _, cond := range pods[0].Status.Conditions {
+		if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (p *Engine) RunPipelineExecution(execution *v3.PipelineExecution) error {
+	logrus.Debug("start RunPipelineExecution")
+	converter, err := jenkins.NewStepConverter(execution, p.PipelineSettingLister, p.SecretLister)
+	if err != nil {
+		return err
+	}
+	if err := p.prepareWorkspace(execution); err != nil {
+		return err
+	}
+	if err := p.prepareGitCredential(execution); err != nil {
+		return err
+	}
+	if err := jenkins.PrepareRegistryCredentials(execution, p.ManagementSecretLister, p.Secrets); err != nil {
+		return err
+	}
+	return p.startStage(converter, execution, 0)
+}
+
+func (p *Engine) prepareWorkspace(execution *v3.PipelineExecution) error {
+	_, projectID := ref.Parse(execution.Spec.ProjectName)
+	size, err := utils.GetPipelineSettingValue(p.PipelineSettingLister, projectID, utils.SettingWorkspaceSize)
+	if err != nil {
+		return err
+	}
+	if size == "" {
+		size = utils.WorkspaceSizeDefault
+	}
+	storageClass, err := utils.GetPipelineSettingValue(p.PipelineSettingLister, projectID, utils.SettingWorkspaceStorageClass)
+	if err != nil {
+		return err
+	}
+	pvc, err := getWorkspace(execution, size, storageClass)
+	if err != nil {
+		return err
+	}
+	if _, err := p.PersistentVolumeClaims.Create(pvc); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrap(err, "Error creating the workspace volume")
+	}
+	return nil
+}
+
+func (p *Engine) prepareGitCredential(execution *v3.PipelineExecution) error {
+	ns, name := ref.Parse(execution.Spec.PipelineName)
+	pipeline, err := p.PipelineLister.Get(ns, name)
+	if err != nil {
+		return err
+	}
+	if pipeline.Spec.SourceCodeCredentialName == "" {
+		return nil
+	}
+	username, password, err := jenkins.GetGitCredential(execution, pipeline.Spec.SourceCodeCredentialName, p.SourceCodeCredentialLister, p.SourceCodeCredentials)
+	if err != nil {
+		return err
+	}
+	sshKey, knownHosts, err := jenkins.GetGitSSHCredential(pipeline.Spec.SourceCodeCredentialName, p.SourceCodeCredentialLister)
+	if err != nil {
+		return err
+	}
+	// the step pods are not kept, so a host key accepted on first use would be accepted again on every clone
+	if sshKey != "" && strings.TrimSpace(knownHosts) == "" {
+		return fmt.Errorf("source code credential %s has an ssh key but no known hosts, add the host keys of the git server to it",
+			pipeline.Spec.SourceCodeCredentialName)
+	}
+	secret := getGitSecret(execution, username, password, sshKey, knownHosts)
+	if _, err := p.Secrets.Create(secret); apierrors.IsAlreadyExists(err) {
+		_, err = p.Secrets.Update(secret)
+		return err
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// startStage creates the pods of the steps of a stage. Stages and steps whose conditions don't match the execution
+// are skipped, in which case the next stage is started.
+func (p *Engine) startStage(converter *jenkins.StepConverter, execution *v3.PipelineExecution, stage int) error {
+	now := time.Now().Format(time.RFC3339)
+	for ; stage < len(execution.Spec.PipelineConfig.Stages); stage++ {
+		stageConfig := execution.Spec.PipelineConfig.Stages[stage]
+		stageStatus := &execution.Status.Stages[stage]
+		started := false
+		for step := range stageConfig.Steps {
+			if !utils.MatchAll(stageConfig.When, execution) || !utils.MatchAll(stageConfig.Steps[step].When, execution) {
+				stageStatus.Steps[step].State = utils.StateSkipped
+				continue
+			}
+			pod, err := getStepPod(converter, execution, stage, step)
+			if err != nil {
+				return err
+			}
+			if _, err := p.K8sClient.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+				return errors.Wrapf(err, "Error creating the pod of step %d of stage '%s'", step, stageConfig.Name)
+			}
+			started = true
+		}
+		if started {
+			return nil
+		}
+		stageStatus.State = utils.StateSkipped
+		stageStatus.Ended = now
+	}
+
+	execution.Status.ExecutionState = utils.StateSuccess
+	execution.Status.Ended = now
+	execution.Labels[utils.PipelineFinishLabel] = "true"
+	v32.PipelineExecutionConditionProvisioned.True(execution)
+	v32.PipelineExecutionConditionBuilt.True(execution)
+	return nil
+}
+
+func (p *Engine) RerunExecution(execution *v3.PipelineExecution) error {
+	return p.RunPipelineExecution(execution)
+}
+
+// StopExecution saves the logs of the running steps and removes the pods, workspace and credentials of an
+// execution.
+func (p *Engine) StopExecution(execution *v3.PipelineExecution) error {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	selector := executionSelector(execution).String()
+	pods, err := p.K8sClient.CoreV1().Pods(ns).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		stage, step, ok := stepIndex(execution, &pod)
+		if ok && pod.Status.Phase == corev1.PodRunning {
+			if err := p.saveStepLog(execution, stage, step); err != nil {
+				logrus.Warnf("failed to save the log of step %d-%d of execution %s: %v", stage, step, execution.Name, err)
+			}
+		}
+		if err := p.K8sClient.CoreV1().Pods(ns).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	if err := p.PersistentVolumeClaims.DeleteNamespaced(ns, workspaceName(execution), &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := p.Secrets.DeleteNamespaced(ns, gitSecretName(execution), &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// SyncExecution updates the states of the steps of the running stage from their pods, and starts the next stage
+// once all its steps succeeded.
+func (p *Engine) SyncExecution(execution *v3.PipelineExecution) (bool, error) {
+	if utils.IsFinishState(execution.Status.ExecutionState) {
+		return false, nil
+	}
+	stage := runningStage(execution)
+	if stage < 0 {
+		return false, nil
+	}
+
+	timeout := utils.DefaultTimeout
+	if execution.Spec.PipelineConfig.Timeout > 0 {
+		timeout = execution.Spec.PipelineConfig.Timeout
+	}
+	if started, err := time.Parse(time.RFC3339, execution.Status.Started); err == nil && time.Since(started) > time.Duration(timeout)*time.Minute {
+		failExecution(execution, stage, time.Now().Format(time.RFC3339), fmt.Sprintf("Timed out after %d minutes", timeout))
+		return true, nil
+	}
+
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	pods, err := p.PodLister.List(ns, executionSelector(execution))
+	if err != nil {
+		return false, err
+	}
+	stepPods := map[int]*corev1.Pod{}
+	for _, pod := range pods {
+		if podStage, step, ok := stepIndex(execution, pod); ok && podStage == stage {
+			stepPods[step] = pod
+		}
+	}
+
+	updated := false
+	stageStatus := &execution.Status.Stages[stage]
+	for step := range stageStatus.Steps {
+		state := stageStatus.Steps[step].State
+		if state != utils.StateWaiting && state != utils.StateBuilding {
+			continue
+		}
+		pod := stepPods[step]
+		if pod == nil {
+			if state == utils.StateBuilding {
+				updated = true
+				failExecution(execution, stage, time.Now().Format(time.RFC3339), fmt.Sprintf("The pod of step %d of stage '%s' is gone", step, execution.Spec.PipelineConfig.Stages[stage].Name))
+				return updated, nil
+			}
+			continue
+		}
+
+		started, ended := podTimes(pod)
+		switch pod.Status.Phase {
+		case corev1.PodRunning:
+			if state != utils.StateBuilding {
+				updated = true
+				buildingStep(execution, stage, step, started)
+			}
+		case corev1.PodSucceeded:
+			updated = true
+			buildingStep(execution, stage, step, started)
+			stageStatus.Steps[step].State = utils.StateSuccess
+			stageStatus.Steps[step].Ended = ended
+			if err := p.saveStepLog(execution, stage, step); err != nil {
+				return false, err
+			}
+		case corev1.PodFailed:
+			updated = true
+			buildingStep(execution, stage, step, started)
+			stageStatus.Steps[step].State = utils.StateFailed
+			stageStatus.Steps[step].Ended = ended
+			if err := p.saveStepLog(execution, stage, step); err != nil {
+				return false, err
+			}
+			failExecution(execution, stage, ended, fmt.Sprintf("Got FAILED status in '%s' stage", execution.Spec.PipelineConfig.Stages[stage].Name))
+			return updated, nil
+		case corev1.PodPending:
+			if message := pendingMessage(pod); message != "" && v32.PipelineExecutionConditionProvisioned.GetMessage(execution) != message {
+				updated = true
+				v32.PipelineExecutionConditionProvisioned.Message(execution, message)
+			}
+		}
+	}
+
+	if stageFinished(stageStatus) {
+		updated = true
+		stageStatus.State = utils.StateSuccess
+		stageStatus.Ended = latestEnd(stageStatus)
+		v32.PipelineExecutionConditionProvisioned.True(execution)
+		converter, err := jenkins.NewStepConverter(execution, p.PipelineSettingLister, p.SecretLister)
+		if err != nil {
+			return false, err
+		}
+		if err := p.startStage(converter, execution, stage+1); err != nil {
+			return false, err
+		}
+	}
+	return updated, nil
+}
+
+func (p *Engine) GetStepLog(execution *v3.PipelineExecution, stage int, step int) (string, error) {
+	if len(execution.Status.Stages) <= stage || len(execution.Status.Stages[stage].Steps) <= step {
+		return "", errors.New("invalid step index")
+	}
+	curStep := execution.Status.Stages[stage].Steps[step]
+	if curStep.State == utils.StateWaiting || curStep.State == utils.StateSkipped || curStep.State == "" {
+		return "", nil
+	} else if curStep.State != utils.StateBuilding {
+		return p.logStore().GetStepLog(execution, stage, step)
+	}
+	return p.getStepLogFromPod(execution, stage, step)
+}
+
+func (p *Engine) getStepLogFromPod(execution *v3.PipelineExecution, stage int, step int) (string, error) {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	content, err := p.K8sClient.CoreV1().Pods(ns).GetLogs(stepPodName(execution, stage, step), &corev1.PodLogOptions{}).DoRaw(context.TODO())
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func (p *Engine) saveStepLog(execution *v3.PipelineExecution, stage int, step int) error {
+	message, err := p.getStepLogFromPod(execution, stage, step)
+	if err != nil {
+		return err
+	}
+	return p.logStore().SaveStepLog(execution, stage, step, message)
+}
+
+// runningStage returns the first stage that is not finished, or -1.
+func runningStage(execution *v3.PipelineExecution) int {
+	for i, stage := range execution.Status.Stages {
+		if stage.State == utils.StateWaiting || stage.State == utils.StateBuilding {
+			return i
+		}
+	}
+	return -1
+}
+
+func stepIndex(execution *v3.PipelineExecution, pod *corev1.Pod) (int, int, bool) {
+	var stage, step int
+	if _, err := fmt.Sscanf(strings.TrimPrefix(pod.Name, execution.Name+"-"), "%d-%d", &stage, &step); err != nil {
+		return 0, 0, false
+	}
+	if stage >= len(execution.Status.Stages) || step >= len(execution.Status.Stages[stage].Steps) {
+		return 0, 0, false
+	}
+	return stage, step, true
+}
+
+func stageFinished(stage *v32.StageStatus) bool {
+	for _, step := range stage.Steps {
+		if step.State != utils.StateSuccess && step.State != utils.StateSkipped {
+			return false
+		}
+	}
+	return true
+}
+
+func latestEnd(stage *v32.StageStatus) string {
+	ended := ""
+	for _, step := range stage.Steps {
+		if step.Ended > ended {
+			ended = step.Ended
+		}
+	}
+	if ended == "" {
+		ended = time.Now().Format(time.RFC3339)
+	}
+	return ended
+}
+
+func podTimes(pod *corev1.Pod) (string, string) {
+	now := time.Now().Format(time.RFC3339)
+	started, ended := now, now
+	for _, status := range pod.Status.ContainerStatuses {
+		if running := status.State.Running; running != nil {
+			started = running.StartedAt.Format(time.RFC3339)
+		} else if terminated := status.State.Terminated; terminated != nil {
+			started = terminated.StartedAt.Format(time.RFC3339)
+			ended = terminated.FinishedAt.Format(time.RFC3339)
+		}
+	}
+	return started, ended
+}
+
+func pendingMessage(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if waiting := status.State.Waiting; waiting != nil && waiting.Reason != "" {
+			return fmt.Sprintf("Step container is waiting: %s", waiting.Reason)
+		}
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Message != "" {
+			return cond.Message
+		}
+	}
+	return ""
+}
+
+func buildingStep(execution *v3.PipelineExecution, stage int, step int, started string) {
+	stageStatus := &execution.Status.Stages[stage]
+	stageStatus.Steps[step].State = utils.StateBuilding
+	if stageStatus.Steps[step].Started == "" {
+		stageStatus.Steps[step].Started = started
+	}
+	if stageStatus.State == utils.StateWaiting {
+		stageStatus.State = utils.StateBuilding
+	}
+	if stageStatus.Started == "" {
+		stageStatus.Started = started
+	}
+	if execution.Status.ExecutionState == utils.StateWaiting {
+		execution.Status.ExecutionState = utils.StateBuilding
+	}
+
+	v32.PipelineExecutionConditionProvisioned.True(execution)
+	v32.PipelineExecutionConditionBuilt.CreateUnknownIfNotExists(execution)
+	v32.PipelineExecutionConditionBuilt.Message(execution, fmt.Sprintf("Running '%s' stage", execution.Spec.PipelineConfig.Stages[stage].Name))
+}
+
+// failExecution fails the execution in a stage. The steps still running are aborted and the steps of the next stages
+// won't run.
+func failExecution(execution *v3.PipelineExecution, stage int, ended string, message string) {
+	execution.Status.Stages[stage].State = utils.StateFailed
+	if execution.Status.Stages[stage].Ended == "" {
+		execution.Status.Stages[stage].Ended = ended
+	}
+	execution.Status.ExecutionState = utils.StateFailed
+	if execution.Status.Ended == "" {
+		execution.Status.Ended = ended
+	}
+	execution.Labels[utils.PipelineFinishLabel] = "true"
+	if v32.PipelineExecutionConditionProvisioned.IsUnknown(execution) {
+		v32.PipelineExecutionConditionProvisioned.True(execution)
+	}
+	v32.PipelineExecutionConditionBuilt.False(execution)
+	v32.PipelineExecutionConditionBuilt.Message(execution, message)
+
+	for i := range execution.Status.Stages {
+		stageStatus := &execution.Status.Stages[i]
+		if stageStatus.State == utils.StateWaiting {
+			stageStatus.State = ""
+		}
+		for j := range stageStatus.Steps {
+			switch stageStatus.Steps[j].State {
+			case utils.StateWaiting:
+				stageStatus.Steps[j].State = ""
+			case utils.StateBuilding:
+				stageStatus.Steps[j].State = utils.StateAborted
+				stageStatus.Steps[j].Ended = ended
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package pod
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newExecution() *v3.PipelineExecution {
+	return &v3.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "p-abc-1",
+			Labels: map[string]string{},
+		},
+		Spec: v32.PipelineExecutionSpec{
+			PipelineConfig: v32.PipelineConfig{
+				Stages: []v32.Stage{
+					{Name: "clone", Steps: []v32.Step{{}}},
+					{Name: "build", Steps: []v32.Step{{}, {}}},
+					{Name: "deploy", Steps: []v32.Step{{}}},
+				},
+			},
+		},
+		Status: v32.PipelineExecutionStatus{
+			ExecutionState: utils.StateBuilding,
+			Stages: []v32.StageStatus{
+				{State: utils.StateSuccess, Steps: []v32.StepStatus{{State: utils.StateSuccess}}},
+				{State: utils.StateBuilding, Steps: []v32.StepStatus{{State: utils.StateBuilding}, {State: utils.StateFailed}}},
+				{State: utils.StateWaiting, Steps: []v32.StepStatus{{State: utils.StateWaiting}}},
+			},
+		},
+	}
+}
+
+func TestStepIndex(t *testing.T) {
+	execution := newExecution()
+	tests := []struct {
+		name  string
+		stage int
+		step  int
+		ok    bool
+	}{
+		{name: "p-abc-1-1-1", stage: 1, step: 1, ok: true},
+		{name: "p-abc-1-2-0", stage: 2, step: 0, ok: true},
+		{name: "p-abc-1-1-2", ok: false},
+		{name: "p-abc-1-workspace", ok: false},
+	}
+	for _, test := range tests {
+		stage, step, ok := stepIndex(execution, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: test.name}})
+		if ok != test.ok || stage != test.stage || step != test.step {
+			t.Errorf("stepIndex(%s) = %d, %d, %v, expected %d, %d, %v", test.name, stage, step, ok, test.stage, test.step, test.ok)
+		}
+	}
+}
+
+func TestFailExecution(t *testing.T) {
+	execution := newExecution()
+	failExecution(execution, 1, "2020-01-01T00:00:00Z", "failed")
+
+	if execution.Status.ExecutionState != utils.StateFailed {
+		t.Errorf("expected execution state %s, got %s", utils.StateFailed, execution.Status.ExecutionState)
+	}
+	if execution.Labels[utils.PipelineFinishLabel] != "true" {
+		t.Error("expected the execution to be finished")
+	}
+	if state := execution.Status.Stages[1].Steps[0].State; state != utils.StateAborted {
+		t.Errorf("expected running step to be aborted, got %s", state)
+	}
+	if state := execution.Status.Stages[1].Steps[1].State; state != utils.StateFailed {
+		t.Errorf("expected failed step to stay failed, got %s", state)
+	}
+	if state := execution.Status.Stages[2].Steps[0].State; state != "" {
+		t.Errorf("expected waiting step to be cleared, got %s", state)
+	}
+	if runningStage(execution) != -1 {
+		t.Error("expected no running stage")
+	}
+}
+
+func TestStageFinished(t *testing.T) {
+	stage := &v32.StageStatus{Steps: []v32.StepStatus{{State: utils.StateSuccess}, {State: utils.StateSkipped}}}
+	if !stageFinished(stage) {
+		t.Error("expected stage with succeeded and skipped steps to be finished")
+	}
+	stage.Steps[1].State = utils.StateBuilding
+	if stageFinished(stage) {
+		t.Error("expected stage with a running step not to be finished")
+	}
+}
@@ -0,0 +1,213 @@
+package pod
+
+import (
+	"fmt"
+	"strconv"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/engine/jenkins"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	gitUserKey       = "username"
+	gitPasswordKey   = "password"
+	gitSSHKey        = "sshPrivateKey"
+	gitKnownHostsKey = "sshKnownHosts"
+
+	cloneScript = `set -e
+if [ -n "${GIT_SSH_KEY}" ]; then
+  mkdir -p /tmp/.git-ssh
+  printf '%s\n' "${GIT_SSH_KEY}" > /tmp/.git-ssh/id && chmod 600 /tmp/.git-ssh/id
+  printf '%s\n' "${GIT_KNOWN_HOSTS}" > /tmp/.git-ssh/known_hosts
+  export GIT_SSH_COMMAND="ssh -i /tmp/.git-ssh/id -o IdentitiesOnly=yes -o UserKnownHostsFile=/tmp/.git-ssh/known_hosts -o StrictHostKeyChecking=yes"
+fi
+git init -q .
+git config credential.helper '!f() { echo "username=${GIT_USERNAME}"; echo "password=${GIT_PASSWORD}"; }; f'
+git fetch -q "${CICD_GIT_URL}" "+${CICD_GIT_REF}:refs/remotes/local/temp"
+git checkout -q -f local/temp
+git log -1 --format='Checked out %H %s'`
+)
+
+func stepPodName(execution *v3.PipelineExecution, stage int, step int) string {
+	return fmt.Sprintf("%s-%d-%d", execution.Name, stage, step)
+}
+
+func workspaceName(execution *v3.PipelineExecution) string {
+	return execution.Name + "-" + utils.WorkspaceVolumeName
+}
+
+func gitSecretName(execution *v3.PipelineExecution) string {
+	return execution.Name + "-git"
+}
+
+func executionSelector(execution *v3.PipelineExecution) labels.Selector {
+	return labels.Set{
+		utils.LabelKeyApp:       utils.StepPodName,
+		utils.LabelKeyExecution: execution.Name,
+	}.AsSelector()
+}
+
+// stepCommand returns the command running a step in its container, they match the commands of the jenkins agents.
+func stepCommand(step *v32.Step) []string {
+	var script string
+	switch {
+	case step.SourceCodeConfig != nil:
+		script = cloneScript
+	case step.RunScriptConfig != nil:
+		script = step.RunScriptConfig.ShellScript
+	case step.PublishImageConfig != nil:
+		script = "/usr/local/bin/dockerd-entrypoint.sh /bin/drone-docker"
+	case step.ApplyYamlConfig != nil:
+		script = "kube-apply"
+	case step.PublishCatalogConfig != nil:
+		script = "publish-catalog"
+	case step.ApplyAppConfig != nil:
+		script = "apply-app"
+	}
+	return []string{"sh", "-c", script}
+}
+
+func getWorkspace(execution *v3.PipelineExecution, size string, storageClass string) (*corev1.PersistentVolumeClaim, error) {
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workspace size %q: %v", size, err)
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workspaceName(execution),
+			Namespace: utils.GetPipelineCommonName(execution.Spec.ProjectName),
+			Labels: map[string]string{
+				utils.LabelKeyApp:       utils.StepPodName,
+				utils.LabelKeyExecution: execution.Name,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: quantity,
+				},
+			},
+		},
+	}
+	if storageClass != "" {
+		pvc.Spec.StorageClassName = &storageClass
+	}
+	return pvc, nil
+}
+
+func getGitSecret(execution *v3.PipelineExecution, username string, password string, sshKey string, knownHosts string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gitSecretName(execution),
+			Namespace: utils.GetPipelineCommonName(execution.Spec.ProjectName),
+			Labels: map[string]string{
+				utils.LabelKeyApp:       utils.StepPodName,
+				utils.LabelKeyExecution: execution.Name,
+			},
+		},
+		StringData: map[string]string{
+			gitUserKey:       username,
+			gitPasswordKey:   password,
+			gitSSHKey:        sshKey,
+			gitKnownHostsKey: knownHosts,
+		},
+	}
+}
+
+func gitSecretEnv(execution *v3.PipelineExecution, name string, key string) corev1.EnvVar {
+	optional := true
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: gitSecretName(execution),
+			},
+			Key:      key,
+			Optional: &optional,
+		}},
+	}
+}
+
+// getStepPod returns the pod running a step. The pods of an execution share its workspace volume, they are
+// scheduled on the same node so that the volume can be mounted by the steps running in parallel.
+func getStepPod(converter *jenkins.StepConverter, execution *v3.PipelineExecution, stage int, step int) (*corev1.Pod, error) {
+	container, err := converter.StepContainer(stage, step)
+	if err != nil {
+		return nil, err
+	}
+	stepConfig := &execution.Spec.PipelineConfig.Stages[stage].Steps[step]
+	container.Command = stepCommand(stepConfig)
+	container.TTY = false
+	container.WorkingDir = utils.WorkspacePath
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      utils.WorkspaceVolumeName,
+		MountPath: utils.WorkspacePath,
+	})
+	if stepConfig.SourceCodeConfig != nil {
+		container.Env = append(container.Env,
+			gitSecretEnv(execution, "GIT_USERNAME", gitUserKey),
+			gitSecretEnv(execution, "GIT_PASSWORD", gitPasswordKey),
+			gitSecretEnv(execution, "GIT_SSH_KEY", gitSSHKey),
+			gitSecretEnv(execution, "GIT_KNOWN_HOSTS", gitKnownHostsKey))
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      stepPodName(execution, stage, step),
+			Namespace: utils.GetPipelineCommonName(execution.Spec.ProjectName),
+			Labels: map[string]string{
+				utils.LabelKeyApp:       utils.StepPodName,
+				utils.LabelKeyExecution: execution.Name,
+				utils.LabelKeyStage:     strconv.Itoa(stage),
+				utils.LabelKeyStep:      strconv.Itoa(step),
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:      corev1.RestartPolicyNever,
+			ServiceAccountName: utils.JenkinsName,
+			Containers:         []corev1.Container{container},
+			Affinity: &corev1.Affinity{
+				PodAffinity: &corev1.PodAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+						{
+							LabelSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									utils.LabelKeyApp:       utils.StepPodName,
+									utils.LabelKeyExecution: execution.Name,
+								},
+							},
+							TopologyKey: "kubernetes.io/hostname",
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: utils.WorkspaceVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: workspaceName(execution),
+						},
+					},
+				},
+				{
+					Name: utils.RegistryCrtVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: utils.RegistryCrtSecretName,
+						},
+					},
+				},
+			},
+		},
+	}
+	converter.ConfigPod(pod)
+	return pod, nil
+}
@@ -9,6 +9,9 @@
 
 var Drivers map[string]Driver
 
+// ProviderDrivers are the drivers selected by the provider query parameter of the webhook url.
+var ProviderDrivers map[string]Driver
+
 type Driver interface {
 	Execute(req *http.Request) (int, error)
 }
@@ -44,4 +47,12 @@ func RegisterDrivers(Management *config.ScaledContext) {
 		SourceCodeCredentials:      sourceCodeCredentials,
 		SourceCodeCredentialLister: sourceCodeCredentialLister,
 	}
+
+	ProviderDrivers = map[string]Driver{}
+	ProviderDrivers[drivers.GitWebhookProvider] = drivers.GitDriver{
+		PipelineLister:             pipelineLister,
+		PipelineExecutions:         pipelineExecutions,
+		SourceCodeCredentials:      sourceCodeCredentials,
+		SourceCodeCredentialLister: sourceCodeCredentialLister,
+	}
 }
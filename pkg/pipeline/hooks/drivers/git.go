@@ -0,0 +1,146 @@
+package drivers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/remote/git"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+)
+
+const (
+	GitWebhookProvider = model.GitType
+	gitPushEvent       = "push"
+	gitZeroCommit      = "0000000000000000000000000000000000000000"
+)
+
+var (
+	// gitSignatureHeaders are the headers holding the hex encoded sha256 hmac of the payload, the first one found is
+	// verified.
+	gitSignatureHeaders = []string{"X-Hub-Signature-256", "X-Gitea-Signature", "X-Gogs-Signature"}
+	// gitEventHeaders are the headers naming the event of the payload, generic senders may not set any of them.
+	gitEventHeaders = []string{"X-Gitea-Event", "X-Gogs-Event", "X-GitHub-Event"}
+)
+
+// GitDriver handles the webhooks of the pipelines using the generic git provider. It is selected with the provider
+// query parameter of the webhook url rather than by a header, as Gitea and Gogs send the headers of GitHub too.
+type GitDriver struct {
+	PipelineLister             v3.PipelineLister
+	PipelineExecutions         v3.PipelineExecutionInterface
+	SourceCodeCredentials      v3.SourceCodeCredentialInterface
+	SourceCodeCredentialLister v3.SourceCodeCredentialLister
+}
+
+func (g GitDriver) Execute(req *http.Request) (int, error) {
+	var signature string
+	for _, header := range gitSignatureHeaders {
+		if signature = req.Header.Get(header); signature != "" {
+			break
+		}
+	}
+	if signature == "" {
+		return http.StatusUnprocessableEntity, errors.New("webhook missing signature")
+	}
+	for _, header := range gitEventHeaders {
+		if event := req.Header.Get(header); event != "" && event != gitPushEvent {
+			return http.StatusUnprocessableEntity, fmt.Errorf("not trigger for event:%s", event)
+		}
+	}
+
+	pipelineID := req.URL.Query().Get("pipelineId")
+	ns, name := ref.Parse(pipelineID)
+	pipeline, err := g.PipelineLister.Get(ns, name)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return http.StatusUnprocessableEntity, err
+	}
+	if match := verifyGitWebhookSignature([]byte(pipeline.Status.Token), signature, body); !match {
+		return http.StatusUnprocessableEntity, errors.New("invalid signature")
+	}
+
+	info, err := parseGitPushPayload(body)
+	if err != nil {
+		return http.StatusUnprocessableEntity, err
+	}
+
+	return validateAndGeneratePipelineExecution(g.PipelineExecutions, g.SourceCodeCredentials, g.SourceCodeCredentialLister, info, pipeline)
+}
+
+func parseGitPushPayload(raw []byte) (*model.BuildInfo, error) {
+	info := &model.BuildInfo{}
+	payload := git.PushEventPayload{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Ref == "" || payload.After == "" {
+		return nil, errors.New("missing ref or commit in payload")
+	}
+	if payload.After == gitZeroCommit {
+		return nil, fmt.Errorf("no trigger for deleted ref '%s'", payload.Ref)
+	}
+
+	info.TriggerType = utils.TriggerTypeWebhook
+	info.Commit = payload.After
+	info.Ref = payload.Ref
+	info.HTMLLink = payload.CompareURL
+	if payload.HeadCommit != nil {
+		info.Message = payload.HeadCommit.Message
+		info.Author = payload.HeadCommit.Author.Name
+		info.Email = payload.HeadCommit.Author.Email
+		if payload.HeadCommit.URL != "" {
+			info.HTMLLink = payload.HeadCommit.URL
+		}
+	} else if len(payload.Commits) > 0 {
+		commit := payload.Commits[len(payload.Commits)-1]
+		info.Message = commit.Message
+		info.Author = commit.Author.Name
+		info.Email = commit.Author.Email
+	}
+	sender := payload.Sender
+	if sender.Login == "" && sender.Username == "" {
+		sender = payload.Pusher
+	}
+	info.Sender = sender.Login
+	if info.Sender == "" {
+		info.Sender = sender.Username
+	}
+	if info.Author == "" {
+		info.Author = info.Sender
+	}
+	info.AvatarURL = sender.AvatarURL
+
+	if strings.HasPrefix(payload.Ref, RefsTagPrefix) {
+		info.Event = utils.WebhookEventTag
+		info.Branch = strings.TrimPrefix(payload.Ref, RefsTagPrefix)
+	} else if strings.HasPrefix(payload.Ref, RefsBranchPrefix) {
+		info.Event = utils.WebhookEventPush
+		info.Branch = strings.TrimPrefix(payload.Ref, RefsBranchPrefix)
+	} else {
+		return nil, fmt.Errorf("no trigger for ref '%s'", payload.Ref)
+	}
+	return info, nil
+}
+
+func verifyGitWebhookSignature(secret []byte, signature string, body []byte) bool {
+	signature = strings.TrimPrefix(signature, "sha256=")
+	actual, err := hex.DecodeString(signature)
+	if err != nil || len(actual) != sha256.Size {
+		return false
+	}
+	computed := hmac.New(sha256.New, secret)
+	computed.Write(body)
+	return hmac.Equal(computed.Sum(nil), actual)
+}
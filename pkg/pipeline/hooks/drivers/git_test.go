@@ -0,0 +1,69 @@
+package drivers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	giteaPushPayload = `{
+  "ref": "refs/heads/main",
+  "before": "28e1879d029cb852e4844d9c718537df08844e03",
+  "after": "bffeb74224043ba2feb48d137756c8a9331c449a",
+  "compare_url": "https://gitea.example.com/org/repo/compare/28e1879...bffeb74",
+  "commits": [
+    {
+      "id": "bffeb74224043ba2feb48d137756c8a9331c449a",
+      "message": "Update README\n",
+      "url": "https://gitea.example.com/org/repo/commit/bffeb74224043ba2feb48d137756c8a9331c449a",
+      "author": {"name": "Jane Doe", "email": "jane@example.com", "username": "jane"}
+    }
+  ],
+  "pusher": {"login": "jane", "avatar_url": "https://gitea.example.com/avatars/jane"},
+  "sender": {"login": "jane", "avatar_url": "https://gitea.example.com/avatars/jane"}
+}`
+	genericTagPayload = `{"ref": "refs/tags/v1.0.0", "after": "bffeb74224043ba2feb48d137756c8a9331c449a"}`
+	deletedRefPayload = `{"ref": "refs/heads/old", "after": "0000000000000000000000000000000000000000"}`
+)
+
+func TestParseGitPushPayload(t *testing.T) {
+	assert := assert.New(t)
+
+	info, err := parseGitPushPayload([]byte(giteaPushPayload))
+	assert.Nil(err)
+	assert.Equal(utils.TriggerTypeWebhook, info.TriggerType)
+	assert.Equal(utils.WebhookEventPush, info.Event)
+	assert.Equal("main", info.Branch)
+	assert.Equal("refs/heads/main", info.Ref)
+	assert.Equal("bffeb74224043ba2feb48d137756c8a9331c449a", info.Commit)
+	assert.Equal("Update README\n", info.Message)
+	assert.Equal("Jane Doe", info.Author)
+	assert.Equal("jane", info.Sender)
+
+	info, err = parseGitPushPayload([]byte(genericTagPayload))
+	assert.Nil(err)
+	assert.Equal(utils.WebhookEventTag, info.Event)
+	assert.Equal("v1.0.0", info.Branch)
+
+	_, err = parseGitPushPayload([]byte(deletedRefPayload))
+	assert.NotNil(err)
+}
+
+func TestVerifyGitWebhookSignature(t *testing.T) {
+	assert := assert.New(t)
+	secret := []byte("secret")
+	body := []byte(genericTagPayload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(verifyGitWebhookSignature(secret, signature, body))
+	assert.True(verifyGitWebhookSignature(secret, "sha256="+signature, body))
+	assert.False(verifyGitWebhookSignature([]byte("other"), signature, body))
+	assert.False(verifyGitWebhookSignature(secret, "sha256=abc", body))
+}
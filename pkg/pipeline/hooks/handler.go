@@ -22,22 +22,32 @@ func (h *WebhookHandler) initDrivers(management *config.ScaledContext) {
 }
 
 func (h *WebhookHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if provider := req.URL.Query().Get("provider"); provider != "" {
+		if driver, ok := ProviderDrivers[provider]; ok {
+			execute(rw, req, provider, driver)
+			return
+		}
+	}
 	for key, driver := range Drivers {
 		if exist := req.Header.Get(key); exist != "" {
-			code, err := driver.Execute(req)
-			if err != nil {
-				e := map[string]interface{}{
-					"type":    "error",
-					"code":    code,
-					"message": err.Error(),
-				}
-				logrus.Debugf("executing %s driver got error: %v", key, err)
-				rw.WriteHeader(code)
-				responseBody, _ := json.Marshal(e)
-				rw.Write(responseBody)
-			}
-			rw.WriteHeader(http.StatusOK)
+			execute(rw, req, key, driver)
 		}
 	}
 
 }
+
+func execute(rw http.ResponseWriter, req *http.Request, key string, driver Driver) {
+	code, err := driver.Execute(req)
+	if err != nil {
+		e := map[string]interface{}{
+			"type":    "error",
+			"code":    code,
+			"message": err.Error(),
+		}
+		logrus.Debugf("executing %s driver got error: %v", key, err)
+		rw.WriteHeader(code)
+		responseBody, _ := json.Marshal(e)
+		rw.Write(responseBody)
+	}
+	rw.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,377 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	gitutil "github.com/rancher/rancher/pkg/git"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	refsHeadsPrefix = "refs/heads/"
+	peeledSuffix    = "^{}"
+	sshLoginName    = "git"
+	commitUserName  = "Rancher Pipeline"
+	commitUserEmail = "pipeline@rancher.local"
+	// gitTimeout bounds a git command so that an unresponsive server doesn't hang the API request or poller using it
+	gitTimeout = 2 * time.Minute
+)
+
+// client is a remote for plain git servers. It has no API to talk to, everything is done with the git command line
+// using the ssh key or the https token of the credential in use.
+type client struct {
+	CACerts    string
+	credential *v3.SourceCodeCredential
+}
+
+func New(config *v32.GitPipelineConfig) (model.Remote, error) {
+	if config == nil {
+		return nil, errors.New("empty git config")
+	}
+	return &client{
+		CACerts: config.CACerts,
+	}, nil
+}
+
+func (c *client) Type() string {
+	return model.GitType
+}
+
+func (c *client) UseCredential(cred *v3.SourceCodeCredential) {
+	c.credential = cred
+}
+
+// Login checks that the repositories of a git login input, encoded as json in the code, are reachable with its
+// credentials and returns the credential to store.
+func (c *client) Login(code string) (*v3.SourceCodeCredential, error) {
+	input := &v32.GitLoginInput{}
+	if err := json.Unmarshal([]byte(code), input); err != nil {
+		return nil, err
+	}
+	if len(input.RepositoryURLs) == 0 {
+		return nil, errors.New("at least one repository is required")
+	}
+	if input.Token != "" && input.Username == "" {
+		return nil, errors.New("username is required to authenticate with a token")
+	}
+	if input.Token == "" && input.SSHPrivateKey == "" {
+		return nil, errors.New("either a token or a ssh private key is required")
+	}
+
+	cred := &v3.SourceCodeCredential{}
+	cred.Spec.SourceCodeType = model.GitType
+	cred.Spec.LoginName = input.Username
+	if cred.Spec.LoginName == "" {
+		cred.Spec.LoginName = sshLoginName
+	}
+	cred.Spec.GitLoginName = cred.Spec.LoginName
+	cred.Spec.DisplayName = cred.Spec.LoginName
+	cred.Spec.AccessToken = input.Token
+	cred.Spec.SSHPrivateKey = input.SSHPrivateKey
+	cred.Spec.SSHKnownHosts = input.SSHKnownHosts
+	cred.Spec.RepositoryURLs = input.RepositoryURLs
+
+	c.credential = cred
+	for _, repoURL := range input.RepositoryURLs {
+		if _, err := c.lsRemote(repoURL, input.Token, "--heads"); err != nil {
+			return nil, errors.Wrapf(err, "failed to access repository %s", repoURL)
+		}
+	}
+	return cred, nil
+}
+
+func (c *client) Repos(account *v3.SourceCodeCredential) ([]v3.SourceCodeRepository, error) {
+	if account == nil {
+		return nil, fmt.Errorf("empty account")
+	}
+	c.credential = account
+	var result []v3.SourceCodeRepository
+	for _, repoURL := range account.Spec.RepositoryURLs {
+		r := v3.SourceCodeRepository{}
+		r.Spec.URL = repoURL
+		r.Spec.Permissions.Admin = true
+		r.Spec.Permissions.Pull = true
+		r.Spec.Permissions.Push = true
+		defaultBranch, err := c.getDefaultBranch(repoURL, account.Spec.AccessToken)
+		if err != nil {
+			logrus.Debugf("error getting the default branch of %s - %v", repoURL, err)
+		}
+		r.Spec.DefaultBranch = defaultBranch
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// CreateHook can't register the webhook on a plain git server, the returned id is the url to configure on the
+// server. Payloads are signed with the token of the pipeline.
+func (c *client) CreateHook(pipeline *v3.Pipeline, accessToken string) (string, error) {
+	return fmt.Sprintf("%s/hooks?pipelineId=%s&provider=%s", settings.ServerURL.Get(), ref.Ref(pipeline), model.GitType), nil
+}
+
+func (c *client) DeleteHook(pipeline *v3.Pipeline, accessToken string) error {
+	return nil
+}
+
+func (c *client) GetPipelineFileInRepo(repoURL string, branch string, accessToken string) ([]byte, error) {
+	dir, err := c.clone(repoURL, branch, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	for _, fileName := range []string{utils.PipelineFileYaml, utils.PipelineFileYml} {
+		content, err := ioutil.ReadFile(filepath.Join(dir, fileName))
+		if err == nil {
+			return content, nil
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (c *client) SetPipelineFileInRepo(repoURL string, branch string, accessToken string, content []byte) error {
+	dir, err := c.clone(repoURL, branch, accessToken)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := utils.PipelineFileYml
+	message := fmt.Sprintf("Create %s file", fileName)
+	if _, err := os.Stat(filepath.Join(dir, utils.PipelineFileYaml)); err == nil {
+		fileName = utils.PipelineFileYaml
+	}
+	if _, err := os.Stat(filepath.Join(dir, fileName)); err == nil {
+		message = fmt.Sprintf("Update %s file", fileName)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, fileName), content, 0644); err != nil {
+		return err
+	}
+	if _, err := c.run(dir, accessToken, "add", fileName); err != nil {
+		return err
+	}
+	if _, err := c.run(dir, accessToken, "-c", "user.name="+commitUserName, "-c", "user.email="+commitUserEmail,
+		"commit", "-m", message); err != nil {
+		return err
+	}
+	authURL, err := c.authURL(repoURL, accessToken)
+	if err != nil {
+		return err
+	}
+	_, err = c.run(dir, accessToken, "push", "--", authURL, "HEAD:"+refsHeadsPrefix+branch)
+	return err
+}
+
+func (c *client) GetBranches(repoURL string, accessToken string) ([]string, error) {
+	refs, err := c.lsRemote(repoURL, accessToken, "--heads")
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for name := range refs {
+		result = append(result, strings.TrimPrefix(name, refsHeadsPrefix))
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (c *client) GetHeadInfo(repoURL string, branch string, accessToken string) (*model.BuildInfo, error) {
+	dir, err := c.clone(repoURL, branch, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	output, err := c.run(dir, accessToken, "log", "-1", "--format=%H%n%an%n%ae%n%s")
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.SplitN(strings.TrimRight(output, "\n"), "\n", 4)
+	if len(lines) < 4 {
+		return nil, fmt.Errorf("cannot find head commit of branch '%s'", branch)
+	}
+	info := &model.BuildInfo{}
+	info.Commit = lines[0]
+	info.Ref = refsHeadsPrefix + branch
+	info.Branch = branch
+	info.Author = lines[1]
+	info.Email = lines[2]
+	info.Message = lines[3]
+	return info, nil
+}
+
+// ListRefs returns the commits of the branches and tags of a repository, annotated tags are resolved to the commits
+// they point to.
+func (c *client) ListRefs(repoURL string, accessToken string) (map[string]string, error) {
+	return c.lsRemote(repoURL, accessToken, "--heads", "--tags")
+}
+
+func (c *client) getDefaultBranch(repoURL string, accessToken string) (string, error) {
+	authURL, err := c.authURL(repoURL, accessToken)
+	if err != nil {
+		return "", err
+	}
+	output, err := c.run("", accessToken, "ls-remote", "--symref", "--", authURL, "HEAD")
+	if err != nil {
+		return "", err
+	}
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 3 && fields[0] == "ref:" && fields[2] == "HEAD" {
+			return strings.TrimPrefix(fields[1], refsHeadsPrefix), nil
+		}
+	}
+	return "", nil
+}
+
+func (c *client) lsRemote(repoURL string, accessToken string, args ...string) (map[string]string, error) {
+	authURL, err := c.authURL(repoURL, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	args = append([]string{"ls-remote"}, args...)
+	output, err := c.run("", accessToken, append(args, "--", authURL)...)
+	if err != nil {
+		return nil, err
+	}
+	refs := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		commit, name := fields[0], fields[1]
+		if strings.HasSuffix(name, peeledSuffix) {
+			refs[strings.TrimSuffix(name, peeledSuffix)] = commit
+		} else if _, ok := refs[name]; !ok {
+			refs[name] = commit
+		}
+	}
+	return refs, scanner.Err()
+}
+
+// clone makes a shallow clone of a branch or tag of a repository in a temporary directory, the caller is responsible
+// for removing it.
+func (c *client) clone(repoURL string, branch string, accessToken string) (string, error) {
+	authURL, err := c.authURL(repoURL, accessToken)
+	if err != nil {
+		return "", err
+	}
+	dir, err := ioutil.TempDir("", "pipeline-git-")
+	if err != nil {
+		return "", err
+	}
+	if _, err := c.run("", accessToken, "clone", "-q", "--depth=1", "--single-branch", "-b", branch, "--", authURL, dir); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	return dir, nil
+}
+
+func (c *client) authURL(repoURL string, accessToken string) (string, error) {
+	if err := validateURL(repoURL); err != nil {
+		return "", err
+	}
+	if c.credential == nil || !strings.HasPrefix(repoURL, "http") {
+		return repoURL, nil
+	}
+	return gitutil.FormatURL(repoURL, c.credential.Spec.GitLoginName, accessToken), nil
+}
+
+// validateURL only accepts the transports the credentials are set up for. Other transports such as ext:: or file://
+// would let a repository url run commands or read files on the server.
+func validateURL(repoURL string) error {
+	if err := gitutil.ValidateURL(repoURL); err != nil {
+		return err
+	}
+	if strings.HasPrefix(repoURL, "git@") {
+		return nil
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse URL %s: %v", repoURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "ssh" {
+		return fmt.Errorf("invalid git URL scheme %s, only http(s), ssh and git supported", u.Scheme)
+	}
+	return nil
+}
+
+// run executes a git command with the ssh key, known hosts and ca certs of the client written to temporary files.
+// Without known hosts, host keys are trusted on first use and checked against the user's known_hosts afterwards.
+// The token is masked in the errors as git prints the urls it fails to access.
+func (c *client) run(dir string, accessToken string, args ...string) (string, error) {
+	tmp, err := ioutil.TempDir("", "pipeline-git-auth-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if c.CACerts != "" {
+		caFile := filepath.Join(tmp, "ca.crt")
+		if err := ioutil.WriteFile(caFile, []byte(c.CACerts), 0600); err != nil {
+			return "", err
+		}
+		env = append(env, "GIT_SSL_CAINFO="+caFile)
+	}
+	if c.credential != nil && c.credential.Spec.SSHPrivateKey != "" {
+		keyFile := filepath.Join(tmp, "id")
+		if err := ioutil.WriteFile(keyFile, []byte(strings.TrimSpace(c.credential.Spec.SSHPrivateKey)+"\n"), 0600); err != nil {
+			return "", err
+		}
+		sshCommand := fmt.Sprintf("ssh -i %s -o IdentitiesOnly=yes", keyFile)
+		if c.credential.Spec.SSHKnownHosts != "" {
+			knownHostsFile := filepath.Join(tmp, "known_hosts")
+			if err := ioutil.WriteFile(knownHostsFile, []byte(c.credential.Spec.SSHKnownHosts), 0600); err != nil {
+				return "", err
+			}
+			sshCommand += fmt.Sprintf(" -o UserKnownHostsFile=%s -o StrictHostKeyChecking=yes", knownHostsFile)
+		} else {
+			sshCommand += " -o StrictHostKeyChecking=accept-new"
+		}
+		env = append(env, "GIT_SSH_COMMAND="+sshCommand)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gitTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", errors.Errorf("git %s timed out after %v", args[0], gitTimeout)
+		}
+		message := stderr.String()
+		if accessToken != "" {
+			message = strings.Replace(message, accessToken, "***", -1)
+		}
+		return "", errors.Wrap(err, strings.TrimSpace(message))
+	}
+	return stdout.String(), nil
+}
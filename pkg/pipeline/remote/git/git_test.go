@@ -0,0 +1,27 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateURL(t *testing.T) {
+	for _, repoURL := range []string{
+		"https://git.example.com/org/repo.git",
+		"http://git.example.com/org/repo.git",
+		"ssh://git@git.example.com:2222/org/repo.git",
+		"git@git.example.com:org/repo.git",
+	} {
+		assert.NoError(t, validateURL(repoURL), repoURL)
+	}
+	for _, repoURL := range []string{
+		"ext::sh -c touch% /tmp/pwned",
+		"file:///etc",
+		"--upload-pack=touch /tmp/pwned",
+		"/var/lib/rancher",
+		"https://git.example.com/org/repo.git\n",
+	} {
+		assert.Error(t, validateURL(repoURL), repoURL)
+	}
+}
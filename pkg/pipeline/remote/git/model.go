@@ -0,0 +1,34 @@
+package git
+
+// PushEventPayload is the push payload sent by Gitea, Gogs and the generic git webhooks.
+type PushEventPayload struct {
+	Ref        string   `json:"ref"`
+	Before     string   `json:"before"`
+	After      string   `json:"after"`
+	CompareURL string   `json:"compare_url"`
+	Commits    []Commit `json:"commits"`
+	HeadCommit *Commit  `json:"head_commit"`
+	Pusher     User     `json:"pusher"`
+	Sender     User     `json:"sender"`
+}
+
+type Commit struct {
+	ID      string     `json:"id"`
+	Message string     `json:"message"`
+	URL     string     `json:"url"`
+	Author  CommitUser `json:"author"`
+}
+
+type CommitUser struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+type User struct {
+	Login     string `json:"login"`
+	Username  string `json:"username"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
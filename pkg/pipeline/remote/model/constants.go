@@ -5,4 +5,5 @@
 	GithubType          = "github"
 	BitbucketCloudType  = "bitbucketcloud"
 	BitbucketServerType = "bitbucketserver"
+	GitType             = "git"
 )
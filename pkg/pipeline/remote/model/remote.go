@@ -28,3 +28,15 @@ type Remote interface {
 type Refresher interface {
 	Refresh(cred *v3.SourceCodeCredential) (bool, error)
 }
+
+// CredentialUser is implemented by the remotes that need more than the access token of a credential to access the
+// repositories, like the ssh key of a generic git credential.
+type CredentialUser interface {
+	UseCredential(cred *v3.SourceCodeCredential)
+}
+
+// RefLister is implemented by the remotes whose repositories are polled for changes. ListRefs returns the commits of
+// the branches and tags of a repository by their full ref names.
+type RefLister interface {
+	ListRefs(repoURL string, accessToken string) (map[string]string, error)
+}
@@ -50,6 +50,16 @@
 	JenkinsJNLPPort                = 50000
 	RegistryPort                   = 443
 	MinioPort                      = 9000
+	StepPodName                    = "pipeline-step"
+	StepContainerName              = "step"
+	WorkspaceVolumeName            = "workspace"
+	WorkspacePath                  = "/workspace"
+	WorkspaceSizeDefault           = "1Gi"
+	LabelKeyStage                  = "stage"
+	LabelKeyStep                   = "step"
+
+	EngineJenkins = "jenkins"
+	EnginePod     = "pod"
 
 	WebhookEventPush        = "push"
 	WebhookEventPullRequest = "pull_request"
@@ -57,6 +67,7 @@
 
 	TriggerTypeUser    = "user"
 	TriggerTypeWebhook = "webhook"
+	TriggerTypePoll    = "poll"
 
 	StateWaiting  = "Waiting"
 	StateBuilding = "Building"
@@ -73,6 +84,7 @@
 	PipelineFinishLabel    = "pipeline.project.cattle.io/finish"
 	LocalRegistryPortLabel = "pipeline.project.cattle.io/local-registry-port"
 	PipelineNamespaceLabel = "pipeline.project.cattle.io/pipeline-namespace"
+	PipelineEngineLabel    = "pipeline.project.cattle.io/engine"
 
 	PipelineFileYml  = ".rancher-pipeline.yml"
 	PipelineFileYaml = ".rancher-pipeline.yaml"
@@ -107,6 +119,10 @@
 	SettingExecutorCPURequestDefault    = "10m"
 	SettingExecutorCPULimit             = "executor-cpu-limit"
 	SettingExecutorCPULimitDefault      = "1"
+	SettingEngine                       = "engine"
+	SettingEngineDefault                = EngineJenkins
+	SettingWorkspaceSize                = "workspace-size"
+	SettingWorkspaceStorageClass        = "workspace-storage-class"
 
 	PipelineToolsMemoryRequestDefault = "10Mi"
 	PipelineToolsMemoryLimitDefault   = "100Mi"
@@ -16,6 +16,7 @@
 	"golang.org/x/crypto/bcrypt"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -274,6 +275,9 @@ func EnsureAccessToken(credentialInterface v3.SourceCodeCredentialInterface, rem
 	if credential == nil {
 		return "", nil
 	}
+	if user, ok := remote.(model.CredentialUser); ok {
+		user.UseCredential(credential)
+	}
 	refresher, ok := remote.(model.Refresher)
 	if !ok {
 		return credential.Spec.AccessToken, nil
@@ -298,3 +302,35 @@ func EnsureAccessToken(credentialInterface v3.SourceCodeCredentialInterface, rem
 	}
 	return credential.Spec.AccessToken, nil
 }
+
+// GetPipelineSettingValue returns the value of a pipeline setting of a project, or its default value when it is not
+// set. Settings of projects that are not initialized yet have no value.
+func GetPipelineSettingValue(pipelineSettingLister v3.PipelineSettingLister, projectID string, name string) (string, error) {
+	setting, err := pipelineSettingLister.Get(projectID, name)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	if setting.Value != "" {
+		return setting.Value, nil
+	}
+	return setting.Default, nil
+}
+
+// GetPipelineEngine returns the engine running an execution: the engine that started it or the engine set for its
+// project.
+func GetPipelineEngine(pipelineSettingLister v3.PipelineSettingLister, execution *v3.PipelineExecution) (string, error) {
+	if engine := execution.Annotations[PipelineEngineLabel]; engine != "" {
+		return engine, nil
+	}
+	_, projectID := ref.Parse(execution.Spec.ProjectName)
+	engine, err := GetPipelineSettingValue(pipelineSettingLister, projectID, SettingEngine)
+	if err != nil {
+		return "", err
+	}
+	if engine == "" {
+		return SettingEngineDefault, nil
+	}
+	return engine, nil
+}
@@ -704,6 +704,7 @@ func logTypes(schema *types.Schemas) *types.Schemas {
 			m.DisplayName{}).
 		MustImport(&Version, v3.ClusterTestInput{}).
 		MustImport(&Version, v3.ProjectTestInput{}).
+		MustImport(&Version, v3.LoggingDryRunReport{}).
 		MustImportAndCustomize(&Version, v3.ClusterLogging{}, func(schema *types.Schema) {
 			schema.CollectionActions = map[string]types.Action{
 				"test": {
@@ -712,6 +713,10 @@ func logTypes(schema *types.Schemas) *types.Schemas {
 				"dryRun": {
 					Input: "clusterTestInput",
 				},
+				"dryRunReport": {
+					Input:  "clusterTestInput",
+					Output: "loggingDryRunReport",
+				},
 			}
 		}).
 		MustImportAndCustomize(&Version, v3.ProjectLogging{}, func(schema *types.Schema) {
@@ -722,6 +727,10 @@ func logTypes(schema *types.Schemas) *types.Schemas {
 				"dryRun": {
 					Input: "projectTestInput",
 				},
+				"dryRunReport": {
+					Input:  "projectTestInput",
+					Output: "loggingDryRunReport",
+				},
 			}
 		})
 }
@@ -765,6 +774,9 @@ func alertTypes(schema *types.Schemas) *types.Schemas {
 		AddMapperForType(&Version, v3.ClusterAlertGroup{},
 			&m.Embed{Field: "status"},
 			m.DisplayName{}).
+		AddMapperForType(&Version, v3.AlertMaintenanceWindow{},
+			&m.Embed{Field: "status"},
+			m.DisplayName{}).
 		AddMapperForType(&Version, v3.ProjectAlertGroup{},
 			&m.Embed{Field: "status"},
 			m.DisplayName{}).
@@ -776,6 +788,12 @@ func alertTypes(schema *types.Schemas) *types.Schemas {
 			m.DisplayName{}).
 		MustImport(&Version, v3.ClusterAlertGroup{}).
 		MustImport(&Version, v3.ProjectAlertGroup{}).
+		MustImport(&Version, v3.AlertMaintenanceWindow{}).
+		MustImportAndCustomize(&Version, v3.AlertHistory{}, func(schema *types.Schema) {
+			// histories are written by the alert controllers only
+			schema.CollectionMethods = []string{http.MethodGet}
+			schema.ResourceMethods = []string{http.MethodGet}
+		}).
 		MustImportAndCustomize(&Version, v3.ClusterAlertRule{}, func(schema *types.Schema) {
 			schema.ResourceActions = map[string]types.Action{
 				"activate":   {},
@@ -889,12 +907,18 @@ func kontainerTypes(schemas *types.Schemas) *types.Schemas {
 			&m.Embed{Field: "status"},
 			m.DisplayName{},
 		).
+		MustImport(&Version, v3.MetadataBundleInput{}).
+		MustImport(&Version, v3.MetadataBundleOutput{}).
 		MustImportAndCustomize(&Version, v3.KontainerDriver{}, func(schema *types.Schema) {
 			schema.ResourceActions = map[string]types.Action{
 				"activate":   {},
 				"deactivate": {},
 			}
 			schema.CollectionActions = map[string]types.Action{
+				"importMetadataBundle": {
+					Input:  "metadataBundleInput",
+					Output: "metadataBundleOutput",
+				},
 				"refresh": {},
 			}
 		})
@@ -977,10 +1001,13 @@ func clusterTemplateTypes(schemas *types.Schemas) *types.Schemas {
 }
 
 func clusterScanTypes(schemas *types.Schemas) *types.Schemas {
-	return schemas.MustImportAndCustomize(&Version, v3.ClusterScan{}, func(schema *types.Schema) {
-		schema.CollectionMethods = []string{http.MethodGet}
-		schema.ResourceMethods = []string{http.MethodGet, http.MethodDelete}
-	})
+	return schemas.
+		AddMapperForType(&Version, v3.CisBenchmarkProfile{}, m.Drop{Field: "namespaceId"}).
+		MustImport(&Version, v3.CisBenchmarkProfile{}).
+		MustImportAndCustomize(&Version, v3.ClusterScan{}, func(schema *types.Schema) {
+			schema.CollectionMethods = []string{http.MethodGet}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodDelete}
+		})
 }
 
 func encryptionTypes(schemas *types.Schemas) *types.Schemas {
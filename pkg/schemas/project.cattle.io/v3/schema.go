@@ -877,6 +877,8 @@ func pipelineTypes(schema *types.Schemas) *types.Schemas {
 		MustImport(&Version, v3.BitbucketServerApplyInput{}).
 		MustImport(&Version, v3.BitbucketServerRequestLoginInput{}).
 		MustImport(&Version, v3.BitbucketServerRequestLoginOutput{}).
+		MustImport(&Version, v3.GitLoginInput{}).
+		MustImport(&Version, v3.GitApplyInput{}).
 		MustImportAndCustomize(&Version, v3.SourceCodeProvider{}, func(schema *types.Schema) {
 			schema.CollectionMethods = []string{http.MethodGet}
 		}).
@@ -897,6 +899,17 @@ func pipelineTypes(schema *types.Schemas) *types.Schemas {
 			schema.CollectionMethods = []string{}
 			schema.ResourceMethods = []string{http.MethodGet}
 		}).
+		MustImportAndCustomize(&Version, v3.GitProvider{}, func(schema *types.Schema) {
+			schema.BaseType = "sourceCodeProvider"
+			schema.ResourceActions = map[string]types.Action{
+				"login": {
+					Input:  "gitLoginInput",
+					Output: "sourceCodeCredential",
+				},
+			}
+			schema.CollectionMethods = []string{}
+			schema.ResourceMethods = []string{http.MethodGet}
+		}).
 		//Github Integration Config
 		MustImportAndCustomize(&Version, v3.SourceCodeProviderConfig{}, func(schema *types.Schema) {
 			schema.CollectionMethods = []string{http.MethodGet}
@@ -949,6 +962,17 @@ func pipelineTypes(schema *types.Schemas) *types.Schemas {
 		schema.CollectionMethods = []string{}
 		schema.ResourceMethods = []string{http.MethodGet, http.MethodPut}
 	}).
+		MustImportAndCustomize(&Version, v3.GitPipelineConfig{}, func(schema *types.Schema) {
+			schema.BaseType = "sourceCodeProviderConfig"
+			schema.ResourceActions = map[string]types.Action{
+				"disable": {},
+				"testAndApply": {
+					Input: "gitApplyInput",
+				},
+			}
+			schema.CollectionMethods = []string{}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodPut}
+		}).
 		MustImportAndCustomize(&Version, v3.Pipeline{}, func(schema *types.Schema) {
 			schema.ResourceActions = map[string]types.Action{
 				"activate":   {},
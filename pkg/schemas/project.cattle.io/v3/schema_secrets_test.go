@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificateWarnings(t *testing.T) {
+	schema := Schemas.Schema(&Version, "secret")
+	if !assert.NotNil(t, schema) {
+		return
+	}
+
+	data := map[string]interface{}{
+		"type": "kubernetes.io/tls",
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				"field.cattle.io/warnings": `["certificate [example.com] expires soon"]`,
+			},
+		},
+	}
+	schema.Mapper.FromInternal(data)
+	assert.Equal(t, []interface{}{"certificate [example.com] expires soon"}, data["warnings"])
+
+	data = map[string]interface{}{
+		"kind":     "certificate",
+		"warnings": []interface{}{"certificate [example.com] expires soon"},
+	}
+	assert.NoError(t, schema.Mapper.ToInternal(data))
+	assert.Nil(t, data["warnings"])
+	assert.Equal(t, `["certificate [example.com] expires soon"]`, data["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})["field.cattle.io/warnings"])
+}
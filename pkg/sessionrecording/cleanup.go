@@ -0,0 +1,95 @@
+package sessionrecording
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const cleanupInterval = time.Hour
+
+// StartCleanup periodically deletes the sessions recorded more than session-recording-retention-days days ago. The
+// transcripts of the local store are owned by the secret of their session and are garbage collected with it, the
+// transcripts of the s3 store are deleted first.
+func (m *Manager) StartCleanup(ctx context.Context) {
+	go wait.JitterUntil(m.cleanup, cleanupInterval, .1, true, ctx.Done())
+}
+
+func (m *Manager) cleanup() {
+	retention := retention()
+	if retention <= 0 {
+		return
+	}
+
+	secrets, err := m.secrets.List(Namespace, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			RecordingLabel: "true",
+		}).String(),
+	})
+	if err != nil {
+		logrus.Errorf("Error listing session recordings during cleanup: %v", err)
+		return
+	}
+
+	var count int
+	now := time.Now()
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secret.Type != SecretType || !expired(secret, retention, now) {
+			continue
+		}
+		if err := m.remove(secret); err != nil {
+			logrus.Errorf("Error deleting session recording %s: %v", secret.Name, err)
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		logrus.Infof("Deleted %d session recordings older than %v", count, retention)
+	}
+}
+
+// expired returns whether a session started more than retention ago. Sessions with an invalid start time are
+// expired as they can't be kept track of.
+func expired(secret *corev1.Secret, retention time.Duration, now time.Time) bool {
+	start, err := time.Parse(time.RFC3339, secret.Annotations[StartAnnotation])
+	return err != nil || now.Sub(start) > retention
+}
+
+func (m *Manager) remove(secret *corev1.Secret) error {
+	session := sessionFromSecret(secret)
+	if session.Store == StoreS3 && session.Object != "" {
+		client, bucket, _, err := m.s3Store()
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), saveTimeout)
+		defer cancel()
+		if err := client.RemoveObject(ctx, bucket, session.Object, minio.RemoveObjectOptions{}); err != nil {
+			return err
+		}
+	}
+
+	err := m.secrets.Delete(Namespace, secret.Name, &metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func retention() time.Duration {
+	days, err := strconv.Atoi(settings.SessionRecordingRetentionDays.Get())
+	if err != nil {
+		return 0
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
@@ -0,0 +1,39 @@
+package sessionrecording
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpired(t *testing.T) {
+	now := time.Date(2021, 6, 30, 12, 0, 0, 0, time.UTC)
+	retention := 30 * 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		start    string
+		expected bool
+	}{
+		{"recent", "2021-06-29T12:00:00Z", false},
+		{"at retention", "2021-05-31T12:00:00Z", false},
+		{"past retention", "2021-05-31T11:59:59Z", true},
+		{"no start", "", true},
+		{"invalid start", "yesterday", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						StartAnnotation: test.start,
+					},
+				},
+			}
+			assert.Equal(t, test.expected, expired(secret, retention, now))
+		})
+	}
+}
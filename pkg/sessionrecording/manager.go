@@ -0,0 +1,364 @@
+package sessionrecording
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/rancher/rancher/pkg/settings"
+	corecontrollers "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	Namespace = "cattle-system"
+
+	SecretType corev1.SecretType = "cattle.io/session-recording"
+
+	RecordingLabel = "cattle.io/session-recording"
+	ClusterLabel   = "cattle.io/session-recording-cluster"
+	// SessionLabel is set on the secrets holding the transcripts of the sessions stored in the local cluster
+	SessionLabel = "cattle.io/session-recording-session"
+
+	KindAnnotation      = "cattle.io/session-recording-kind"
+	UserAnnotation      = "cattle.io/session-recording-user"
+	StartAnnotation     = "cattle.io/session-recording-start"
+	EndAnnotation       = "cattle.io/session-recording-end"
+	StoreAnnotation     = "cattle.io/session-recording-store"
+	ObjectAnnotation    = "cattle.io/session-recording-object"
+	SizeAnnotation      = "cattle.io/session-recording-size"
+	TruncatedAnnotation = "cattle.io/session-recording-truncated"
+	chunkAnnotation     = "cattle.io/session-recording-chunk"
+
+	StoreLocal = "local"
+	StoreS3    = "s3"
+
+	contentKey = "content"
+	// chunkSize keeps the secrets holding a transcript under the 1 MiB limit of kubernetes objects
+	chunkSize = 900 * 1024
+	// saveTimeout bounds the upload of a transcript to the s3 store
+	saveTimeout = 5 * time.Minute
+)
+
+// Session is the metadata of a recorded session.
+type Session struct {
+	Name      string `json:"name"`
+	Kind      string `json:"kind"`
+	User      string `json:"user"`
+	Cluster   string `json:"cluster"`
+	Start     string `json:"start"`
+	End       string `json:"end,omitempty"`
+	Store     string `json:"store,omitempty"`
+	Object    string `json:"object,omitempty"`
+	Size      int    `json:"size,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// Manager starts the recording of sessions, and lists and opens the recorded sessions. The metadata of all the
+// sessions is kept in secrets of the local cluster, the transcripts are stored in the store set by the
+// session-recording-store setting when the session ends.
+type Manager struct {
+	secrets corecontrollers.SecretClient
+}
+
+func NewManager(secrets corecontrollers.SecretClient) *Manager {
+	return &Manager{
+		secrets: secrets,
+	}
+}
+
+// Enabled returns whether the shell and log sessions of the users are recorded.
+func Enabled() bool {
+	return settings.SessionRecording.Get() == "true"
+}
+
+// Start starts the recording of a session, it returns a nil Recorder if recording is disabled. The transcript is
+// saved to the store set when the session starts, the local store keeps a lower size limit as it uses secrets.
+func (m *Manager) Start(kind, user, cluster string) (*Recorder, error) {
+	if !Enabled() {
+		return nil, nil
+	}
+
+	store, maxSize := StoreLocal, settings.SessionRecordingLocalMaxSize.GetInt()
+	if settings.SessionRecordingStore.Get() == StoreS3 {
+		store, maxSize = StoreS3, settings.SessionRecordingMaxSize.GetInt()
+	}
+
+	now := time.Now()
+	secret, err := m.secrets.Create(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "session-",
+			Namespace:    Namespace,
+			Labels: map[string]string{
+				RecordingLabel: "true",
+				ClusterLabel:   cluster,
+			},
+			Annotations: map[string]string{
+				KindAnnotation:  kind,
+				UserAnnotation:  user,
+				StartAnnotation: now.UTC().Format(time.RFC3339),
+			},
+		},
+		Type: SecretType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the recording of the session: %w", err)
+	}
+
+	return newRecorder(Header{
+		Title:   fmt.Sprintf("%s of %s on cluster %s", kind, user, cluster),
+		Env:     map[string]string{"TERM": "xterm"},
+		User:    user,
+		Cluster: cluster,
+		Kind:    kind,
+	}, int64(maxSize), time.Now, func(content []byte, truncated bool) error {
+		return m.save(secret, store, content, truncated)
+	})
+}
+
+func (m *Manager) save(secret *corev1.Secret, store string, content []byte, truncated bool) error {
+	secret = secret.DeepCopy()
+	switch store {
+	case StoreS3:
+		object, err := m.saveS3(secret, content)
+		if err != nil {
+			return err
+		}
+		secret.Annotations[ObjectAnnotation] = object
+	default:
+		if err := m.saveLocal(secret, content); err != nil {
+			return err
+		}
+	}
+
+	secret.Annotations[EndAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	secret.Annotations[StoreAnnotation] = store
+	secret.Annotations[SizeAnnotation] = strconv.Itoa(len(content))
+	if truncated {
+		secret.Annotations[TruncatedAnnotation] = "true"
+	}
+	_, err := m.secrets.Update(secret)
+	return err
+}
+
+// saveLocal stores the transcript in secrets owned by the secret of the session.
+func (m *Manager) saveLocal(secret *corev1.Secret, content []byte) error {
+	for i := 0; len(content) > 0; i++ {
+		chunk := content
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		content = content[len(chunk):]
+
+		_, err := m.secrets.Create(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", secret.Name, i),
+				Namespace: secret.Namespace,
+				Labels: map[string]string{
+					SessionLabel: secret.Name,
+				},
+				Annotations: map[string]string{
+					chunkAnnotation: strconv.Itoa(i),
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       secret.Name,
+						UID:        secret.UID,
+					},
+				},
+			},
+			Type: SecretType,
+			Data: map[string][]byte{
+				contentKey: chunk,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) openLocal(name string) (io.ReadCloser, error) {
+	secrets, err := m.secrets.List(Namespace, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			SessionLabel: name,
+		}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := secrets.Items
+	sort.Slice(chunks, func(i, j int) bool {
+		left, _ := strconv.Atoi(chunks[i].Annotations[chunkAnnotation])
+		right, _ := strconv.Atoi(chunks[j].Annotations[chunkAnnotation])
+		return left < right
+	})
+	var content []byte
+	for _, chunk := range chunks {
+		content = append(content, chunk.Data[contentKey]...)
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+// s3Store returns the client, the bucket and the folder of the s3 store, configured by the secret named by the
+// session-recording-s3-secret setting.
+func (m *Manager) s3Store() (*minio.Client, string, string, error) {
+	name := settings.SessionRecordingS3Secret.Get()
+	if name == "" {
+		return nil, "", "", fmt.Errorf("the %s setting must be set to store session recordings in s3", settings.SessionRecordingS3Secret.Name)
+	}
+	secret, err := m.secrets.Get(Namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	endpoint := string(secret.Data["endpoint"])
+	bucket := string(secret.Data["bucket"])
+	if endpoint == "" || bucket == "" {
+		return nil, "", "", fmt.Errorf("secret %s/%s must set the endpoint and the bucket of the s3 store", Namespace, name)
+	}
+
+	// no access credentials, we assume IAM roles
+	creds := credentials.NewIAM("")
+	if accessKey, secretKey := string(secret.Data["accessKey"]), string(secret.Data["secretKey"]); accessKey != "" && secretKey != "" {
+		creds = credentials.NewStaticV4(accessKey, secretKey, "")
+	}
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:        creds,
+		Region:       string(secret.Data["region"]),
+		Secure:       string(secret.Data["insecure"]) != "true",
+		BucketLookup: minio.BucketLookupAuto,
+	})
+	if err != nil {
+		return nil, "", "", err
+	}
+	return client, bucket, string(secret.Data["folder"]), nil
+}
+
+func (m *Manager) saveS3(secret *corev1.Secret, content []byte) (string, error) {
+	client, bucket, folder, err := m.s3Store()
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), saveTimeout)
+	defer cancel()
+	object := path.Join(folder, secret.Labels[ClusterLabel], secret.Name+".cast.gz")
+	_, err = client.PutObject(ctx, bucket, object, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{
+		ContentType: "application/gzip",
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload session recording %s to bucket %s: %w", object, bucket, err)
+	}
+	return object, nil
+}
+
+func (m *Manager) openS3(ctx context.Context, object string) (io.ReadCloser, error) {
+	client, bucket, _, err := m.s3Store()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObject(ctx, bucket, object, minio.GetObjectOptions{})
+}
+
+// List returns the sessions recorded on a cluster, the latest first.
+func (m *Manager) List(cluster string) ([]Session, error) {
+	secrets, err := m.secrets.List(Namespace, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			RecordingLabel: "true",
+			ClusterLabel:   cluster,
+		}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Session{}
+	for i := range secrets.Items {
+		result = append(result, sessionFromSecret(&secrets.Items[i]))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Start == result[j].Start {
+			return result[i].Name > result[j].Name
+		}
+		return result[i].Start > result[j].Start
+	})
+	return result, nil
+}
+
+// Open returns a recorded session of a cluster and its asciicast transcript.
+func (m *Manager) Open(ctx context.Context, cluster, name string) (*Session, io.ReadCloser, error) {
+	secret, err := m.secrets.Get(Namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if secret.Type != SecretType || secret.Labels[RecordingLabel] != "true" || secret.Labels[ClusterLabel] != cluster {
+		return nil, nil, fmt.Errorf("session recording %s of cluster %s: %w", name, cluster, validation.NotFound)
+	}
+
+	session := sessionFromSecret(secret)
+	var content io.ReadCloser
+	switch session.Store {
+	case "":
+		return nil, nil, fmt.Errorf("session %s is still in progress: %w", name, validation.Conflict)
+	case StoreS3:
+		content, err = m.openS3(ctx, session.Object)
+	default:
+		content, err = m.openLocal(name)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gz, err := gzip.NewReader(content)
+	if err != nil {
+		content.Close()
+		return nil, nil, err
+	}
+	return &session, &transcript{Reader: gz, content: content}, nil
+}
+
+type transcript struct {
+	*gzip.Reader
+	content io.Closer
+}
+
+func (t *transcript) Close() error {
+	if err := t.Reader.Close(); err != nil {
+		logrus.Debugf("failed to close session recording: %v", err)
+	}
+	return t.content.Close()
+}
+
+func sessionFromSecret(secret *corev1.Secret) Session {
+	size, _ := strconv.Atoi(secret.Annotations[SizeAnnotation])
+	return Session{
+		Name:      secret.Name,
+		Kind:      secret.Annotations[KindAnnotation],
+		User:      secret.Annotations[UserAnnotation],
+		Cluster:   secret.Labels[ClusterLabel],
+		Start:     secret.Annotations[StartAnnotation],
+		End:       secret.Annotations[EndAnnotation],
+		Store:     secret.Annotations[StoreAnnotation],
+		Object:    secret.Annotations[ObjectAnnotation],
+		Size:      size,
+		Truncated: secret.Annotations[TruncatedAnnotation] == "true",
+	}
+}
@@ -0,0 +1,141 @@
+// Package sessionrecording records the cluster shell, cluster log and machine ssh sessions of users as asciicast v2
+// transcripts, see https://github.com/asciinema/asciinema/blob/develop/doc/asciicast-v2.md. The transcripts are
+// stored in secrets of the local cluster or in an s3 bucket and are only readable by administrators.
+package sessionrecording
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	KindShell = "shell"
+	KindLog   = "log"
+	KindSSH   = "ssh"
+
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// Header is the first line of an asciicast v2 transcript, user, cluster and kind are extra fields identifying the session.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	User      string            `json:"user,omitempty"`
+	Cluster   string            `json:"cluster,omitempty"`
+	Kind      string            `json:"kind,omitempty"`
+}
+
+// Recorder writes the events of a session to a gzipped asciicast transcript, which is saved when the recorder is
+// closed. All the methods of a nil Recorder do nothing, a nil Recorder is returned when recording is disabled.
+type Recorder struct {
+	lock      sync.Mutex
+	start     time.Time
+	now       func() time.Time
+	buf       bytes.Buffer
+	gz        *gzip.Writer
+	size      int64
+	maxSize   int64
+	truncated bool
+	closed    bool
+	save      func(content []byte, truncated bool) error
+}
+
+func newRecorder(header Header, maxSize int64, now func() time.Time, save func([]byte, bool) error) (*Recorder, error) {
+	r := &Recorder{
+		start:   now(),
+		now:     now,
+		maxSize: maxSize,
+		save:    save,
+	}
+	r.gz = gzip.NewWriter(&r.buf)
+
+	header.Version = 2
+	header.Timestamp = r.start.Unix()
+	if header.Width == 0 {
+		header.Width = defaultWidth
+	}
+	if header.Height == 0 {
+		header.Height = defaultHeight
+	}
+	if err := r.writeLine(header); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Output records data printed to the terminal of the user.
+func (r *Recorder) Output(data []byte) {
+	r.event("o", string(data))
+}
+
+// Input records data typed by the user.
+func (r *Recorder) Input(data []byte) {
+	r.event("i", string(data))
+}
+
+// Resize records a resize of the terminal of the user.
+func (r *Recorder) Resize(width, height int) {
+	r.event("r", fmt.Sprintf("%dx%d", width, height))
+}
+
+func (r *Recorder) event(code, data string) {
+	if r == nil || data == "" {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed || r.truncated {
+		return
+	}
+
+	// the time of the events is in seconds since the start of the session, with a microsecond precision
+	elapsed := math.Round(r.now().Sub(r.start).Seconds()*1e6) / 1e6
+	if r.maxSize > 0 && r.size+int64(len(data)) > r.maxSize {
+		r.truncated = true
+		code, data = "o", "\r\n[session recording truncated]\r\n"
+	}
+	// the transcript is written to a buffer, a write error is not possible
+	_ = r.writeLine([]interface{}{elapsed, code, data})
+}
+
+func (r *Recorder) writeLine(v interface{}) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	r.size += int64(len(line)) + 1
+	if _, err := r.gz.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Close ends the session and saves its transcript.
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	if err := r.gz.Close(); err != nil {
+		return err
+	}
+	return r.save(r.buf.Bytes(), r.truncated)
+}
@@ -0,0 +1,150 @@
+package sessionrecording
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRecorder(t *testing.T, maxSize int64) (*Recorder, func() []string) {
+	now := time.Unix(1600000000, 0)
+	var saved []byte
+	r, err := newRecorder(Header{User: "u-abc", Cluster: "c-xyz", Kind: KindShell}, maxSize, func() time.Time {
+		now = now.Add(500 * time.Millisecond)
+		return now
+	}, func(content []byte, truncated bool) error {
+		saved = content
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r, func() []string {
+		gz, err := gzip.NewReader(bytes.NewReader(saved))
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(gz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	r, transcript := testRecorder(t, 0)
+	r.Output([]byte("$ "))
+	r.Input([]byte("ls\r"))
+	r.Resize(120, 40)
+	r.Output(nil)
+	assert.NoError(t, r.Close())
+	r.Output([]byte("closed"))
+
+	assert.Equal(t, []string{
+		`{"version":2,"width":80,"height":24,"timestamp":1600000000,"user":"u-abc","cluster":"c-xyz","kind":"shell"}`,
+		`[0.5,"o","$ "]`,
+		`[1,"i","ls\r"]`,
+		`[1.5,"r","120x40"]`,
+	}, transcript()[:4])
+}
+
+func TestRecorderTruncated(t *testing.T) {
+	r, transcript := testRecorder(t, 200)
+	r.Output([]byte(strings.Repeat("a", 50)))
+	r.Output([]byte(strings.Repeat("b", 100)))
+	r.Output([]byte("c"))
+	assert.NoError(t, r.Close())
+
+	lines := transcript()
+	assert.Len(t, lines, 3)
+	assert.Equal(t, `[1,"o","\r\n[session recording truncated]\r\n"]`, lines[2])
+}
+
+func TestNilRecorder(t *testing.T) {
+	var r *Recorder
+	r.Output([]byte("data"))
+	r.Resize(80, 24)
+	assert.NoError(t, r.Close())
+}
+
+func frame(opcode byte, fin bool, mask []byte, payload []byte) []byte {
+	b0 := opcode
+	if fin {
+		b0 |= 0x80
+	}
+	result := []byte{b0}
+
+	var b1 byte
+	if mask != nil {
+		b1 = 0x80
+	}
+	switch {
+	case len(payload) < 126:
+		result = append(result, b1|byte(len(payload)))
+	default:
+		result = append(result, b1|126, 0, 0)
+		binary.BigEndian.PutUint16(result[2:], uint16(len(payload)))
+	}
+
+	data := append([]byte{}, payload...)
+	if mask != nil {
+		result = append(result, mask...)
+		for i := range data {
+			data[i] ^= mask[i%4]
+		}
+	}
+	return append(result, data...)
+}
+
+func TestFrameParser(t *testing.T) {
+	var messages []string
+	p := &frameParser{onMessage: func(opcode byte, payload []byte) {
+		messages = append(messages, string(payload))
+	}}
+
+	large := strings.Repeat("x", 300)
+	stream := bytes.Join([][]byte{
+		frame(textMessage, true, []byte{1, 2, 3, 4}, []byte("hello")),
+		frame(binaryMessage, false, nil, []byte("frag")),
+		frame(9, true, nil, []byte("ping")),
+		frame(continuationFrame, true, nil, []byte("mented")),
+		frame(binaryMessage, true, []byte{5, 6, 7, 8}, []byte(large)),
+	}, nil)
+
+	// the frames are split across writes the way they are read from a connection
+	for len(stream) > 0 {
+		n := 7
+		if n > len(stream) {
+			n = len(stream)
+		}
+		p.Write(stream[:n])
+		stream = stream[n:]
+	}
+	assert.Equal(t, []string{"hello", "fragmented", large}, messages)
+	assert.Empty(t, p.buf)
+}
+
+func TestChannelMessages(t *testing.T) {
+	r, transcript := testRecorder(t, 0)
+	r.onInput(textMessage, []byte("0"+base64.StdEncoding.EncodeToString([]byte("whoami\r"))))
+	r.onInput(binaryMessage, append([]byte{resizeChannel}, []byte(`{"Width":100,"Height":30}`)...))
+	r.onOutput(binaryMessage, append([]byte{stdoutChannel}, []byte("admin\r\n")...))
+	r.onOutput(binaryMessage, []byte{stderrChannel})
+	r.onOutput(textMessage, []byte("3"+base64.StdEncoding.EncodeToString([]byte("{}"))))
+	assert.NoError(t, r.Close())
+
+	assert.Equal(t, []string{
+		`[0.5,"i","whoami\r"]`,
+		`[1,"r","100x30"]`,
+		`[1.5,"o","admin\r\n"]`,
+	}, transcript()[1:])
+}
@@ -0,0 +1,238 @@
+package sessionrecording
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"net/http"
+)
+
+const (
+	continuationFrame = 0
+	textMessage       = 1
+	binaryMessage     = 2
+	closeMessage      = 8
+
+	// maxMessageSize stops the parsing of a websocket connection which doesn't look like a terminal session
+	maxMessageSize = 16 * 1024 * 1024
+
+	stdinChannel  = 0
+	stdoutChannel = 1
+	stderrChannel = 2
+	resizeChannel = 4
+)
+
+// frameParser decodes the websocket frames written to it and calls onMessage with each complete data message.
+type frameParser struct {
+	buf       []byte
+	opcode    byte
+	message   []byte
+	failed    bool
+	onMessage func(opcode byte, payload []byte)
+}
+
+func (p *frameParser) Write(data []byte) (int, error) {
+	if p.failed {
+		return len(data), nil
+	}
+	p.buf = append(p.buf, data...)
+	for p.next() {
+	}
+	if len(p.buf) == 0 {
+		p.buf = nil
+	}
+	return len(data), nil
+}
+
+// next decodes the first frame of the buffer, it returns false if the frame isn't complete yet.
+func (p *frameParser) next() bool {
+	if len(p.buf) < 2 {
+		return false
+	}
+
+	fin := p.buf[0]&0x80 != 0
+	opcode := p.buf[0] & 0x0f
+	masked := p.buf[1]&0x80 != 0
+	length := uint64(p.buf[1] & 0x7f)
+	offset := 2
+	switch length {
+	case 126:
+		if len(p.buf) < 4 {
+			return false
+		}
+		length = uint64(binary.BigEndian.Uint16(p.buf[2:4]))
+		offset = 4
+	case 127:
+		if len(p.buf) < 10 {
+			return false
+		}
+		length = binary.BigEndian.Uint64(p.buf[2:10])
+		offset = 10
+	}
+	if length > maxMessageSize || uint64(len(p.message))+length > maxMessageSize {
+		p.failed, p.buf, p.message = true, nil, nil
+		return false
+	}
+
+	var mask []byte
+	if masked {
+		if len(p.buf) < offset+4 {
+			return false
+		}
+		mask = p.buf[offset : offset+4]
+		offset += 4
+	}
+	end := offset + int(length)
+	if len(p.buf) < end {
+		return false
+	}
+
+	payload := make([]byte, length)
+	copy(payload, p.buf[offset:end])
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	p.buf = p.buf[end:]
+
+	switch {
+	case opcode >= closeMessage:
+		// control frames can be sent between the fragments of a message
+		return true
+	case opcode == continuationFrame:
+		p.message = append(p.message, payload...)
+	default:
+		p.opcode, p.message = opcode, payload
+	}
+	if fin {
+		p.onMessage(p.opcode, p.message)
+		p.message = nil
+	}
+	return true
+}
+
+// channelMessage returns the channel and the data of a message of the channel.k8s.io and base64.channel.k8s.io
+// protocols used by the exec requests of kubernetes.
+func channelMessage(opcode byte, payload []byte) (byte, []byte, bool) {
+	if len(payload) == 0 {
+		return 0, nil, false
+	}
+	switch opcode {
+	case textMessage:
+		data, err := base64.StdEncoding.DecodeString(string(payload[1:]))
+		if err != nil {
+			return 0, nil, false
+		}
+		return payload[0] - '0', data, true
+	case binaryMessage:
+		return payload[0], payload[1:], true
+	}
+	return 0, nil, false
+}
+
+type terminalSize struct {
+	Width  int
+	Height int
+}
+
+func (r *Recorder) onInput(opcode byte, payload []byte) {
+	channel, data, ok := channelMessage(opcode, payload)
+	if !ok {
+		return
+	}
+	switch channel {
+	case stdinChannel:
+		r.Input(data)
+	case resizeChannel:
+		size := terminalSize{}
+		if err := json.Unmarshal(data, &size); err == nil {
+			r.Resize(size.Width, size.Height)
+		}
+	}
+}
+
+func (r *Recorder) onOutput(opcode byte, payload []byte) {
+	channel, data, ok := channelMessage(opcode, payload)
+	if ok && (channel == stdoutChannel || channel == stderrChannel) {
+		r.Output(data)
+	}
+}
+
+// recordingConn records the exec session going through a hijacked connection, the input of the user is read from
+// the connection and the output of the terminal is written to it.
+type recordingConn struct {
+	net.Conn
+	input  *frameParser
+	output *frameParser
+}
+
+func (c *recordingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.input.Write(b[:n])
+	}
+	return n, err
+}
+
+func (c *recordingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.output.Write(b[:n])
+	}
+	return n, err
+}
+
+type responseWriter struct {
+	http.ResponseWriter
+	recorder *Recorder
+}
+
+// ResponseWriter returns a response writer recording the kubernetes exec session proxied through the websocket the
+// response is upgraded to.
+func (r *Recorder) ResponseWriter(rw http.ResponseWriter) http.ResponseWriter {
+	if r == nil {
+		return rw
+	}
+	return &responseWriter{
+		ResponseWriter: rw,
+		recorder:       r,
+	}
+}
+
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := hijacker(w.ResponseWriter)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+	conn, brw, err := h.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &recordingConn{
+		Conn:   conn,
+		input:  &frameParser{onMessage: w.recorder.onInput},
+		output: &frameParser{onMessage: w.recorder.onOutput},
+	}, brw, nil
+}
+
+func (w *responseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func hijacker(rw http.ResponseWriter) (http.Hijacker, bool) {
+	for {
+		if h, ok := rw.(http.Hijacker); ok {
+			return h, true
+		}
+		u, ok := rw.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return nil, false
+		}
+		rw = u.Unwrap()
+	}
+}
@@ -24,6 +24,8 @@
 	AgentImage                          = NewSetting("agent-image", "rancher/rancher-agent:master-head")
 	AgentRolloutTimeout                 = NewSetting("agent-rollout-timeout", "300s")
 	AgentRolloutWait                    = NewSetting("agent-rollout-wait", "true")
+	AlertHistoryMaxEntries              = NewSetting("alert-history-max-entries", "100")
+	AlertHistoryRetentionDays           = NewSetting("alert-history-retention-days", "30")
 	AuthImage                           = NewSetting("auth-image", v32.ToolsSystemImages.AuthSystemImages.KubeAPIAuth)
 	AuthTokenMaxTTLMinutes              = NewSetting("auth-token-max-ttl-minutes", "0") // never expire
 	AuthorizationCacheTTLSeconds        = NewSetting("authorization-cache-ttl-seconds", "10")
@@ -49,6 +51,7 @@
 	InternalCACerts                     = NewSetting("internal-cacerts", "")
 	IsRKE                               = NewSetting("is-rke", "")
 	JailerTimeout                       = NewSetting("jailer-timeout", "60")
+	KubeconfigExecCommand               = NewSetting("kubeconfig-exec-command", "rancher")
 	KubeconfigGenerateToken             = NewSetting("kubeconfig-generate-token", "true")
 	KubeconfigTokenTTLMinutes           = NewSetting("kubeconfig-token-ttl-minutes", "960") // 16 hours
 	KubernetesVersion                   = NewSetting("k8s-version", "")
@@ -63,7 +66,9 @@
 	PeerServices                        = NewSetting("peer-service", os.Getenv("CATTLE_PEER_SERVICE"))
 	RDNSServerBaseURL                   = NewSetting("rdns-base-url", "https://api.lb.rancher.cloud/v1")
 	RkeVersion                          = NewSetting("rke-version", "")
+	RkeMetadataBundlePublicKeys         = NewSetting("rke-metadata-bundle-public-keys", "")
 	RkeMetadataConfig                   = NewSetting("rke-metadata-config", getMetadataConfig())
+	RkeMetadataOffline                  = NewSetting("rke-metadata-offline", "false")
 	ServerImage                         = NewSetting("server-image", "rancher/rancher")
 	ServerURL                           = NewSetting("server-url", "")
 	ServerVersion                       = NewSetting("server-version", "dev")
@@ -119,6 +124,23 @@
 	HideLocalCluster                    = NewSetting("hide-local-cluster", "false")
 	MachineProvisionImage               = NewSetting("machine-provision-image", "rancher/machine:v0.15.0-rancher73")
 	SystemFeatureChartRefreshSeconds    = NewSetting("system-feature-chart-refresh-seconds", "900")
+	SessionRecording                    = NewSetting("session-recording", "false")
+	SessionRecordingStore               = NewSetting("session-recording-store", "local")            // Options are 'local' or 's3'
+	SessionRecordingS3Secret            = NewSetting("session-recording-s3-secret", "")             // secret in cattle-system with the endpoint, bucket and credentials of the s3 store
+	SessionRecordingMaxSize             = NewSetting("session-recording-max-size", "52428800")      // 50 MiB of transcript per session stored in s3, the rest of the session isn't recorded
+	SessionRecordingLocalMaxSize        = NewSetting("session-recording-local-max-size", "1048576") // 1 MiB of transcript per session stored in secrets of the local cluster, use s3 for longer sessions
+	SessionRecordingRetentionDays       = NewSetting("session-recording-retention-days", "30")      // recorded sessions are deleted after this many days, 0 keeps them
+	MachineSSHAllowedRoles              = NewSetting("machine-ssh-allowed-roles", "*")              // comma separated cluster roles allowed to ssh into the machines of a cluster, * for all
+	MachineSSHDeniedRoles               = NewSetting("machine-ssh-denied-roles", "")                // comma separated cluster roles never allowed to ssh into the machines of a cluster
+	MachineSSHCertificateTTL            = NewSetting("machine-ssh-certificate-ttl", "300")          // seconds the ssh certificate of a machine shell can be used to log in
+	ClusterProxyUserQPS                 = NewSetting("cluster-proxy-user-qps", "0")                 // requests per second of a user to a downstream cluster, 0 for no limit
+	ClusterProxyUserBurst               = NewSetting("cluster-proxy-user-burst", "0")               // defaults to the qps
+	ClusterProxyUserMaxInFlight         = NewSetting("cluster-proxy-user-max-inflight", "0")
+	ClusterProxyUserMaxWatches          = NewSetting("cluster-proxy-user-max-watches", "0")
+	ClusterProxyClusterQPS              = NewSetting("cluster-proxy-cluster-qps", "0")   // requests per second of all the users to a downstream cluster, 0 for no limit
+	ClusterProxyClusterBurst            = NewSetting("cluster-proxy-cluster-burst", "0") // defaults to the qps
+	ClusterProxyClusterMaxInFlight      = NewSetting("cluster-proxy-cluster-max-inflight", "0")
+	ClusterProxyClusterMaxWatches       = NewSetting("cluster-proxy-cluster-max-watches", "0")
 
 	FleetMinVersion          = NewSetting("fleet-min-version", "")
 	RancherWebhookMinVersion = NewSetting("rancher-webhook-min-version", "")
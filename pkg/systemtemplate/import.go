@@ -132,13 +132,14 @@ func SystemTemplate(resp io.Writer, agentImage, authImage, namespace, token, url
 
 func GetDesiredFeatures(cluster *v3.Cluster) map[string]bool {
 	return map[string]bool{
-		features.MCM.Name():                false,
-		features.MCMAgent.Name():           true,
-		features.Fleet.Name():              false,
-		features.RKE2.Name():               false,
-		features.ProvisioningV2.Name():     false,
-		features.EmbeddedClusterAPI.Name(): false,
-		features.MonitoringV1.Name():       cluster.Spec.EnableClusterMonitoring,
+		features.MCM.Name():                 false,
+		features.MCMAgent.Name():            true,
+		features.Fleet.Name():               false,
+		features.RKE2.Name():                false,
+		features.ProvisioningV2.Name():      false,
+		features.EmbeddedClusterAPI.Name():  false,
+		features.MonitoringV1.Name():        cluster.Spec.EnableClusterMonitoring,
+		features.RequireSignedCharts.Name(): features.RequireSignedCharts.Enabled(),
 	}
 }
 